@@ -40,7 +40,7 @@ wire:
 .PHONY: ent
 # Run ent for generate schema
 ent:
-	@go run -mod=mod entgo.io/ent/cmd/ent generate ./ent/schema
+	@go run -mod=mod entgo.io/ent/cmd/ent generate --feature sql/execquery ./ent/schema
 
 .PHONY: lint
 # Run linter fo Golang files
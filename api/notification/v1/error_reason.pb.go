@@ -27,19 +27,43 @@ const (
 	ErrorReason_INTERNAL_ERROR         ErrorReason = 0
 	ErrorReason_INVALID_REQUEST        ErrorReason = 1
 	ErrorReason_NOTIFICATION_NOT_FOUND ErrorReason = 2
+	ErrorReason_QUOTA_EXCEEDED         ErrorReason = 3
+	ErrorReason_SCHEDULE_NOT_FOUND     ErrorReason = 4
+	ErrorReason_SUPPRESSION_NOT_FOUND  ErrorReason = 5
+	ErrorReason_RECIPIENT_SUPPRESSED   ErrorReason = 6
+	ErrorReason_FORBIDDEN              ErrorReason = 7
+	ErrorReason_UNAUTHORIZED           ErrorReason = 8
+	ErrorReason_API_KEY_NOT_FOUND      ErrorReason = 9
+	ErrorReason_CREDENTIALS_NOT_FOUND  ErrorReason = 10
 )
 
 // Enum value maps for ErrorReason.
 var (
 	ErrorReason_name = map[int32]string{
-		0: "INTERNAL_ERROR",
-		1: "INVALID_REQUEST",
-		2: "NOTIFICATION_NOT_FOUND",
+		0:  "INTERNAL_ERROR",
+		1:  "INVALID_REQUEST",
+		2:  "NOTIFICATION_NOT_FOUND",
+		3:  "QUOTA_EXCEEDED",
+		4:  "SCHEDULE_NOT_FOUND",
+		5:  "SUPPRESSION_NOT_FOUND",
+		6:  "RECIPIENT_SUPPRESSED",
+		7:  "FORBIDDEN",
+		8:  "UNAUTHORIZED",
+		9:  "API_KEY_NOT_FOUND",
+		10: "CREDENTIALS_NOT_FOUND",
 	}
 	ErrorReason_value = map[string]int32{
 		"INTERNAL_ERROR":         0,
 		"INVALID_REQUEST":        1,
 		"NOTIFICATION_NOT_FOUND": 2,
+		"QUOTA_EXCEEDED":         3,
+		"SCHEDULE_NOT_FOUND":     4,
+		"SUPPRESSION_NOT_FOUND":  5,
+		"RECIPIENT_SUPPRESSED":   6,
+		"FORBIDDEN":              7,
+		"UNAUTHORIZED":           8,
+		"API_KEY_NOT_FOUND":      9,
+		"CREDENTIALS_NOT_FOUND":  10,
 	}
 )
 
@@ -77,17 +101,31 @@ var file_notification_v1_error_reason_proto_rawDesc = []byte{
 	0x31, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x13, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x6a, 0x0a, 0x0b, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x0e, 0x49, 0x4e, 0x54,
-	0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x00, 0x1a, 0x04, 0xa8,
-	0x45, 0xf4, 0x03, 0x12, 0x19, 0x0a, 0x0f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x52,
-	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x20,
-	0x0a, 0x16, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e,
-	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03,
-	0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x26, 0x5a, 0x24, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0xce, 0x02, 0x0a, 0x0b, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x0e, 0x49, 0x4e,
+	0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x00, 0x1a, 0x04,
+	0xa8, 0x45, 0xf4, 0x03, 0x12, 0x19, 0x0a, 0x0f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12,
+	0x20, 0x0a, 0x16, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x1a, 0x04, 0xa8, 0x45, 0x94,
+	0x03, 0x12, 0x18, 0x0a, 0x0e, 0x51, 0x55, 0x4f, 0x54, 0x41, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45,
+	0x44, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x04, 0xa8, 0x45, 0xad, 0x03, 0x12, 0x1c, 0x0a, 0x12, 0x53,
+	0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x04, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x1f, 0x0a, 0x15, 0x53, 0x55, 0x50,
+	0x50, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x05, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x1e, 0x0a, 0x14, 0x52, 0x45,
+	0x43, 0x49, 0x50, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x52, 0x45, 0x53, 0x53,
+	0x45, 0x44, 0x10, 0x06, 0x1a, 0x04, 0xa8, 0x45, 0xa6, 0x03, 0x12, 0x13, 0x0a, 0x09, 0x46, 0x4f,
+	0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x07, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12,
+	0x16, 0x0a, 0x0c, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10,
+	0x08, 0x1a, 0x04, 0xa8, 0x45, 0x91, 0x03, 0x12, 0x1b, 0x0a, 0x11, 0x41, 0x50, 0x49, 0x5f, 0x4b,
+	0x45, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x09, 0x1a, 0x04,
+	0xa8, 0x45, 0x94, 0x03, 0x12, 0x1f, 0x0a, 0x15, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49,
+	0x41, 0x4c, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x0a, 0x1a,
+	0x04, 0xa8, 0x45, 0x94, 0x03, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x26, 0x5a, 0x24, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31,
+	0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -46,3 +46,99 @@ func IsNotificationNotFound(err error) bool {
 func ErrorNotificationNotFound(format string, args ...interface{}) *errors.Error {
 	return errors.New(404, ErrorReason_NOTIFICATION_NOT_FOUND.String(), fmt.Sprintf(format, args...))
 }
+
+func IsQuotaExceeded(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_QUOTA_EXCEEDED.String() && e.Code == 429
+}
+
+func ErrorQuotaExceeded(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, ErrorReason_QUOTA_EXCEEDED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsScheduleNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_SCHEDULE_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorScheduleNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_SCHEDULE_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsSuppressionNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_SUPPRESSION_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorSuppressionNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_SUPPRESSION_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsRecipientSuppressed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_RECIPIENT_SUPPRESSED.String() && e.Code == 422
+}
+
+func ErrorRecipientSuppressed(format string, args ...interface{}) *errors.Error {
+	return errors.New(422, ErrorReason_RECIPIENT_SUPPRESSED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsForbidden(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_FORBIDDEN.String() && e.Code == 403
+}
+
+func ErrorForbidden(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_FORBIDDEN.String(), fmt.Sprintf(format, args...))
+}
+
+func IsUnauthorized(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_UNAUTHORIZED.String() && e.Code == 401
+}
+
+func ErrorUnauthorized(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_UNAUTHORIZED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsApiKeyNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_API_KEY_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorApiKeyNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_API_KEY_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCredentialsNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_CREDENTIALS_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorCredentialsNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_CREDENTIALS_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
@@ -20,13 +20,47 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationNotificationCheck = "/notification.v1.Notification/Check"
+const OperationNotificationCreateSchedule = "/notification.v1.Notification/CreateSchedule"
+const OperationNotificationCreateSuppression = "/notification.v1.Notification/CreateSuppression"
+const OperationNotificationDeleteCredentials = "/notification.v1.Notification/DeleteCredentials"
+const OperationNotificationDeleteSchedule = "/notification.v1.Notification/DeleteSchedule"
+const OperationNotificationDeleteSuppression = "/notification.v1.Notification/DeleteSuppression"
 const OperationNotificationEnqueue = "/notification.v1.Notification/Enqueue"
+const OperationNotificationGetSchedule = "/notification.v1.Notification/GetSchedule"
+const OperationNotificationGetSuppression = "/notification.v1.Notification/GetSuppression"
+const OperationNotificationIssueAPIKey = "/notification.v1.Notification/IssueAPIKey"
+const OperationNotificationListAPIKeys = "/notification.v1.Notification/ListAPIKeys"
+const OperationNotificationListCredentials = "/notification.v1.Notification/ListCredentials"
+const OperationNotificationListSchedules = "/notification.v1.Notification/ListSchedules"
+const OperationNotificationListSuppressions = "/notification.v1.Notification/ListSuppressions"
+const OperationNotificationRevokeAPIKey = "/notification.v1.Notification/RevokeAPIKey"
 const OperationNotificationSend = "/notification.v1.Notification/Send"
+const OperationNotificationSetCredentials = "/notification.v1.Notification/SetCredentials"
+const OperationNotificationUpdateSchedule = "/notification.v1.Notification/UpdateSchedule"
+const OperationNotificationUpdateSuppression = "/notification.v1.Notification/UpdateSuppression"
+const OperationNotificationUsage = "/notification.v1.Notification/Usage"
 
 type NotificationHTTPServer interface {
 	Check(context.Context, *CheckRequest) (*CheckResponse, error)
+	CreateSchedule(context.Context, *ScheduleRequest) (*ScheduleResponse, error)
+	CreateSuppression(context.Context, *SuppressionRequest) (*SuppressionResponse, error)
+	DeleteCredentials(context.Context, *DeleteCredentialsRequest) (*DeleteCredentialsResponse, error)
+	DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error)
+	DeleteSuppression(context.Context, *DeleteSuppressionRequest) (*DeleteSuppressionResponse, error)
 	Enqueue(context.Context, *SendRequest) (*EnqueueResponse, error)
+	GetSchedule(context.Context, *GetScheduleRequest) (*ScheduleResponse, error)
+	GetSuppression(context.Context, *GetSuppressionRequest) (*SuppressionResponse, error)
+	IssueAPIKey(context.Context, *IssueAPIKeyRequest) (*IssueAPIKeyResponse, error)
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	ListCredentials(context.Context, *ListCredentialsRequest) (*ListCredentialsResponse, error)
+	ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error)
+	ListSuppressions(context.Context, *ListSuppressionsRequest) (*ListSuppressionsResponse, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
 	Send(context.Context, *SendRequest) (*SendResponse, error)
+	SetCredentials(context.Context, *SetCredentialsRequest) (*SetCredentialsResponse, error)
+	UpdateSchedule(context.Context, *ScheduleRequest) (*ScheduleResponse, error)
+	UpdateSuppression(context.Context, *SuppressionRequest) (*SuppressionResponse, error)
+	Usage(context.Context, *UsageRequest) (*UsageResponse, error)
 }
 
 func RegisterNotificationHTTPServer(s *http.Server, srv NotificationHTTPServer) {
@@ -34,6 +68,23 @@ func RegisterNotificationHTTPServer(s *http.Server, srv NotificationHTTPServer)
 	r.POST("/v1/enqueue", _Notification_Enqueue0_HTTP_Handler(srv))
 	r.POST("/v1/send", _Notification_Send0_HTTP_Handler(srv))
 	r.POST("/v1/check", _Notification_Check0_HTTP_Handler(srv))
+	r.POST("/v1/usage", _Notification_Usage0_HTTP_Handler(srv))
+	r.POST("/v1/schedule/create", _Notification_CreateSchedule0_HTTP_Handler(srv))
+	r.POST("/v1/schedule/update", _Notification_UpdateSchedule0_HTTP_Handler(srv))
+	r.POST("/v1/schedule/get", _Notification_GetSchedule0_HTTP_Handler(srv))
+	r.POST("/v1/schedule/delete", _Notification_DeleteSchedule0_HTTP_Handler(srv))
+	r.POST("/v1/schedule/list", _Notification_ListSchedules0_HTTP_Handler(srv))
+	r.POST("/v1/suppression/create", _Notification_CreateSuppression0_HTTP_Handler(srv))
+	r.POST("/v1/suppression/update", _Notification_UpdateSuppression0_HTTP_Handler(srv))
+	r.POST("/v1/suppression/get", _Notification_GetSuppression0_HTTP_Handler(srv))
+	r.POST("/v1/suppression/delete", _Notification_DeleteSuppression0_HTTP_Handler(srv))
+	r.POST("/v1/suppression/list", _Notification_ListSuppressions0_HTTP_Handler(srv))
+	r.POST("/v1/key/issue", _Notification_IssueAPIKey0_HTTP_Handler(srv))
+	r.POST("/v1/key/list", _Notification_ListAPIKeys0_HTTP_Handler(srv))
+	r.POST("/v1/key/revoke", _Notification_RevokeAPIKey0_HTTP_Handler(srv))
+	r.POST("/v1/credentials/set", _Notification_SetCredentials0_HTTP_Handler(srv))
+	r.POST("/v1/credentials/list", _Notification_ListCredentials0_HTTP_Handler(srv))
+	r.POST("/v1/credentials/delete", _Notification_DeleteCredentials0_HTTP_Handler(srv))
 }
 
 func _Notification_Enqueue0_HTTP_Handler(srv NotificationHTTPServer) func(ctx http.Context) error {
@@ -93,10 +144,350 @@ func _Notification_Check0_HTTP_Handler(srv NotificationHTTPServer) func(ctx http
 	}
 }
 
+func _Notification_Usage0_HTTP_Handler(srv NotificationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UsageRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNotificationUsage)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Usage(ctx, req.(*UsageRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UsageResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Notification_CreateSchedule0_HTTP_Handler(srv NotificationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ScheduleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNotificationCreateSchedule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateSchedule(ctx, req.(*ScheduleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ScheduleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Notification_UpdateSchedule0_HTTP_Handler(srv NotificationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ScheduleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNotificationUpdateSchedule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateSchedule(ctx, req.(*ScheduleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ScheduleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Notification_GetSchedule0_HTTP_Handler(srv NotificationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetScheduleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNotificationGetSchedule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSchedule(ctx, req.(*GetScheduleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ScheduleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Notification_DeleteSchedule0_HTTP_Handler(srv NotificationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteScheduleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNotificationDeleteSchedule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteSchedule(ctx, req.(*DeleteScheduleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteScheduleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Notification_ListSchedules0_HTTP_Handler(srv NotificationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSchedulesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNotificationListSchedules)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSchedules(ctx, req.(*ListSchedulesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSchedulesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Notification_CreateSuppression0_HTTP_Handler(srv NotificationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SuppressionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNotificationCreateSuppression)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateSuppression(ctx, req.(*SuppressionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SuppressionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Notification_UpdateSuppression0_HTTP_Handler(srv NotificationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SuppressionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNotificationUpdateSuppression)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateSuppression(ctx, req.(*SuppressionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SuppressionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Notification_GetSuppression0_HTTP_Handler(srv NotificationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSuppressionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNotificationGetSuppression)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSuppression(ctx, req.(*GetSuppressionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SuppressionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Notification_DeleteSuppression0_HTTP_Handler(srv NotificationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteSuppressionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNotificationDeleteSuppression)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteSuppression(ctx, req.(*DeleteSuppressionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteSuppressionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Notification_ListSuppressions0_HTTP_Handler(srv NotificationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSuppressionsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNotificationListSuppressions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSuppressions(ctx, req.(*ListSuppressionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSuppressionsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Notification_IssueAPIKey0_HTTP_Handler(srv NotificationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in IssueAPIKeyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNotificationIssueAPIKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.IssueAPIKey(ctx, req.(*IssueAPIKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*IssueAPIKeyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Notification_ListAPIKeys0_HTTP_Handler(srv NotificationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAPIKeysRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNotificationListAPIKeys)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAPIKeysResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Notification_RevokeAPIKey0_HTTP_Handler(srv NotificationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeAPIKeyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNotificationRevokeAPIKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeAPIKeyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Notification_SetCredentials0_HTTP_Handler(srv NotificationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetCredentialsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNotificationSetCredentials)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetCredentials(ctx, req.(*SetCredentialsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetCredentialsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Notification_ListCredentials0_HTTP_Handler(srv NotificationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListCredentialsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNotificationListCredentials)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListCredentials(ctx, req.(*ListCredentialsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListCredentialsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Notification_DeleteCredentials0_HTTP_Handler(srv NotificationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteCredentialsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNotificationDeleteCredentials)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteCredentials(ctx, req.(*DeleteCredentialsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteCredentialsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type NotificationHTTPClient interface {
 	Check(ctx context.Context, req *CheckRequest, opts ...http.CallOption) (rsp *CheckResponse, err error)
+	CreateSchedule(ctx context.Context, req *ScheduleRequest, opts ...http.CallOption) (rsp *ScheduleResponse, err error)
+	CreateSuppression(ctx context.Context, req *SuppressionRequest, opts ...http.CallOption) (rsp *SuppressionResponse, err error)
+	DeleteCredentials(ctx context.Context, req *DeleteCredentialsRequest, opts ...http.CallOption) (rsp *DeleteCredentialsResponse, err error)
+	DeleteSchedule(ctx context.Context, req *DeleteScheduleRequest, opts ...http.CallOption) (rsp *DeleteScheduleResponse, err error)
+	DeleteSuppression(ctx context.Context, req *DeleteSuppressionRequest, opts ...http.CallOption) (rsp *DeleteSuppressionResponse, err error)
 	Enqueue(ctx context.Context, req *SendRequest, opts ...http.CallOption) (rsp *EnqueueResponse, err error)
+	GetSchedule(ctx context.Context, req *GetScheduleRequest, opts ...http.CallOption) (rsp *ScheduleResponse, err error)
+	GetSuppression(ctx context.Context, req *GetSuppressionRequest, opts ...http.CallOption) (rsp *SuppressionResponse, err error)
+	IssueAPIKey(ctx context.Context, req *IssueAPIKeyRequest, opts ...http.CallOption) (rsp *IssueAPIKeyResponse, err error)
+	ListAPIKeys(ctx context.Context, req *ListAPIKeysRequest, opts ...http.CallOption) (rsp *ListAPIKeysResponse, err error)
+	ListCredentials(ctx context.Context, req *ListCredentialsRequest, opts ...http.CallOption) (rsp *ListCredentialsResponse, err error)
+	ListSchedules(ctx context.Context, req *ListSchedulesRequest, opts ...http.CallOption) (rsp *ListSchedulesResponse, err error)
+	ListSuppressions(ctx context.Context, req *ListSuppressionsRequest, opts ...http.CallOption) (rsp *ListSuppressionsResponse, err error)
+	RevokeAPIKey(ctx context.Context, req *RevokeAPIKeyRequest, opts ...http.CallOption) (rsp *RevokeAPIKeyResponse, err error)
 	Send(ctx context.Context, req *SendRequest, opts ...http.CallOption) (rsp *SendResponse, err error)
+	SetCredentials(ctx context.Context, req *SetCredentialsRequest, opts ...http.CallOption) (rsp *SetCredentialsResponse, err error)
+	UpdateSchedule(ctx context.Context, req *ScheduleRequest, opts ...http.CallOption) (rsp *ScheduleResponse, err error)
+	UpdateSuppression(ctx context.Context, req *SuppressionRequest, opts ...http.CallOption) (rsp *SuppressionResponse, err error)
+	Usage(ctx context.Context, req *UsageRequest, opts ...http.CallOption) (rsp *UsageResponse, err error)
 }
 
 type NotificationHTTPClientImpl struct {
@@ -120,6 +511,71 @@ func (c *NotificationHTTPClientImpl) Check(ctx context.Context, in *CheckRequest
 	return &out, err
 }
 
+func (c *NotificationHTTPClientImpl) CreateSchedule(ctx context.Context, in *ScheduleRequest, opts ...http.CallOption) (*ScheduleResponse, error) {
+	var out ScheduleResponse
+	pattern := "/v1/schedule/create"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNotificationCreateSchedule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *NotificationHTTPClientImpl) CreateSuppression(ctx context.Context, in *SuppressionRequest, opts ...http.CallOption) (*SuppressionResponse, error) {
+	var out SuppressionResponse
+	pattern := "/v1/suppression/create"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNotificationCreateSuppression))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *NotificationHTTPClientImpl) DeleteCredentials(ctx context.Context, in *DeleteCredentialsRequest, opts ...http.CallOption) (*DeleteCredentialsResponse, error) {
+	var out DeleteCredentialsResponse
+	pattern := "/v1/credentials/delete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNotificationDeleteCredentials))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *NotificationHTTPClientImpl) DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...http.CallOption) (*DeleteScheduleResponse, error) {
+	var out DeleteScheduleResponse
+	pattern := "/v1/schedule/delete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNotificationDeleteSchedule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *NotificationHTTPClientImpl) DeleteSuppression(ctx context.Context, in *DeleteSuppressionRequest, opts ...http.CallOption) (*DeleteSuppressionResponse, error) {
+	var out DeleteSuppressionResponse
+	pattern := "/v1/suppression/delete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNotificationDeleteSuppression))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *NotificationHTTPClientImpl) Enqueue(ctx context.Context, in *SendRequest, opts ...http.CallOption) (*EnqueueResponse, error) {
 	var out EnqueueResponse
 	pattern := "/v1/enqueue"
@@ -133,6 +589,110 @@ func (c *NotificationHTTPClientImpl) Enqueue(ctx context.Context, in *SendReques
 	return &out, err
 }
 
+func (c *NotificationHTTPClientImpl) GetSchedule(ctx context.Context, in *GetScheduleRequest, opts ...http.CallOption) (*ScheduleResponse, error) {
+	var out ScheduleResponse
+	pattern := "/v1/schedule/get"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNotificationGetSchedule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *NotificationHTTPClientImpl) GetSuppression(ctx context.Context, in *GetSuppressionRequest, opts ...http.CallOption) (*SuppressionResponse, error) {
+	var out SuppressionResponse
+	pattern := "/v1/suppression/get"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNotificationGetSuppression))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *NotificationHTTPClientImpl) IssueAPIKey(ctx context.Context, in *IssueAPIKeyRequest, opts ...http.CallOption) (*IssueAPIKeyResponse, error) {
+	var out IssueAPIKeyResponse
+	pattern := "/v1/key/issue"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNotificationIssueAPIKey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *NotificationHTTPClientImpl) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...http.CallOption) (*ListAPIKeysResponse, error) {
+	var out ListAPIKeysResponse
+	pattern := "/v1/key/list"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNotificationListAPIKeys))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *NotificationHTTPClientImpl) ListCredentials(ctx context.Context, in *ListCredentialsRequest, opts ...http.CallOption) (*ListCredentialsResponse, error) {
+	var out ListCredentialsResponse
+	pattern := "/v1/credentials/list"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNotificationListCredentials))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *NotificationHTTPClientImpl) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...http.CallOption) (*ListSchedulesResponse, error) {
+	var out ListSchedulesResponse
+	pattern := "/v1/schedule/list"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNotificationListSchedules))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *NotificationHTTPClientImpl) ListSuppressions(ctx context.Context, in *ListSuppressionsRequest, opts ...http.CallOption) (*ListSuppressionsResponse, error) {
+	var out ListSuppressionsResponse
+	pattern := "/v1/suppression/list"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNotificationListSuppressions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *NotificationHTTPClientImpl) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...http.CallOption) (*RevokeAPIKeyResponse, error) {
+	var out RevokeAPIKeyResponse
+	pattern := "/v1/key/revoke"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNotificationRevokeAPIKey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *NotificationHTTPClientImpl) Send(ctx context.Context, in *SendRequest, opts ...http.CallOption) (*SendResponse, error) {
 	var out SendResponse
 	pattern := "/v1/send"
@@ -145,3 +705,55 @@ func (c *NotificationHTTPClientImpl) Send(ctx context.Context, in *SendRequest,
 	}
 	return &out, err
 }
+
+func (c *NotificationHTTPClientImpl) SetCredentials(ctx context.Context, in *SetCredentialsRequest, opts ...http.CallOption) (*SetCredentialsResponse, error) {
+	var out SetCredentialsResponse
+	pattern := "/v1/credentials/set"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNotificationSetCredentials))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *NotificationHTTPClientImpl) UpdateSchedule(ctx context.Context, in *ScheduleRequest, opts ...http.CallOption) (*ScheduleResponse, error) {
+	var out ScheduleResponse
+	pattern := "/v1/schedule/update"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNotificationUpdateSchedule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *NotificationHTTPClientImpl) UpdateSuppression(ctx context.Context, in *SuppressionRequest, opts ...http.CallOption) (*SuppressionResponse, error) {
+	var out SuppressionResponse
+	pattern := "/v1/suppression/update"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNotificationUpdateSuppression))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *NotificationHTTPClientImpl) Usage(ctx context.Context, in *UsageRequest, opts ...http.CallOption) (*UsageResponse, error) {
+	var out UsageResponse
+	pattern := "/v1/usage"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNotificationUsage))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
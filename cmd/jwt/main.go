@@ -1,9 +1,14 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
+	"os"
 	"path"
+	"strconv"
+	"strings"
+	"time"
 
 	"notifications/internal/auth"
 	"notifications/internal/conf"
@@ -12,6 +17,7 @@ import (
 	"github.com/go-kratos/kratos/v2/config"
 	"github.com/go-kratos/kratos/v2/config/file"
 	"github.com/go-kratos/kratos/v2/log"
+	jwtv4 "github.com/golang-jwt/jwt/v4"
 	"github.com/joho/godotenv"
 )
 
@@ -22,11 +28,44 @@ var (
 	flagconf string
 	// dotenv is loaded from config path .env file
 	dotenv string
+	// sender is subject of token
+	sender int64
+	// scopes are comma separated scopes of token
+	scopes string
+	// channels are comma separated allowed channels of token
+	channels string
+	// subject of token, overrides sender
+	subject string
+	// tenant of sender selecting credentials of channels
+	tenant string
+	// ttl is lifetime of token
+	ttl time.Duration
+	// issuer of token
+	issuer string
+	// audience is comma separated audience of token
+	audience string
+	// extra are claims of token in JSON, they override other claims
+	extra string
+	// keyPath is path to PEM private key signing token, the secret of config signs HS256 token if empty
+	keyPath string
+	// kid is id of key put to header of token, it is ignored by shared secret
+	kid string
 )
 
 func init() {
 	flag.StringVar(&flagconf, "conf", "./configs", "config path, eg: -conf config.yaml")
 	flag.StringVar(&dotenv, "dotenv", ".env", ".env file, eg: -dotenv .env.local")
+	flag.Int64Var(&sender, "sender", 0, "sender id as subject of token, eg: -sender 42")
+	flag.StringVar(&scopes, "scopes", auth.ScopeSend, "comma separated scopes of token, eg: -scopes notifications:send,notifications:read")
+	flag.StringVar(&channels, "channels", "", "allowed channels of token, all if empty, eg: -channels email,sms")
+	flag.StringVar(&subject, "subject", "", "subject of token instead of sender, eg: -subject 42")
+	flag.StringVar(&tenant, "tenant", "", "tenant of sender, credentials of config are used if empty, eg: -tenant acme")
+	flag.DurationVar(&ttl, "ttl", auth.DefaultTTL, "lifetime of token, eg: -ttl 720h")
+	flag.StringVar(&issuer, "issuer", auth.Issuer, "issuer of token, eg: -issuer https://auth.example.com")
+	flag.StringVar(&audience, "audience", "", "audience of token, eg: -audience notifications")
+	flag.StringVar(&extra, "claims", "", `extra claims of token in JSON, eg: -claims '{"tenant":"acme"}'`)
+	flag.StringVar(&keyPath, "key", "", "PEM private key signing RS/ES/EdDSA token, HS256 by secret if empty, eg: -key private.pem")
+	flag.StringVar(&kid, "kid", "", "id of signing key, eg: -kid 2022-09")
 }
 
 func main() {
@@ -67,7 +106,10 @@ func run() error {
 		return err
 	}
 
-	token := auth.MakeJWT(bc.Auth.Jwt.Secret)
+	token, err := makeToken(bc.Auth.Jwt.Secret)
+	if err != nil {
+		return err
+	}
 
 	colors := map[string]string{
 		`reset`:  "\u001B[0m",
@@ -84,3 +126,63 @@ func run() error {
 	fmt.Println(colors["blue"] + token + colors["reset"])
 	return nil
 }
+
+func splitList(list string) []string {
+	items := []string{}
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func makeToken(secret string) (string, error) {
+	if ttl <= 0 {
+		return "", fmt.Errorf(`ttl must be positive, got %s`, ttl)
+	}
+	now := time.Now()
+	claims := jwtv4.MapClaims{
+		`iss`:    issuer,
+		`iat`:    now.Unix(),
+		`exp`:    now.Add(ttl).Unix(),
+		`scopes`: splitList(scopes),
+	}
+	if list := splitList(channels); len(list) > 0 {
+		claims[`channels`] = list
+	}
+	if sender != 0 {
+		claims[`sub`] = strconv.FormatInt(sender, 10)
+	}
+	if subject != "" {
+		claims[`sub`] = subject
+	}
+	if tenant != "" {
+		claims[`tenant`] = tenant
+	}
+	if list := splitList(audience); len(list) > 0 {
+		claims[`aud`] = list
+	}
+	if extra != "" {
+		var extraClaims map[string]interface{}
+		if err := json.Unmarshal([]byte(extra), &extraClaims); err != nil {
+			return "", fmt.Errorf(`claims are not JSON object: %w`, err)
+		}
+		for name, value := range extraClaims {
+			claims[name] = value
+		}
+	}
+
+	if keyPath == "" {
+		return auth.Sign(claims, jwtv4.SigningMethodHS256, []byte(secret), kid)
+	}
+	pem, err := os.ReadFile(keyPath)
+	if err != nil {
+		return "", err
+	}
+	private, method, err := auth.ParsePrivateKey(pem)
+	if err != nil {
+		return "", err
+	}
+	return auth.Sign(claims, method, private, kid)
+}
@@ -11,6 +11,7 @@ import (
 	"notifications/internal/pkg/logger"
 	"notifications/internal/pkg/metrics"
 	"notifications/internal/pkg/runtime"
+	"notifications/internal/pkg/tracing"
 	"notifications/internal/pkg/transport"
 
 	"github.com/go-kratos/kratos/v2"
@@ -100,7 +101,29 @@ func run() error {
 	logs := logger.New(id, Name, Version, bc.Log.Level)
 	logHelper := logger.NewHelper(logs, "scope", "server")
 
-	metric, err := metrics.New(bc.Metrics.Address, Name, bc.Metrics.Mute)
+	shutdownTracing, err := tracing.NewProvider(
+		ctx,
+		tracing.Options{
+			Endpoint:       bc.Tracing.GetEndpoint(),
+			Insecure:       bc.Tracing.GetInsecure(),
+			Ratio:          bc.Tracing.GetRatio(),
+			Timeout:        bc.Tracing.GetTimeout().AsDuration(),
+			PropagateHosts: bc.Tracing.GetPropagateHosts(),
+		},
+		id,
+		Name,
+		Version,
+	)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logHelper.Errorf("failed to shutdown tracing: %v", err)
+		}
+	}()
+
+	metric, err := metrics.NewExporter(bc.Metrics.Exporter, bc.Metrics.Address, Name, bc.Metrics.Mute)
 	if err != nil {
 		return err
 	}
@@ -148,9 +171,11 @@ func run() error {
 	smsAeroClient := smsaero.New(aero.Email, aero.ApiKey, httpClient, metric, logs)
 	smsAeroSender := senders.NewSMSAero(smsAeroClient, metric, logs)
 
-	sendersSet := senders.NewSenders(plainSender, emailSender, telegramSender, smsAeroSender)
+	tenants := senders.NewTenants(wireChannelCredentialRepo(database, metric), httpClient, metric, logs)
+
+	sendersSet := senders.NewSenders(plainSender, emailSender, telegramSender, smsAeroSender, tenants)
 
-	app, err := wireApp(ctx, database, bc.Server, bc.Auth, sendersSet, metric, logs)
+	app, err := wireApp(ctx, database, bc.Server, bc.Auth, bc.Biz, bc.Senders, sendersSet, metric, logs)
 	if err != nil {
 		return err
 	}
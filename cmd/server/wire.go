@@ -26,8 +26,23 @@ func wireData(*conf.Data, log.Logger) (data.Database, func(), error) {
 	panic(wire.Build(data.ProviderDataSet))
 }
 
+// wireChannelCredentialRepo init repo of credentials of tenants for senders
+func wireChannelCredentialRepo(data.Database, metrics.Metrics) biz.ChannelCredentialRepo {
+	panic(wire.Build(data.ProviderRepoSet))
+}
+
 // wireApp init kratos application.
-func wireApp(context.Context, data.Database, *conf.Server, *conf.Auth, *senders.Senders, metrics.Metrics, log.Logger) (
+func wireApp(
+	context.Context,
+	data.Database,
+	*conf.Server,
+	*conf.Auth,
+	*conf.Biz,
+	*conf.Senders,
+	*senders.Senders,
+	metrics.Metrics,
+	log.Logger,
+) (
 	*kratos.App,
 	error,
 ) {
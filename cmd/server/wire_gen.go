@@ -10,6 +10,7 @@ import (
 	"context"
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
+	"notifications/internal/auth"
 	"notifications/internal/biz"
 	"notifications/internal/conf"
 	"notifications/internal/data"
@@ -32,13 +33,35 @@ func wireData(confData *conf.Data, logger log.Logger) (data.Database, func(), er
 	}, nil
 }
 
+// wireChannelCredentialRepo init repo of credentials of tenants for senders
+func wireChannelCredentialRepo(database data.Database, metricsMetrics metrics.Metrics) biz.ChannelCredentialRepo {
+	channelCredentialRepo := data.NewChannelCredentialRepo(database, metricsMetrics)
+	return channelCredentialRepo
+}
+
 // wireApp init kratos application.
-func wireApp(contextContext context.Context, database data.Database, confServer *conf.Server, auth *conf.Auth, sendersSenders *senders.Senders, metricsMetrics metrics.Metrics, logger log.Logger) (*kratos.App, error) {
+func wireApp(contextContext context.Context, database data.Database, confServer *conf.Server, confAuth *conf.Auth, confBiz *conf.Biz, confSenders *conf.Senders, sendersSenders *senders.Senders, metricsMetrics metrics.Metrics, logger log.Logger) (*kratos.App, error) {
+	verifier, err := auth.NewVerifier(confAuth)
+	if err != nil {
+		return nil, err
+	}
 	notificationRepo := data.NewNotificationRepo(database, logger, metricsMetrics)
-	notificationUsecase := biz.NewNotificationUsecase(notificationRepo, sendersSenders, metricsMetrics, logger)
-	notificationService := service.NewNotificationService(notificationUsecase, sendersSenders, logger)
-	grpcServer := server.NewGRPCServer(confServer, notificationService, metricsMetrics, logger)
-	httpServer := server.NewHTTPServer(confServer, auth, notificationService, metricsMetrics, logger)
+	quotaRepo := data.NewQuotaRepo(database, metricsMetrics)
+	suppressionRepo := data.NewSuppressionRepo(database, metricsMetrics)
+	limiter := data.NewRateLimiter(database, confBiz, metricsMetrics)
+	notificationUsecase := biz.NewNotificationUsecase(notificationRepo, quotaRepo, suppressionRepo, limiter, sendersSenders, confBiz, metricsMetrics, logger)
+	scheduleRepo := data.NewScheduleRepo(database, metricsMetrics)
+	transactor := data.NewTransactor(notificationRepo)
+	scheduleUsecase := biz.NewScheduleUsecase(scheduleRepo, transactor, notificationUsecase, metricsMetrics, logger)
+	suppressionUsecase := biz.NewSuppressionUsecase(suppressionRepo, metricsMetrics, logger)
+	apiKeyRepo := data.NewAPIKeyRepo(database, metricsMetrics)
+	apiKeyUsecase := biz.NewAPIKeyUsecase(apiKeyRepo, metricsMetrics, logger)
+	channelCredentialRepo := data.NewChannelCredentialRepo(database, metricsMetrics)
+	channelCredentialUsecase := biz.NewChannelCredentialUsecase(channelCredentialRepo, metricsMetrics, logger)
+	notificationService := service.NewNotificationService(notificationUsecase, scheduleUsecase, suppressionUsecase, apiKeyUsecase, channelCredentialUsecase, sendersSenders, logger)
+	health := server.NewHealth(database, confSenders)
+	grpcServer := server.NewGRPCServer(confServer, verifier, notificationService, apiKeyUsecase, health, metricsMetrics, logger)
+	httpServer := server.NewHTTPServer(confServer, verifier, notificationService, apiKeyUsecase, health, metricsMetrics, logger)
 	app := newApp(contextContext, logger, grpcServer, httpServer)
 	return app, nil
 }
@@ -2,7 +2,9 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"net/http"
 	"os"
 	"path"
 	"syscall"
@@ -11,9 +13,11 @@ import (
 	"notifications/internal/clients/smsaero"
 	"notifications/internal/clients/telegram"
 	"notifications/internal/conf"
+	"notifications/internal/health"
 	"notifications/internal/pkg/logger"
 	"notifications/internal/pkg/metrics"
 	"notifications/internal/pkg/runtime"
+	"notifications/internal/pkg/tracing"
 	"notifications/internal/pkg/transport"
 	"notifications/internal/senders"
 	"notifications/internal/worker"
@@ -44,8 +48,32 @@ func init() {
 	flag.StringVar(&dotenv, "dotenv", ".env", ".env file, eg: -dotenv .env")
 }
 
-func newWorker(u *biz.NotificationUsecase, l log.Logger) *worker.Worker {
-	return worker.New(u, l)
+func newWorker(
+	u *biz.NotificationUsecase,
+	schedules *biz.ScheduleUsecase,
+	digests *biz.DigestUsecase,
+	c *conf.Worker,
+	listener biz.NotificationListener,
+	metric metrics.Metrics,
+	l log.Logger,
+) *worker.Worker {
+	options := []worker.Option{}
+	if c.GetListen() {
+		options = append(options, worker.ListenOption(listener, c.GetListenPollInterval().AsDuration()))
+	}
+	if c.GetScheduleInterval() == nil || c.GetScheduleInterval().AsDuration() > 0 {
+		options = append(
+			options,
+			worker.ScheduleOption(schedules, c.GetScheduleInterval().AsDuration(), c.GetScheduleBatchSize()),
+		)
+	}
+	if c.GetDigestInterval() == nil || c.GetDigestInterval().AsDuration() > 0 {
+		options = append(
+			options,
+			worker.DigestOption(digests, c.GetDigestInterval().AsDuration(), c.GetDigestBatchSize()),
+		)
+	}
+	return worker.New(u, c, metric, l, options...)
 }
 
 func main() {
@@ -94,7 +122,29 @@ func run() error {
 	logHelper := logger.NewHelper(logs, "scope", "worker")
 	dead.SetLogger(logHelper)
 
-	metric, err := metrics.New(bc.Metrics.Address, Name, bc.Metrics.Mute)
+	shutdownTracing, err := tracing.NewProvider(
+		ctx,
+		tracing.Options{
+			Endpoint:       bc.Tracing.GetEndpoint(),
+			Insecure:       bc.Tracing.GetInsecure(),
+			Ratio:          bc.Tracing.GetRatio(),
+			Timeout:        bc.Tracing.GetTimeout().AsDuration(),
+			PropagateHosts: bc.Tracing.GetPropagateHosts(),
+		},
+		id,
+		Name,
+		Version,
+	)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logHelper.Errorf("failed to shutdown tracing: %v", err)
+		}
+	}()
+
+	metric, err := metrics.NewExporter(bc.Metrics.Exporter, bc.Metrics.Address, Name, bc.Metrics.Mute)
 	if err != nil {
 		return err
 	}
@@ -131,14 +181,31 @@ func run() error {
 	smsAeroClient := smsaero.New(aero.Email, aero.ApiKey, httpClient, metric, logs)
 	smsAeroSender := senders.NewSMSAero(smsAeroClient, metric, logs)
 
-	sendersSet := senders.NewSenders(plainSender, emailSender, telegramSender, smsAeroSender)
+	tenants := senders.NewTenants(wireChannelCredentialRepo(database, metric), httpClient, metric, logs)
 
-	wrkr, err := wireWorker(database, sendersSet, metric, logs)
+	sendersSet := senders.NewSenders(plainSender, emailSender, telegramSender, smsAeroSender, tenants)
+
+	wrkr, err := wireWorker(database, bc.Data, bc.Biz, bc.Worker, sendersSet, metric, logs)
 	if err != nil {
 		log.Errorf("failed to wire worker: %v", err)
 		return nil
 	}
 
+	healthChecks := health.New().Register(`database`, database.Check)
+	httpServer := worker.NewHTTPServer(bc.Worker.GetHttp(), wrkr, healthChecks)
+	if httpServer != nil {
+		go func() {
+			if err := httpServer.Start(ctx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logHelper.Errorf("worker http server failed: %v", err)
+			}
+		}()
+		defer func() {
+			if err := httpServer.Stop(context.Background()); err != nil {
+				logHelper.Errorf("failed to stop worker http server: %v", err)
+			}
+		}()
+	}
+
 	logHelper.Info("worker start")
 	err = wrkr.Run(ctx)
 	if err != nil && err != context.Canceled {
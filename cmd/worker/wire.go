@@ -23,6 +23,19 @@ func wireData(*conf.Data, log.Logger) (data.Database, func(), error) {
 	panic(wire.Build(data.ProviderDataSet))
 }
 
-func wireWorker(data.Database, *senders.Senders, metrics.Metrics, log.Logger) (*worker.Worker, error) {
-	panic(wire.Build(data.ProviderRepoSet, biz.ProviderSet, newWorker))
+// wireChannelCredentialRepo init repo of credentials of tenants for senders
+func wireChannelCredentialRepo(data.Database, metrics.Metrics) biz.ChannelCredentialRepo {
+	panic(wire.Build(data.ProviderRepoSet))
+}
+
+func wireWorker(
+	data.Database,
+	*conf.Data,
+	*conf.Biz,
+	*conf.Worker,
+	*senders.Senders,
+	metrics.Metrics,
+	log.Logger,
+) (*worker.Worker, error) {
+	panic(wire.Build(data.ProviderRepoSet, data.ProviderListenerSet, biz.ProviderSet, newWorker))
 }
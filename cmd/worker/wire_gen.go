@@ -29,9 +29,23 @@ func wireData(confData *conf.Data, logger log.Logger) (data.Database, func(), er
 	}, nil
 }
 
-func wireWorker(database data.Database, sendersSenders *senders.Senders, metricsMetrics metrics.Metrics, logger log.Logger) (*worker.Worker, error) {
+// wireChannelCredentialRepo init repo of credentials of tenants for senders
+func wireChannelCredentialRepo(database data.Database, metricsMetrics metrics.Metrics) biz.ChannelCredentialRepo {
+	channelCredentialRepo := data.NewChannelCredentialRepo(database, metricsMetrics)
+	return channelCredentialRepo
+}
+
+func wireWorker(database data.Database, confData *conf.Data, confBiz *conf.Biz, confWorker *conf.Worker, sendersSenders *senders.Senders, metricsMetrics metrics.Metrics, logger log.Logger) (*worker.Worker, error) {
 	notificationRepo := data.NewNotificationRepo(database, logger, metricsMetrics)
-	notificationUsecase := biz.NewNotificationUsecase(notificationRepo, sendersSenders, metricsMetrics, logger)
-	workerWorker := newWorker(notificationUsecase, logger)
+	quotaRepo := data.NewQuotaRepo(database, metricsMetrics)
+	suppressionRepo := data.NewSuppressionRepo(database, metricsMetrics)
+	limiter := data.NewRateLimiter(database, confBiz, metricsMetrics)
+	notificationUsecase := biz.NewNotificationUsecase(notificationRepo, quotaRepo, suppressionRepo, limiter, sendersSenders, confBiz, metricsMetrics, logger)
+	scheduleRepo := data.NewScheduleRepo(database, metricsMetrics)
+	transactor := data.NewTransactor(notificationRepo)
+	scheduleUsecase := biz.NewScheduleUsecase(scheduleRepo, transactor, notificationUsecase, metricsMetrics, logger)
+	digestUsecase := biz.NewDigestUsecase(notificationRepo, confBiz, metricsMetrics, logger)
+	notificationListener := data.NewNotificationListener(confData, logger)
+	workerWorker := newWorker(notificationUsecase, scheduleUsecase, digestUsecase, confWorker, notificationListener, metricsMetrics, logger)
 	return workerWorker, nil
 }
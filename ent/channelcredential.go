@@ -0,0 +1,151 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"notifications/ent/channelcredential"
+	"notifications/ent/schema"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ChannelCredential is the model entity for the ChannelCredential schema.
+type ChannelCredential struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// tenant of senders using credentials
+	Tenant string `json:"tenant,omitempty"`
+	// types in (email|sms|telegram)
+	Type schema.NotificationType `json:"type,omitempty"`
+	// credentials of channel, fields are variable by type
+	Credentials schema.Credentials `json:"-"`
+	// creation time of credentials
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// last update time of credentials, senders of tenant are rebuilt after update
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ChannelCredential) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case channelcredential.FieldCredentials:
+			values[i] = new([]byte)
+		case channelcredential.FieldID:
+			values[i] = new(sql.NullInt64)
+		case channelcredential.FieldTenant, channelcredential.FieldType:
+			values[i] = new(sql.NullString)
+		case channelcredential.FieldCreatedAt, channelcredential.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ChannelCredential", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ChannelCredential fields.
+func (cc *ChannelCredential) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case channelcredential.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cc.ID = int(value.Int64)
+		case channelcredential.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				cc.Tenant = value.String
+			}
+		case channelcredential.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				cc.Type = schema.NotificationType(value.String)
+			}
+		case channelcredential.FieldCredentials:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field credentials", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &cc.Credentials); err != nil {
+					return fmt.Errorf("unmarshal field credentials: %w", err)
+				}
+			}
+		case channelcredential.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cc.CreatedAt = value.Time
+			}
+		case channelcredential.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				cc.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this ChannelCredential.
+// Note that you need to call ChannelCredential.Unwrap() before calling this method if this ChannelCredential
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cc *ChannelCredential) Update() *ChannelCredentialUpdateOne {
+	return (&ChannelCredentialClient{config: cc.config}).UpdateOne(cc)
+}
+
+// Unwrap unwraps the ChannelCredential entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cc *ChannelCredential) Unwrap() *ChannelCredential {
+	_tx, ok := cc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ChannelCredential is not a transactional entity")
+	}
+	cc.config.driver = _tx.drv
+	return cc
+}
+
+// String implements the fmt.Stringer.
+func (cc *ChannelCredential) String() string {
+	var builder strings.Builder
+	builder.WriteString("ChannelCredential(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cc.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(cc.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", cc.Type))
+	builder.WriteString(", ")
+	builder.WriteString("credentials=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(cc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ChannelCredentials is a parsable slice of ChannelCredential.
+type ChannelCredentials []*ChannelCredential
+
+func (cc ChannelCredentials) config(cfg config) {
+	for _i := range cc {
+		cc[_i].config = cfg
+	}
+}
@@ -0,0 +1,59 @@
+// Code generated by ent, DO NOT EDIT.
+
+package channelcredential
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the channelcredential type in the database.
+	Label = "channel_credential"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldCredentials holds the string denoting the credentials field in the database.
+	FieldCredentials = "credentials"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the channelcredential in the database.
+	Table = "channel_credentials"
+)
+
+// Columns holds all SQL columns for channelcredential fields.
+var Columns = []string{
+	FieldID,
+	FieldTenant,
+	FieldType,
+	FieldCredentials,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	TenantValidator func(string) error
+	// TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	TypeValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
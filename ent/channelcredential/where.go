@@ -0,0 +1,480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package channelcredential
+
+import (
+	"notifications/ent/predicate"
+	"notifications/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenant), v))
+	})
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v schema.NotificationType) predicate.ChannelCredential {
+	vc := string(v)
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldType), vc))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenant), v))
+	})
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenant), v))
+	})
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.ChannelCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldTenant), v...))
+	})
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.ChannelCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldTenant), v...))
+	})
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenant), v))
+	})
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenant), v))
+	})
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenant), v))
+	})
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenant), v))
+	})
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTenant), v))
+	})
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTenant), v))
+	})
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTenant), v))
+	})
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTenant), v))
+	})
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTenant), v))
+	})
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v schema.NotificationType) predicate.ChannelCredential {
+	vc := string(v)
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldType), vc))
+	})
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v schema.NotificationType) predicate.ChannelCredential {
+	vc := string(v)
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldType), vc))
+	})
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...schema.NotificationType) predicate.ChannelCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldType), v...))
+	})
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...schema.NotificationType) predicate.ChannelCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldType), v...))
+	})
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v schema.NotificationType) predicate.ChannelCredential {
+	vc := string(v)
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldType), vc))
+	})
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v schema.NotificationType) predicate.ChannelCredential {
+	vc := string(v)
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldType), vc))
+	})
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v schema.NotificationType) predicate.ChannelCredential {
+	vc := string(v)
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldType), vc))
+	})
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v schema.NotificationType) predicate.ChannelCredential {
+	vc := string(v)
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldType), vc))
+	})
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v schema.NotificationType) predicate.ChannelCredential {
+	vc := string(v)
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldType), vc))
+	})
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v schema.NotificationType) predicate.ChannelCredential {
+	vc := string(v)
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldType), vc))
+	})
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v schema.NotificationType) predicate.ChannelCredential {
+	vc := string(v)
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldType), vc))
+	})
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v schema.NotificationType) predicate.ChannelCredential {
+	vc := string(v)
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldType), vc))
+	})
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v schema.NotificationType) predicate.ChannelCredential {
+	vc := string(v)
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldType), vc))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ChannelCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ChannelCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ChannelCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ChannelCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ChannelCredential) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ChannelCredential) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ChannelCredential) predicate.ChannelCredential {
+	return predicate.ChannelCredential(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
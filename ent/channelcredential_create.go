@@ -0,0 +1,336 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"notifications/ent/channelcredential"
+	"notifications/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChannelCredentialCreate is the builder for creating a ChannelCredential entity.
+type ChannelCredentialCreate struct {
+	config
+	mutation *ChannelCredentialMutation
+	hooks    []Hook
+}
+
+// SetTenant sets the "tenant" field.
+func (ccc *ChannelCredentialCreate) SetTenant(s string) *ChannelCredentialCreate {
+	ccc.mutation.SetTenant(s)
+	return ccc
+}
+
+// SetType sets the "type" field.
+func (ccc *ChannelCredentialCreate) SetType(st schema.NotificationType) *ChannelCredentialCreate {
+	ccc.mutation.SetType(st)
+	return ccc
+}
+
+// SetCredentials sets the "credentials" field.
+func (ccc *ChannelCredentialCreate) SetCredentials(s schema.Credentials) *ChannelCredentialCreate {
+	ccc.mutation.SetCredentials(s)
+	return ccc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ccc *ChannelCredentialCreate) SetCreatedAt(t time.Time) *ChannelCredentialCreate {
+	ccc.mutation.SetCreatedAt(t)
+	return ccc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ccc *ChannelCredentialCreate) SetNillableCreatedAt(t *time.Time) *ChannelCredentialCreate {
+	if t != nil {
+		ccc.SetCreatedAt(*t)
+	}
+	return ccc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ccc *ChannelCredentialCreate) SetUpdatedAt(t time.Time) *ChannelCredentialCreate {
+	ccc.mutation.SetUpdatedAt(t)
+	return ccc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ccc *ChannelCredentialCreate) SetNillableUpdatedAt(t *time.Time) *ChannelCredentialCreate {
+	if t != nil {
+		ccc.SetUpdatedAt(*t)
+	}
+	return ccc
+}
+
+// Mutation returns the ChannelCredentialMutation object of the builder.
+func (ccc *ChannelCredentialCreate) Mutation() *ChannelCredentialMutation {
+	return ccc.mutation
+}
+
+// Save creates the ChannelCredential in the database.
+func (ccc *ChannelCredentialCreate) Save(ctx context.Context) (*ChannelCredential, error) {
+	var (
+		err  error
+		node *ChannelCredential
+	)
+	ccc.defaults()
+	if len(ccc.hooks) == 0 {
+		if err = ccc.check(); err != nil {
+			return nil, err
+		}
+		node, err = ccc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ChannelCredentialMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ccc.check(); err != nil {
+				return nil, err
+			}
+			ccc.mutation = mutation
+			if node, err = ccc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ccc.hooks) - 1; i >= 0; i-- {
+			if ccc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ccc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ccc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ChannelCredential)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ChannelCredentialMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ccc *ChannelCredentialCreate) SaveX(ctx context.Context) *ChannelCredential {
+	v, err := ccc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccc *ChannelCredentialCreate) Exec(ctx context.Context) error {
+	_, err := ccc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccc *ChannelCredentialCreate) ExecX(ctx context.Context) {
+	if err := ccc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ccc *ChannelCredentialCreate) defaults() {
+	if _, ok := ccc.mutation.CreatedAt(); !ok {
+		v := channelcredential.DefaultCreatedAt()
+		ccc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ccc.mutation.UpdatedAt(); !ok {
+		v := channelcredential.DefaultUpdatedAt()
+		ccc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ccc *ChannelCredentialCreate) check() error {
+	if _, ok := ccc.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "ChannelCredential.tenant"`)}
+	}
+	if v, ok := ccc.mutation.Tenant(); ok {
+		if err := channelcredential.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "ChannelCredential.tenant": %w`, err)}
+		}
+	}
+	if _, ok := ccc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "ChannelCredential.type"`)}
+	}
+	if v, ok := ccc.mutation.GetType(); ok {
+		if err := channelcredential.TypeValidator(string(v)); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ChannelCredential.type": %w`, err)}
+		}
+	}
+	if _, ok := ccc.mutation.Credentials(); !ok {
+		return &ValidationError{Name: "credentials", err: errors.New(`ent: missing required field "ChannelCredential.credentials"`)}
+	}
+	if _, ok := ccc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ChannelCredential.created_at"`)}
+	}
+	if _, ok := ccc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ChannelCredential.updated_at"`)}
+	}
+	return nil
+}
+
+func (ccc *ChannelCredentialCreate) sqlSave(ctx context.Context) (*ChannelCredential, error) {
+	_node, _spec := ccc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ccc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (ccc *ChannelCredentialCreate) createSpec() (*ChannelCredential, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChannelCredential{config: ccc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: channelcredential.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: channelcredential.FieldID,
+			},
+		}
+	)
+	if value, ok := ccc.mutation.Tenant(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: channelcredential.FieldTenant,
+		})
+		_node.Tenant = value
+	}
+	if value, ok := ccc.mutation.GetType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: channelcredential.FieldType,
+		})
+		_node.Type = value
+	}
+	if value, ok := ccc.mutation.Credentials(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: channelcredential.FieldCredentials,
+		})
+		_node.Credentials = value
+	}
+	if value, ok := ccc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: channelcredential.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := ccc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: channelcredential.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ChannelCredentialCreateBulk is the builder for creating many ChannelCredential entities in bulk.
+type ChannelCredentialCreateBulk struct {
+	config
+	builders []*ChannelCredentialCreate
+}
+
+// Save creates the ChannelCredential entities in the database.
+func (cccb *ChannelCredentialCreateBulk) Save(ctx context.Context) ([]*ChannelCredential, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(cccb.builders))
+	nodes := make([]*ChannelCredential, len(cccb.builders))
+	mutators := make([]Mutator, len(cccb.builders))
+	for i := range cccb.builders {
+		func(i int, root context.Context) {
+			builder := cccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChannelCredentialMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cccb *ChannelCredentialCreateBulk) SaveX(ctx context.Context) []*ChannelCredential {
+	v, err := cccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cccb *ChannelCredentialCreateBulk) Exec(ctx context.Context) error {
+	_, err := cccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cccb *ChannelCredentialCreateBulk) ExecX(ctx context.Context) {
+	if err := cccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
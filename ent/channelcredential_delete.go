@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"notifications/ent/channelcredential"
+	"notifications/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChannelCredentialDelete is the builder for deleting a ChannelCredential entity.
+type ChannelCredentialDelete struct {
+	config
+	hooks    []Hook
+	mutation *ChannelCredentialMutation
+}
+
+// Where appends a list predicates to the ChannelCredentialDelete builder.
+func (ccd *ChannelCredentialDelete) Where(ps ...predicate.ChannelCredential) *ChannelCredentialDelete {
+	ccd.mutation.Where(ps...)
+	return ccd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ccd *ChannelCredentialDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ccd.hooks) == 0 {
+		affected, err = ccd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ChannelCredentialMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ccd.mutation = mutation
+			affected, err = ccd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ccd.hooks) - 1; i >= 0; i-- {
+			if ccd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ccd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ccd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccd *ChannelCredentialDelete) ExecX(ctx context.Context) int {
+	n, err := ccd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ccd *ChannelCredentialDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: channelcredential.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: channelcredential.FieldID,
+			},
+		},
+	}
+	if ps := ccd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ccd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// ChannelCredentialDeleteOne is the builder for deleting a single ChannelCredential entity.
+type ChannelCredentialDeleteOne struct {
+	ccd *ChannelCredentialDelete
+}
+
+// Exec executes the deletion query.
+func (ccdo *ChannelCredentialDeleteOne) Exec(ctx context.Context) error {
+	n, err := ccdo.ccd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{channelcredential.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccdo *ChannelCredentialDeleteOne) ExecX(ctx context.Context) {
+	ccdo.ccd.ExecX(ctx)
+}
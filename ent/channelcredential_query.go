@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"notifications/ent/channelcredential"
+	"notifications/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChannelCredentialQuery is the builder for querying ChannelCredential entities.
+type ChannelCredentialQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ChannelCredential
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ChannelCredentialQuery builder.
+func (ccq *ChannelCredentialQuery) Where(ps ...predicate.ChannelCredential) *ChannelCredentialQuery {
+	ccq.predicates = append(ccq.predicates, ps...)
+	return ccq
+}
+
+// Limit adds a limit step to the query.
+func (ccq *ChannelCredentialQuery) Limit(limit int) *ChannelCredentialQuery {
+	ccq.limit = &limit
+	return ccq
+}
+
+// Offset adds an offset step to the query.
+func (ccq *ChannelCredentialQuery) Offset(offset int) *ChannelCredentialQuery {
+	ccq.offset = &offset
+	return ccq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ccq *ChannelCredentialQuery) Unique(unique bool) *ChannelCredentialQuery {
+	ccq.unique = &unique
+	return ccq
+}
+
+// Order adds an order step to the query.
+func (ccq *ChannelCredentialQuery) Order(o ...OrderFunc) *ChannelCredentialQuery {
+	ccq.order = append(ccq.order, o...)
+	return ccq
+}
+
+// First returns the first ChannelCredential entity from the query.
+// Returns a *NotFoundError when no ChannelCredential was found.
+func (ccq *ChannelCredentialQuery) First(ctx context.Context) (*ChannelCredential, error) {
+	nodes, err := ccq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{channelcredential.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ccq *ChannelCredentialQuery) FirstX(ctx context.Context) *ChannelCredential {
+	node, err := ccq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ChannelCredential ID from the query.
+// Returns a *NotFoundError when no ChannelCredential ID was found.
+func (ccq *ChannelCredentialQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ccq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{channelcredential.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ccq *ChannelCredentialQuery) FirstIDX(ctx context.Context) int {
+	id, err := ccq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ChannelCredential entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ChannelCredential entity is found.
+// Returns a *NotFoundError when no ChannelCredential entities are found.
+func (ccq *ChannelCredentialQuery) Only(ctx context.Context) (*ChannelCredential, error) {
+	nodes, err := ccq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{channelcredential.Label}
+	default:
+		return nil, &NotSingularError{channelcredential.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ccq *ChannelCredentialQuery) OnlyX(ctx context.Context) *ChannelCredential {
+	node, err := ccq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ChannelCredential ID in the query.
+// Returns a *NotSingularError when more than one ChannelCredential ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ccq *ChannelCredentialQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ccq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{channelcredential.Label}
+	default:
+		err = &NotSingularError{channelcredential.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ccq *ChannelCredentialQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ccq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ChannelCredentials.
+func (ccq *ChannelCredentialQuery) All(ctx context.Context) ([]*ChannelCredential, error) {
+	if err := ccq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ccq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ccq *ChannelCredentialQuery) AllX(ctx context.Context) []*ChannelCredential {
+	nodes, err := ccq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ChannelCredential IDs.
+func (ccq *ChannelCredentialQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := ccq.Select(channelcredential.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ccq *ChannelCredentialQuery) IDsX(ctx context.Context) []int {
+	ids, err := ccq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ccq *ChannelCredentialQuery) Count(ctx context.Context) (int, error) {
+	if err := ccq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ccq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ccq *ChannelCredentialQuery) CountX(ctx context.Context) int {
+	count, err := ccq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ccq *ChannelCredentialQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ccq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ccq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ccq *ChannelCredentialQuery) ExistX(ctx context.Context) bool {
+	exist, err := ccq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ChannelCredentialQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ccq *ChannelCredentialQuery) Clone() *ChannelCredentialQuery {
+	if ccq == nil {
+		return nil
+	}
+	return &ChannelCredentialQuery{
+		config:     ccq.config,
+		limit:      ccq.limit,
+		offset:     ccq.offset,
+		order:      append([]OrderFunc{}, ccq.order...),
+		predicates: append([]predicate.ChannelCredential{}, ccq.predicates...),
+		// clone intermediate query.
+		sql:    ccq.sql.Clone(),
+		path:   ccq.path,
+		unique: ccq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Tenant string `json:"tenant,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ChannelCredential.Query().
+//		GroupBy(channelcredential.FieldTenant).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ccq *ChannelCredentialQuery) GroupBy(field string, fields ...string) *ChannelCredentialGroupBy {
+	grbuild := &ChannelCredentialGroupBy{config: ccq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ccq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ccq.sqlQuery(ctx), nil
+	}
+	grbuild.label = channelcredential.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Tenant string `json:"tenant,omitempty"`
+//	}
+//
+//	client.ChannelCredential.Query().
+//		Select(channelcredential.FieldTenant).
+//		Scan(ctx, &v)
+func (ccq *ChannelCredentialQuery) Select(fields ...string) *ChannelCredentialSelect {
+	ccq.fields = append(ccq.fields, fields...)
+	selbuild := &ChannelCredentialSelect{ChannelCredentialQuery: ccq}
+	selbuild.label = channelcredential.Label
+	selbuild.flds, selbuild.scan = &ccq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (ccq *ChannelCredentialQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range ccq.fields {
+		if !channelcredential.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ccq.path != nil {
+		prev, err := ccq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ccq.sql = prev
+	}
+	return nil
+}
+
+func (ccq *ChannelCredentialQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ChannelCredential, error) {
+	var (
+		nodes = []*ChannelCredential{}
+		_spec = ccq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*ChannelCredential).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &ChannelCredential{config: ccq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ccq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ccq *ChannelCredentialQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ccq.querySpec()
+	_spec.Node.Columns = ccq.fields
+	if len(ccq.fields) > 0 {
+		_spec.Unique = ccq.unique != nil && *ccq.unique
+	}
+	return sqlgraph.CountNodes(ctx, ccq.driver, _spec)
+}
+
+func (ccq *ChannelCredentialQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ccq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (ccq *ChannelCredentialQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   channelcredential.Table,
+			Columns: channelcredential.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: channelcredential.FieldID,
+			},
+		},
+		From:   ccq.sql,
+		Unique: true,
+	}
+	if unique := ccq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := ccq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, channelcredential.FieldID)
+		for i := range fields {
+			if fields[i] != channelcredential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ccq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ccq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ccq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ccq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ccq *ChannelCredentialQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ccq.driver.Dialect())
+	t1 := builder.Table(channelcredential.Table)
+	columns := ccq.fields
+	if len(columns) == 0 {
+		columns = channelcredential.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ccq.sql != nil {
+		selector = ccq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ccq.unique != nil && *ccq.unique {
+		selector.Distinct()
+	}
+	for _, p := range ccq.predicates {
+		p(selector)
+	}
+	for _, p := range ccq.order {
+		p(selector)
+	}
+	if offset := ccq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ccq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ChannelCredentialGroupBy is the group-by builder for ChannelCredential entities.
+type ChannelCredentialGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ccgb *ChannelCredentialGroupBy) Aggregate(fns ...AggregateFunc) *ChannelCredentialGroupBy {
+	ccgb.fns = append(ccgb.fns, fns...)
+	return ccgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (ccgb *ChannelCredentialGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ccgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ccgb.sql = query
+	return ccgb.sqlScan(ctx, v)
+}
+
+func (ccgb *ChannelCredentialGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range ccgb.fields {
+		if !channelcredential.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := ccgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ccgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ccgb *ChannelCredentialGroupBy) sqlQuery() *sql.Selector {
+	selector := ccgb.sql.Select()
+	aggregation := make([]string, 0, len(ccgb.fns))
+	for _, fn := range ccgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(ccgb.fields)+len(ccgb.fns))
+		for _, f := range ccgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(ccgb.fields...)...)
+}
+
+// ChannelCredentialSelect is the builder for selecting fields of ChannelCredential entities.
+type ChannelCredentialSelect struct {
+	*ChannelCredentialQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ccs *ChannelCredentialSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ccs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ccs.sql = ccs.ChannelCredentialQuery.sqlQuery(ctx)
+	return ccs.sqlScan(ctx, v)
+}
+
+func (ccs *ChannelCredentialSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ccs.sql.Query()
+	if err := ccs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,411 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"notifications/ent/channelcredential"
+	"notifications/ent/predicate"
+	"notifications/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChannelCredentialUpdate is the builder for updating ChannelCredential entities.
+type ChannelCredentialUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChannelCredentialMutation
+}
+
+// Where appends a list predicates to the ChannelCredentialUpdate builder.
+func (ccu *ChannelCredentialUpdate) Where(ps ...predicate.ChannelCredential) *ChannelCredentialUpdate {
+	ccu.mutation.Where(ps...)
+	return ccu
+}
+
+// SetTenant sets the "tenant" field.
+func (ccu *ChannelCredentialUpdate) SetTenant(s string) *ChannelCredentialUpdate {
+	ccu.mutation.SetTenant(s)
+	return ccu
+}
+
+// SetType sets the "type" field.
+func (ccu *ChannelCredentialUpdate) SetType(st schema.NotificationType) *ChannelCredentialUpdate {
+	ccu.mutation.SetType(st)
+	return ccu
+}
+
+// SetCredentials sets the "credentials" field.
+func (ccu *ChannelCredentialUpdate) SetCredentials(s schema.Credentials) *ChannelCredentialUpdate {
+	ccu.mutation.SetCredentials(s)
+	return ccu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ccu *ChannelCredentialUpdate) SetUpdatedAt(t time.Time) *ChannelCredentialUpdate {
+	ccu.mutation.SetUpdatedAt(t)
+	return ccu
+}
+
+// Mutation returns the ChannelCredentialMutation object of the builder.
+func (ccu *ChannelCredentialUpdate) Mutation() *ChannelCredentialMutation {
+	return ccu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ccu *ChannelCredentialUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	ccu.defaults()
+	if len(ccu.hooks) == 0 {
+		if err = ccu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = ccu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ChannelCredentialMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ccu.check(); err != nil {
+				return 0, err
+			}
+			ccu.mutation = mutation
+			affected, err = ccu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ccu.hooks) - 1; i >= 0; i-- {
+			if ccu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ccu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ccu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccu *ChannelCredentialUpdate) SaveX(ctx context.Context) int {
+	affected, err := ccu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ccu *ChannelCredentialUpdate) Exec(ctx context.Context) error {
+	_, err := ccu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccu *ChannelCredentialUpdate) ExecX(ctx context.Context) {
+	if err := ccu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ccu *ChannelCredentialUpdate) defaults() {
+	if _, ok := ccu.mutation.UpdatedAt(); !ok {
+		v := channelcredential.UpdateDefaultUpdatedAt()
+		ccu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ccu *ChannelCredentialUpdate) check() error {
+	if v, ok := ccu.mutation.Tenant(); ok {
+		if err := channelcredential.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "ChannelCredential.tenant": %w`, err)}
+		}
+	}
+	if v, ok := ccu.mutation.GetType(); ok {
+		if err := channelcredential.TypeValidator(string(v)); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ChannelCredential.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ccu *ChannelCredentialUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   channelcredential.Table,
+			Columns: channelcredential.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: channelcredential.FieldID,
+			},
+		},
+	}
+	if ps := ccu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ccu.mutation.Tenant(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: channelcredential.FieldTenant,
+		})
+	}
+	if value, ok := ccu.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: channelcredential.FieldType,
+		})
+	}
+	if value, ok := ccu.mutation.Credentials(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: channelcredential.FieldCredentials,
+		})
+	}
+	if value, ok := ccu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: channelcredential.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ccu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{channelcredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ChannelCredentialUpdateOne is the builder for updating a single ChannelCredential entity.
+type ChannelCredentialUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChannelCredentialMutation
+}
+
+// SetTenant sets the "tenant" field.
+func (ccuo *ChannelCredentialUpdateOne) SetTenant(s string) *ChannelCredentialUpdateOne {
+	ccuo.mutation.SetTenant(s)
+	return ccuo
+}
+
+// SetType sets the "type" field.
+func (ccuo *ChannelCredentialUpdateOne) SetType(st schema.NotificationType) *ChannelCredentialUpdateOne {
+	ccuo.mutation.SetType(st)
+	return ccuo
+}
+
+// SetCredentials sets the "credentials" field.
+func (ccuo *ChannelCredentialUpdateOne) SetCredentials(s schema.Credentials) *ChannelCredentialUpdateOne {
+	ccuo.mutation.SetCredentials(s)
+	return ccuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ccuo *ChannelCredentialUpdateOne) SetUpdatedAt(t time.Time) *ChannelCredentialUpdateOne {
+	ccuo.mutation.SetUpdatedAt(t)
+	return ccuo
+}
+
+// Mutation returns the ChannelCredentialMutation object of the builder.
+func (ccuo *ChannelCredentialUpdateOne) Mutation() *ChannelCredentialMutation {
+	return ccuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ccuo *ChannelCredentialUpdateOne) Select(field string, fields ...string) *ChannelCredentialUpdateOne {
+	ccuo.fields = append([]string{field}, fields...)
+	return ccuo
+}
+
+// Save executes the query and returns the updated ChannelCredential entity.
+func (ccuo *ChannelCredentialUpdateOne) Save(ctx context.Context) (*ChannelCredential, error) {
+	var (
+		err  error
+		node *ChannelCredential
+	)
+	ccuo.defaults()
+	if len(ccuo.hooks) == 0 {
+		if err = ccuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = ccuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ChannelCredentialMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ccuo.check(); err != nil {
+				return nil, err
+			}
+			ccuo.mutation = mutation
+			node, err = ccuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ccuo.hooks) - 1; i >= 0; i-- {
+			if ccuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ccuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ccuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ChannelCredential)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ChannelCredentialMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccuo *ChannelCredentialUpdateOne) SaveX(ctx context.Context) *ChannelCredential {
+	node, err := ccuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ccuo *ChannelCredentialUpdateOne) Exec(ctx context.Context) error {
+	_, err := ccuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccuo *ChannelCredentialUpdateOne) ExecX(ctx context.Context) {
+	if err := ccuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ccuo *ChannelCredentialUpdateOne) defaults() {
+	if _, ok := ccuo.mutation.UpdatedAt(); !ok {
+		v := channelcredential.UpdateDefaultUpdatedAt()
+		ccuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ccuo *ChannelCredentialUpdateOne) check() error {
+	if v, ok := ccuo.mutation.Tenant(); ok {
+		if err := channelcredential.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "ChannelCredential.tenant": %w`, err)}
+		}
+	}
+	if v, ok := ccuo.mutation.GetType(); ok {
+		if err := channelcredential.TypeValidator(string(v)); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ChannelCredential.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ccuo *ChannelCredentialUpdateOne) sqlSave(ctx context.Context) (_node *ChannelCredential, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   channelcredential.Table,
+			Columns: channelcredential.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: channelcredential.FieldID,
+			},
+		},
+	}
+	id, ok := ccuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ChannelCredential.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ccuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, channelcredential.FieldID)
+		for _, f := range fields {
+			if !channelcredential.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != channelcredential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ccuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ccuo.mutation.Tenant(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: channelcredential.FieldTenant,
+		})
+	}
+	if value, ok := ccuo.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: channelcredential.FieldType,
+		})
+	}
+	if value, ok := ccuo.mutation.Credentials(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: channelcredential.FieldCredentials,
+		})
+	}
+	if value, ok := ccuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: channelcredential.FieldUpdatedAt,
+		})
+	}
+	_node = &ChannelCredential{config: ccuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ccuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{channelcredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -3,6 +3,10 @@
 package ent
 
 import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 )
@@ -24,7 +28,13 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	Notification []ent.Hook
+	APIKey            []ent.Hook
+	ChannelCredential []ent.Hook
+	Notification      []ent.Hook
+	Quota             []ent.Hook
+	RateLimit         []ent.Hook
+	Schedule          []ent.Hook
+	Suppression       []ent.Hook
 }
 
 // Options applies the options on the config object.
@@ -57,3 +67,27 @@ func Driver(driver dialect.Driver) Option {
 		c.driver = driver
 	}
 }
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...interface{}) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...interface{}) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...interface{}) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...interface{}) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
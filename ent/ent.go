@@ -6,7 +6,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"notifications/ent/apikey"
+	"notifications/ent/channelcredential"
 	"notifications/ent/notification"
+	"notifications/ent/quota"
+	"notifications/ent/ratelimit"
+	"notifications/ent/schedule"
+	"notifications/ent/suppression"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -31,7 +37,13 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		notification.Table: notification.ValidColumn,
+		apikey.Table:            apikey.ValidColumn,
+		channelcredential.Table: channelcredential.ValidColumn,
+		notification.Table:      notification.ValidColumn,
+		quota.Table:             quota.ValidColumn,
+		ratelimit.Table:         ratelimit.ValidColumn,
+		schedule.Table:          schedule.ValidColumn,
+		suppression.Table:       suppression.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
@@ -81,7 +93,6 @@ type AggregateFunc func(*sql.Selector) string
 //	GroupBy(field1, field2).
 //	Aggregate(ent.As(ent.Sum(field1), "sum_field1"), (ent.As(ent.Sum(field2), "sum_field2")).
 //	Scan(ctx, &v)
-//
 func As(fn AggregateFunc, end string) AggregateFunc {
 	return func(s *sql.Selector) string {
 		return sql.As(fn(s), end)
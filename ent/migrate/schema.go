@@ -8,10 +8,62 @@ import (
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "sender_id", Type: field.TypeInt},
+		{Name: "tenant", Type: field.TypeString, Nullable: true},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "prefix", Type: field.TypeString},
+		{Name: "hash", Type: field.TypeString, Unique: true},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true},
+		{Name: "channels", Type: field.TypeJSON, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apikey_sender_id",
+				Unique:  false,
+				Columns: []*schema.Column{APIKeysColumns[1]},
+			},
+		},
+	}
+	// ChannelCredentialsColumns holds the columns for the "channel_credentials" table.
+	ChannelCredentialsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant", Type: field.TypeString},
+		{Name: "type", Type: field.TypeString},
+		{Name: "credentials", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+	}
+	// ChannelCredentialsTable holds the schema information for the "channel_credentials" table.
+	ChannelCredentialsTable = &schema.Table{
+		Name:       "channel_credentials",
+		Columns:    ChannelCredentialsColumns,
+		PrimaryKey: []*schema.Column{ChannelCredentialsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "channelcredential_tenant_type",
+				Unique:  true,
+				Columns: []*schema.Column{ChannelCredentialsColumns[1], ChannelCredentialsColumns[2]},
+			},
+		},
+	}
 	// NotificationsColumns holds the columns for the "notifications" table.
 	NotificationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "sender_id", Type: field.TypeInt},
+		{Name: "tenant", Type: field.TypeString, Nullable: true},
 		{Name: "type", Type: field.TypeString, Default: "email"},
 		{Name: "payload", Type: field.TypeJSON},
 		{Name: "ttl", Type: field.TypeInt},
@@ -21,7 +73,21 @@ var (
 		{Name: "planned_at", Type: field.TypeTime},
 		{Name: "retry_at", Type: field.TypeTime, Nullable: true},
 		{Name: "retries", Type: field.TypeInt, Default: 0},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "retry_policy", Type: field.TypeJSON, Nullable: true},
+		{Name: "category", Type: field.TypeString, Nullable: true},
+		{Name: "delivery_window", Type: field.TypeJSON, Nullable: true},
 		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "lease_until", Type: field.TypeTime, Nullable: true},
+		{Name: "worker_id", Type: field.TypeString, Nullable: true},
+		{Name: "dedup_key", Type: field.TypeString, Nullable: true},
+		{Name: "original_id", Type: field.TypeInt, Nullable: true},
+		{Name: "locale", Type: field.TypeString, Nullable: true},
+		{Name: "digest_key", Type: field.TypeString, Nullable: true},
+		{Name: "digest_id", Type: field.TypeInt, Nullable: true},
+		{Name: "schedule_id", Type: field.TypeInt, Nullable: true},
+		{Name: "occurrence_at", Type: field.TypeTime, Nullable: true},
+		{Name: "trace_context", Type: field.TypeJSON, Nullable: true},
 	}
 	// NotificationsTable holds the schema information for the "notifications" table.
 	NotificationsTable = &schema.Table{
@@ -32,28 +98,161 @@ var (
 			{
 				Name:    "notification_type",
 				Unique:  false,
-				Columns: []*schema.Column{NotificationsColumns[2]},
+				Columns: []*schema.Column{NotificationsColumns[3]},
 			},
 			{
 				Name:    "notification_status",
 				Unique:  false,
-				Columns: []*schema.Column{NotificationsColumns[5]},
+				Columns: []*schema.Column{NotificationsColumns[6]},
 			},
 			{
 				Name:    "notification_planned_at",
 				Unique:  false,
-				Columns: []*schema.Column{NotificationsColumns[8]},
+				Columns: []*schema.Column{NotificationsColumns[9]},
 			},
 			{
 				Name:    "notification_sent_at",
 				Unique:  false,
-				Columns: []*schema.Column{NotificationsColumns[11]},
+				Columns: []*schema.Column{NotificationsColumns[16]},
+			},
+			{
+				Name:    "notification_sender_id_type_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[1], NotificationsColumns[3], NotificationsColumns[7]},
+			},
+			{
+				Name:    "notification_priority_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[12], NotificationsColumns[7]},
+			},
+			{
+				Name:    "notification_lease_until",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[17]},
+			},
+			{
+				Name:    "notification_sender_id_dedup_key_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[1], NotificationsColumns[19], NotificationsColumns[7]},
+			},
+			{
+				Name:    "notification_schedule_id_occurrence_at",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationsColumns[24], NotificationsColumns[25]},
+			},
+			{
+				Name:    "notification_sender_id_digest_key_status",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[1], NotificationsColumns[22], NotificationsColumns[6]},
+			},
+		},
+	}
+	// QuotaColumns holds the columns for the "quota" table.
+	QuotaColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "sender_id", Type: field.TypeInt},
+		{Name: "type", Type: field.TypeString},
+		{Name: "per_minute", Type: field.TypeInt, Default: 0},
+		{Name: "per_day", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+	}
+	// QuotaTable holds the schema information for the "quota" table.
+	QuotaTable = &schema.Table{
+		Name:       "quota",
+		Columns:    QuotaColumns,
+		PrimaryKey: []*schema.Column{QuotaColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "quota_sender_id_type",
+				Unique:  true,
+				Columns: []*schema.Column{QuotaColumns[1], QuotaColumns[2]},
+			},
+		},
+	}
+	// RateLimitsColumns holds the columns for the "rate_limits" table.
+	RateLimitsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "tokens", Type: field.TypeFloat64},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+	}
+	// RateLimitsTable holds the schema information for the "rate_limits" table.
+	RateLimitsTable = &schema.Table{
+		Name:       "rate_limits",
+		Columns:    RateLimitsColumns,
+		PrimaryKey: []*schema.Column{RateLimitsColumns[0]},
+	}
+	// SchedulesColumns holds the columns for the "schedules" table.
+	SchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "sender_id", Type: field.TypeInt},
+		{Name: "tenant", Type: field.TypeString, Nullable: true},
+		{Name: "type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeJSON},
+		{Name: "ttl", Type: field.TypeInt},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "category", Type: field.TypeString, Nullable: true},
+		{Name: "cron", Type: field.TypeString},
+		{Name: "timezone", Type: field.TypeString, Nullable: true},
+		{Name: "ends_at", Type: field.TypeTime, Nullable: true},
+		{Name: "next_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+	}
+	// SchedulesTable holds the schema information for the "schedules" table.
+	SchedulesTable = &schema.Table{
+		Name:       "schedules",
+		Columns:    SchedulesColumns,
+		PrimaryKey: []*schema.Column{SchedulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "schedule_sender_id",
+				Unique:  false,
+				Columns: []*schema.Column{SchedulesColumns[1]},
+			},
+			{
+				Name:    "schedule_next_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{SchedulesColumns[11]},
+			},
+		},
+	}
+	// SuppressionsColumns holds the columns for the "suppressions" table.
+	SuppressionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "sender_id", Type: field.TypeInt},
+		{Name: "tenant", Type: field.TypeString, Nullable: true},
+		{Name: "type", Type: field.TypeString},
+		{Name: "address", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+	}
+	// SuppressionsTable holds the schema information for the "suppressions" table.
+	SuppressionsTable = &schema.Table{
+		Name:       "suppressions",
+		Columns:    SuppressionsColumns,
+		PrimaryKey: []*schema.Column{SuppressionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "suppression_sender_id_tenant_type_address",
+				Unique:  true,
+				Columns: []*schema.Column{SuppressionsColumns[1], SuppressionsColumns[2], SuppressionsColumns[3], SuppressionsColumns[4]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
+		ChannelCredentialsTable,
 		NotificationsTable,
+		QuotaTable,
+		RateLimitsTable,
+		SchedulesTable,
+		SuppressionsTable,
 	}
 )
 
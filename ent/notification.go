@@ -20,13 +20,15 @@ type Notification struct {
 	ID int `json:"id,omitempty"`
 	// SenderID holds the value of the "sender_id" field.
 	SenderID int `json:"sender_id,omitempty"`
+	// tenant of sender, selects credentials of channel, credentials of config are used if empty
+	Tenant string `json:"tenant,omitempty"`
 	// types in (plain|sms|email|whatsapp|push)
 	Type schema.NotificationType `json:"type,omitempty"`
 	// message payload as map<string, any> variable by type
 	Payload schema.Payload `json:"payload,omitempty"`
 	// time to live in seconds
 	TTL int `json:"ttl,omitempty"`
-	// statuses in (draft|pending|sent|retry|fail)
+	// statuses in (draft|pending|sent|retry|fail|processing|suppressed|held|digested)
 	Status schema.NotificationStatus `json:"status,omitempty"`
 	// creation time of notification
 	CreatedAt time.Time `json:"created_at,omitempty"`
@@ -38,8 +40,36 @@ type Notification struct {
 	RetryAt *time.Time `json:"retry_at,omitempty"`
 	// count of retries to send notification
 	Retries int `json:"retries,omitempty"`
+	// notifications with higher priority are sent first
+	Priority int `json:"priority,omitempty"`
+	// retry policy of notification, overrides configured policy for type
+	RetryPolicy *schema.RetryPolicy `json:"retry_policy,omitempty"`
+	// category of notification, e.g. marketing, selects configured delivery window
+	Category string `json:"category,omitempty"`
+	// delivery window and timezone of recipient, overrides configured window
+	DeliveryWindow *schema.DeliveryWindow `json:"delivery_window,omitempty"`
 	// time of notification was sent
 	SentAt *time.Time `json:"sent_at,omitempty"`
+	// time until notification in processing is claimed by worker, reclaimed after
+	LeaseUntil *time.Time `json:"lease_until,omitempty"`
+	// identifier of worker processing notification
+	WorkerID *string `json:"worker_id,omitempty"`
+	// hash of type, recipient and payload, the same notifications of sender are suppressed within window
+	DedupKey string `json:"dedup_key,omitempty"`
+	// original notification of suppressed duplicate
+	OriginalID *int `json:"original_id,omitempty"`
+	// locale of recipient, e.g. ru or kk-KZ, selects localized templates
+	Locale string `json:"locale,omitempty"`
+	// notifications of recipient with the same digest key are held until planned time and merged into digest
+	DigestKey string `json:"digest_key,omitempty"`
+	// digest notification merged this notification
+	DigestID *int `json:"digest_id,omitempty"`
+	// schedule materialized notification, notification is unique for schedule and occurrence
+	ScheduleID *int `json:"schedule_id,omitempty"`
+	// occurrence of schedule materialized notification, planned time may be deferred to delivery window
+	OccurrenceAt *time.Time `json:"occurrence_at,omitempty"`
+	// trace context of request enqueued notification, worker continues trace with it
+	TraceContext map[string]string `json:"trace_context,omitempty"`
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -47,13 +77,13 @@ func (*Notification) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case notification.FieldPayload:
+		case notification.FieldPayload, notification.FieldRetryPolicy, notification.FieldDeliveryWindow, notification.FieldTraceContext:
 			values[i] = new([]byte)
-		case notification.FieldID, notification.FieldSenderID, notification.FieldTTL, notification.FieldRetries:
+		case notification.FieldID, notification.FieldSenderID, notification.FieldTTL, notification.FieldRetries, notification.FieldPriority, notification.FieldOriginalID, notification.FieldDigestID, notification.FieldScheduleID:
 			values[i] = new(sql.NullInt64)
-		case notification.FieldType, notification.FieldStatus:
+		case notification.FieldTenant, notification.FieldType, notification.FieldStatus, notification.FieldCategory, notification.FieldWorkerID, notification.FieldDedupKey, notification.FieldLocale, notification.FieldDigestKey:
 			values[i] = new(sql.NullString)
-		case notification.FieldCreatedAt, notification.FieldUpdatedAt, notification.FieldPlannedAt, notification.FieldRetryAt, notification.FieldSentAt:
+		case notification.FieldCreatedAt, notification.FieldUpdatedAt, notification.FieldPlannedAt, notification.FieldRetryAt, notification.FieldSentAt, notification.FieldLeaseUntil, notification.FieldOccurrenceAt:
 			values[i] = new(sql.NullTime)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Notification", columns[i])
@@ -82,6 +112,12 @@ func (n *Notification) assignValues(columns []string, values []interface{}) erro
 			} else if value.Valid {
 				n.SenderID = int(value.Int64)
 			}
+		case notification.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				n.Tenant = value.String
+			}
 		case notification.FieldType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field type", values[i])
@@ -139,6 +175,34 @@ func (n *Notification) assignValues(columns []string, values []interface{}) erro
 			} else if value.Valid {
 				n.Retries = int(value.Int64)
 			}
+		case notification.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				n.Priority = int(value.Int64)
+			}
+		case notification.FieldRetryPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field retry_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &n.RetryPolicy); err != nil {
+					return fmt.Errorf("unmarshal field retry_policy: %w", err)
+				}
+			}
+		case notification.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				n.Category = value.String
+			}
+		case notification.FieldDeliveryWindow:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_window", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &n.DeliveryWindow); err != nil {
+					return fmt.Errorf("unmarshal field delivery_window: %w", err)
+				}
+			}
 		case notification.FieldSentAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field sent_at", values[i])
@@ -146,6 +210,74 @@ func (n *Notification) assignValues(columns []string, values []interface{}) erro
 				n.SentAt = new(time.Time)
 				*n.SentAt = value.Time
 			}
+		case notification.FieldLeaseUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field lease_until", values[i])
+			} else if value.Valid {
+				n.LeaseUntil = new(time.Time)
+				*n.LeaseUntil = value.Time
+			}
+		case notification.FieldWorkerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field worker_id", values[i])
+			} else if value.Valid {
+				n.WorkerID = new(string)
+				*n.WorkerID = value.String
+			}
+		case notification.FieldDedupKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dedup_key", values[i])
+			} else if value.Valid {
+				n.DedupKey = value.String
+			}
+		case notification.FieldOriginalID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field original_id", values[i])
+			} else if value.Valid {
+				n.OriginalID = new(int)
+				*n.OriginalID = int(value.Int64)
+			}
+		case notification.FieldLocale:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field locale", values[i])
+			} else if value.Valid {
+				n.Locale = value.String
+			}
+		case notification.FieldDigestKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field digest_key", values[i])
+			} else if value.Valid {
+				n.DigestKey = value.String
+			}
+		case notification.FieldDigestID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field digest_id", values[i])
+			} else if value.Valid {
+				n.DigestID = new(int)
+				*n.DigestID = int(value.Int64)
+			}
+		case notification.FieldScheduleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule_id", values[i])
+			} else if value.Valid {
+				n.ScheduleID = new(int)
+				*n.ScheduleID = int(value.Int64)
+			}
+		case notification.FieldOccurrenceAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field occurrence_at", values[i])
+			} else if value.Valid {
+				n.OccurrenceAt = new(time.Time)
+				*n.OccurrenceAt = value.Time
+			}
+		case notification.FieldTraceContext:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field trace_context", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &n.TraceContext); err != nil {
+					return fmt.Errorf("unmarshal field trace_context: %w", err)
+				}
+			}
 		}
 	}
 	return nil
@@ -177,6 +309,9 @@ func (n *Notification) String() string {
 	builder.WriteString("sender_id=")
 	builder.WriteString(fmt.Sprintf("%v", n.SenderID))
 	builder.WriteString(", ")
+	builder.WriteString("tenant=")
+	builder.WriteString(n.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("type=")
 	builder.WriteString(fmt.Sprintf("%v", n.Type))
 	builder.WriteString(", ")
@@ -206,10 +341,64 @@ func (n *Notification) String() string {
 	builder.WriteString("retries=")
 	builder.WriteString(fmt.Sprintf("%v", n.Retries))
 	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", n.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("retry_policy=")
+	builder.WriteString(fmt.Sprintf("%v", n.RetryPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(n.Category)
+	builder.WriteString(", ")
+	builder.WriteString("delivery_window=")
+	builder.WriteString(fmt.Sprintf("%v", n.DeliveryWindow))
+	builder.WriteString(", ")
 	if v := n.SentAt; v != nil {
 		builder.WriteString("sent_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	if v := n.LeaseUntil; v != nil {
+		builder.WriteString("lease_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := n.WorkerID; v != nil {
+		builder.WriteString("worker_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("dedup_key=")
+	builder.WriteString(n.DedupKey)
+	builder.WriteString(", ")
+	if v := n.OriginalID; v != nil {
+		builder.WriteString("original_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("locale=")
+	builder.WriteString(n.Locale)
+	builder.WriteString(", ")
+	builder.WriteString("digest_key=")
+	builder.WriteString(n.DigestKey)
+	builder.WriteString(", ")
+	if v := n.DigestID; v != nil {
+		builder.WriteString("digest_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := n.ScheduleID; v != nil {
+		builder.WriteString("schedule_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := n.OccurrenceAt; v != nil {
+		builder.WriteString("occurrence_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("trace_context=")
+	builder.WriteString(fmt.Sprintf("%v", n.TraceContext))
 	builder.WriteByte(')')
 	return builder.String()
 }
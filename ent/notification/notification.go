@@ -14,6 +14,8 @@ const (
 	FieldID = "id"
 	// FieldSenderID holds the string denoting the sender_id field in the database.
 	FieldSenderID = "sender_id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
 	// FieldPayload holds the string denoting the payload field in the database.
@@ -32,8 +34,36 @@ const (
 	FieldRetryAt = "retry_at"
 	// FieldRetries holds the string denoting the retries field in the database.
 	FieldRetries = "retries"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldRetryPolicy holds the string denoting the retry_policy field in the database.
+	FieldRetryPolicy = "retry_policy"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldDeliveryWindow holds the string denoting the delivery_window field in the database.
+	FieldDeliveryWindow = "delivery_window"
 	// FieldSentAt holds the string denoting the sent_at field in the database.
 	FieldSentAt = "sent_at"
+	// FieldLeaseUntil holds the string denoting the lease_until field in the database.
+	FieldLeaseUntil = "lease_until"
+	// FieldWorkerID holds the string denoting the worker_id field in the database.
+	FieldWorkerID = "worker_id"
+	// FieldDedupKey holds the string denoting the dedup_key field in the database.
+	FieldDedupKey = "dedup_key"
+	// FieldOriginalID holds the string denoting the original_id field in the database.
+	FieldOriginalID = "original_id"
+	// FieldLocale holds the string denoting the locale field in the database.
+	FieldLocale = "locale"
+	// FieldDigestKey holds the string denoting the digest_key field in the database.
+	FieldDigestKey = "digest_key"
+	// FieldDigestID holds the string denoting the digest_id field in the database.
+	FieldDigestID = "digest_id"
+	// FieldScheduleID holds the string denoting the schedule_id field in the database.
+	FieldScheduleID = "schedule_id"
+	// FieldOccurrenceAt holds the string denoting the occurrence_at field in the database.
+	FieldOccurrenceAt = "occurrence_at"
+	// FieldTraceContext holds the string denoting the trace_context field in the database.
+	FieldTraceContext = "trace_context"
 	// Table holds the table name of the notification in the database.
 	Table = "notifications"
 )
@@ -42,6 +72,7 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldSenderID,
+	FieldTenant,
 	FieldType,
 	FieldPayload,
 	FieldTTL,
@@ -51,7 +82,21 @@ var Columns = []string{
 	FieldPlannedAt,
 	FieldRetryAt,
 	FieldRetries,
+	FieldPriority,
+	FieldRetryPolicy,
+	FieldCategory,
+	FieldDeliveryWindow,
 	FieldSentAt,
+	FieldLeaseUntil,
+	FieldWorkerID,
+	FieldDedupKey,
+	FieldOriginalID,
+	FieldLocale,
+	FieldDigestKey,
+	FieldDigestID,
+	FieldScheduleID,
+	FieldOccurrenceAt,
+	FieldTraceContext,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -83,4 +128,6 @@ var (
 	DefaultPlannedAt func() time.Time
 	// DefaultRetries holds the default value on creation for the "retries" field.
 	DefaultRetries int
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority int
 )
@@ -27,6 +27,20 @@ func (nc *NotificationCreate) SetSenderID(i int) *NotificationCreate {
 	return nc
 }
 
+// SetTenant sets the "tenant" field.
+func (nc *NotificationCreate) SetTenant(s string) *NotificationCreate {
+	nc.mutation.SetTenant(s)
+	return nc
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableTenant(s *string) *NotificationCreate {
+	if s != nil {
+		nc.SetTenant(*s)
+	}
+	return nc
+}
+
 // SetType sets the "type" field.
 func (nc *NotificationCreate) SetType(st schema.NotificationType) *NotificationCreate {
 	nc.mutation.SetType(st)
@@ -137,6 +151,46 @@ func (nc *NotificationCreate) SetNillableRetries(i *int) *NotificationCreate {
 	return nc
 }
 
+// SetPriority sets the "priority" field.
+func (nc *NotificationCreate) SetPriority(i int) *NotificationCreate {
+	nc.mutation.SetPriority(i)
+	return nc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillablePriority(i *int) *NotificationCreate {
+	if i != nil {
+		nc.SetPriority(*i)
+	}
+	return nc
+}
+
+// SetRetryPolicy sets the "retry_policy" field.
+func (nc *NotificationCreate) SetRetryPolicy(sp *schema.RetryPolicy) *NotificationCreate {
+	nc.mutation.SetRetryPolicy(sp)
+	return nc
+}
+
+// SetCategory sets the "category" field.
+func (nc *NotificationCreate) SetCategory(s string) *NotificationCreate {
+	nc.mutation.SetCategory(s)
+	return nc
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableCategory(s *string) *NotificationCreate {
+	if s != nil {
+		nc.SetCategory(*s)
+	}
+	return nc
+}
+
+// SetDeliveryWindow sets the "delivery_window" field.
+func (nc *NotificationCreate) SetDeliveryWindow(sw *schema.DeliveryWindow) *NotificationCreate {
+	nc.mutation.SetDeliveryWindow(sw)
+	return nc
+}
+
 // SetSentAt sets the "sent_at" field.
 func (nc *NotificationCreate) SetSentAt(t time.Time) *NotificationCreate {
 	nc.mutation.SetSentAt(t)
@@ -151,6 +205,138 @@ func (nc *NotificationCreate) SetNillableSentAt(t *time.Time) *NotificationCreat
 	return nc
 }
 
+// SetLeaseUntil sets the "lease_until" field.
+func (nc *NotificationCreate) SetLeaseUntil(t time.Time) *NotificationCreate {
+	nc.mutation.SetLeaseUntil(t)
+	return nc
+}
+
+// SetNillableLeaseUntil sets the "lease_until" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableLeaseUntil(t *time.Time) *NotificationCreate {
+	if t != nil {
+		nc.SetLeaseUntil(*t)
+	}
+	return nc
+}
+
+// SetWorkerID sets the "worker_id" field.
+func (nc *NotificationCreate) SetWorkerID(s string) *NotificationCreate {
+	nc.mutation.SetWorkerID(s)
+	return nc
+}
+
+// SetNillableWorkerID sets the "worker_id" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableWorkerID(s *string) *NotificationCreate {
+	if s != nil {
+		nc.SetWorkerID(*s)
+	}
+	return nc
+}
+
+// SetDedupKey sets the "dedup_key" field.
+func (nc *NotificationCreate) SetDedupKey(s string) *NotificationCreate {
+	nc.mutation.SetDedupKey(s)
+	return nc
+}
+
+// SetNillableDedupKey sets the "dedup_key" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableDedupKey(s *string) *NotificationCreate {
+	if s != nil {
+		nc.SetDedupKey(*s)
+	}
+	return nc
+}
+
+// SetOriginalID sets the "original_id" field.
+func (nc *NotificationCreate) SetOriginalID(i int) *NotificationCreate {
+	nc.mutation.SetOriginalID(i)
+	return nc
+}
+
+// SetNillableOriginalID sets the "original_id" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableOriginalID(i *int) *NotificationCreate {
+	if i != nil {
+		nc.SetOriginalID(*i)
+	}
+	return nc
+}
+
+// SetLocale sets the "locale" field.
+func (nc *NotificationCreate) SetLocale(s string) *NotificationCreate {
+	nc.mutation.SetLocale(s)
+	return nc
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableLocale(s *string) *NotificationCreate {
+	if s != nil {
+		nc.SetLocale(*s)
+	}
+	return nc
+}
+
+// SetDigestKey sets the "digest_key" field.
+func (nc *NotificationCreate) SetDigestKey(s string) *NotificationCreate {
+	nc.mutation.SetDigestKey(s)
+	return nc
+}
+
+// SetNillableDigestKey sets the "digest_key" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableDigestKey(s *string) *NotificationCreate {
+	if s != nil {
+		nc.SetDigestKey(*s)
+	}
+	return nc
+}
+
+// SetDigestID sets the "digest_id" field.
+func (nc *NotificationCreate) SetDigestID(i int) *NotificationCreate {
+	nc.mutation.SetDigestID(i)
+	return nc
+}
+
+// SetNillableDigestID sets the "digest_id" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableDigestID(i *int) *NotificationCreate {
+	if i != nil {
+		nc.SetDigestID(*i)
+	}
+	return nc
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (nc *NotificationCreate) SetScheduleID(i int) *NotificationCreate {
+	nc.mutation.SetScheduleID(i)
+	return nc
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableScheduleID(i *int) *NotificationCreate {
+	if i != nil {
+		nc.SetScheduleID(*i)
+	}
+	return nc
+}
+
+// SetOccurrenceAt sets the "occurrence_at" field.
+func (nc *NotificationCreate) SetOccurrenceAt(t time.Time) *NotificationCreate {
+	nc.mutation.SetOccurrenceAt(t)
+	return nc
+}
+
+// SetNillableOccurrenceAt sets the "occurrence_at" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableOccurrenceAt(t *time.Time) *NotificationCreate {
+	if t != nil {
+		nc.SetOccurrenceAt(*t)
+	}
+	return nc
+}
+
+// SetTraceContext sets the "trace_context" field.
+func (nc *NotificationCreate) SetTraceContext(m map[string]string) *NotificationCreate {
+	nc.mutation.SetTraceContext(m)
+	return nc
+}
+
 // Mutation returns the NotificationMutation object of the builder.
 func (nc *NotificationCreate) Mutation() *NotificationMutation {
 	return nc.mutation
@@ -252,6 +438,10 @@ func (nc *NotificationCreate) defaults() {
 		v := notification.DefaultRetries
 		nc.mutation.SetRetries(v)
 	}
+	if _, ok := nc.mutation.Priority(); !ok {
+		v := notification.DefaultPriority
+		nc.mutation.SetPriority(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -293,6 +483,9 @@ func (nc *NotificationCreate) check() error {
 	if _, ok := nc.mutation.Retries(); !ok {
 		return &ValidationError{Name: "retries", err: errors.New(`ent: missing required field "Notification.retries"`)}
 	}
+	if _, ok := nc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "Notification.priority"`)}
+	}
 	return nil
 }
 
@@ -328,6 +521,14 @@ func (nc *NotificationCreate) createSpec() (*Notification, *sqlgraph.CreateSpec)
 		})
 		_node.SenderID = value
 	}
+	if value, ok := nc.mutation.Tenant(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldTenant,
+		})
+		_node.Tenant = value
+	}
 	if value, ok := nc.mutation.GetType(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -400,6 +601,38 @@ func (nc *NotificationCreate) createSpec() (*Notification, *sqlgraph.CreateSpec)
 		})
 		_node.Retries = value
 	}
+	if value, ok := nc.mutation.Priority(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: notification.FieldPriority,
+		})
+		_node.Priority = value
+	}
+	if value, ok := nc.mutation.RetryPolicy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: notification.FieldRetryPolicy,
+		})
+		_node.RetryPolicy = value
+	}
+	if value, ok := nc.mutation.Category(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldCategory,
+		})
+		_node.Category = value
+	}
+	if value, ok := nc.mutation.DeliveryWindow(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: notification.FieldDeliveryWindow,
+		})
+		_node.DeliveryWindow = value
+	}
 	if value, ok := nc.mutation.SentAt(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -408,6 +641,86 @@ func (nc *NotificationCreate) createSpec() (*Notification, *sqlgraph.CreateSpec)
 		})
 		_node.SentAt = &value
 	}
+	if value, ok := nc.mutation.LeaseUntil(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: notification.FieldLeaseUntil,
+		})
+		_node.LeaseUntil = &value
+	}
+	if value, ok := nc.mutation.WorkerID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldWorkerID,
+		})
+		_node.WorkerID = &value
+	}
+	if value, ok := nc.mutation.DedupKey(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldDedupKey,
+		})
+		_node.DedupKey = value
+	}
+	if value, ok := nc.mutation.OriginalID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: notification.FieldOriginalID,
+		})
+		_node.OriginalID = &value
+	}
+	if value, ok := nc.mutation.Locale(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldLocale,
+		})
+		_node.Locale = value
+	}
+	if value, ok := nc.mutation.DigestKey(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldDigestKey,
+		})
+		_node.DigestKey = value
+	}
+	if value, ok := nc.mutation.DigestID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: notification.FieldDigestID,
+		})
+		_node.DigestID = &value
+	}
+	if value, ok := nc.mutation.ScheduleID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: notification.FieldScheduleID,
+		})
+		_node.ScheduleID = &value
+	}
+	if value, ok := nc.mutation.OccurrenceAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: notification.FieldOccurrenceAt,
+		})
+		_node.OccurrenceAt = &value
+	}
+	if value, ok := nc.mutation.TraceContext(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: notification.FieldTraceContext,
+		})
+		_node.TraceContext = value
+	}
 	return _node, _spec
 }
 
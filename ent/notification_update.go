@@ -42,6 +42,26 @@ func (nu *NotificationUpdate) AddSenderID(i int) *NotificationUpdate {
 	return nu
 }
 
+// SetTenant sets the "tenant" field.
+func (nu *NotificationUpdate) SetTenant(s string) *NotificationUpdate {
+	nu.mutation.SetTenant(s)
+	return nu
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableTenant(s *string) *NotificationUpdate {
+	if s != nil {
+		nu.SetTenant(*s)
+	}
+	return nu
+}
+
+// ClearTenant clears the value of the "tenant" field.
+func (nu *NotificationUpdate) ClearTenant() *NotificationUpdate {
+	nu.mutation.ClearTenant()
+	return nu
+}
+
 // SetType sets the "type" field.
 func (nu *NotificationUpdate) SetType(st schema.NotificationType) *NotificationUpdate {
 	nu.mutation.SetType(st)
@@ -150,6 +170,71 @@ func (nu *NotificationUpdate) AddRetries(i int) *NotificationUpdate {
 	return nu
 }
 
+// SetPriority sets the "priority" field.
+func (nu *NotificationUpdate) SetPriority(i int) *NotificationUpdate {
+	nu.mutation.ResetPriority()
+	nu.mutation.SetPriority(i)
+	return nu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillablePriority(i *int) *NotificationUpdate {
+	if i != nil {
+		nu.SetPriority(*i)
+	}
+	return nu
+}
+
+// AddPriority adds i to the "priority" field.
+func (nu *NotificationUpdate) AddPriority(i int) *NotificationUpdate {
+	nu.mutation.AddPriority(i)
+	return nu
+}
+
+// SetRetryPolicy sets the "retry_policy" field.
+func (nu *NotificationUpdate) SetRetryPolicy(sp *schema.RetryPolicy) *NotificationUpdate {
+	nu.mutation.SetRetryPolicy(sp)
+	return nu
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (nu *NotificationUpdate) ClearRetryPolicy() *NotificationUpdate {
+	nu.mutation.ClearRetryPolicy()
+	return nu
+}
+
+// SetCategory sets the "category" field.
+func (nu *NotificationUpdate) SetCategory(s string) *NotificationUpdate {
+	nu.mutation.SetCategory(s)
+	return nu
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableCategory(s *string) *NotificationUpdate {
+	if s != nil {
+		nu.SetCategory(*s)
+	}
+	return nu
+}
+
+// ClearCategory clears the value of the "category" field.
+func (nu *NotificationUpdate) ClearCategory() *NotificationUpdate {
+	nu.mutation.ClearCategory()
+	return nu
+}
+
+// SetDeliveryWindow sets the "delivery_window" field.
+func (nu *NotificationUpdate) SetDeliveryWindow(sw *schema.DeliveryWindow) *NotificationUpdate {
+	nu.mutation.SetDeliveryWindow(sw)
+	return nu
+}
+
+// ClearDeliveryWindow clears the value of the "delivery_window" field.
+func (nu *NotificationUpdate) ClearDeliveryWindow() *NotificationUpdate {
+	nu.mutation.ClearDeliveryWindow()
+	return nu
+}
+
 // SetSentAt sets the "sent_at" field.
 func (nu *NotificationUpdate) SetSentAt(t time.Time) *NotificationUpdate {
 	nu.mutation.SetSentAt(t)
@@ -170,6 +255,219 @@ func (nu *NotificationUpdate) ClearSentAt() *NotificationUpdate {
 	return nu
 }
 
+// SetLeaseUntil sets the "lease_until" field.
+func (nu *NotificationUpdate) SetLeaseUntil(t time.Time) *NotificationUpdate {
+	nu.mutation.SetLeaseUntil(t)
+	return nu
+}
+
+// SetNillableLeaseUntil sets the "lease_until" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableLeaseUntil(t *time.Time) *NotificationUpdate {
+	if t != nil {
+		nu.SetLeaseUntil(*t)
+	}
+	return nu
+}
+
+// ClearLeaseUntil clears the value of the "lease_until" field.
+func (nu *NotificationUpdate) ClearLeaseUntil() *NotificationUpdate {
+	nu.mutation.ClearLeaseUntil()
+	return nu
+}
+
+// SetWorkerID sets the "worker_id" field.
+func (nu *NotificationUpdate) SetWorkerID(s string) *NotificationUpdate {
+	nu.mutation.SetWorkerID(s)
+	return nu
+}
+
+// SetNillableWorkerID sets the "worker_id" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableWorkerID(s *string) *NotificationUpdate {
+	if s != nil {
+		nu.SetWorkerID(*s)
+	}
+	return nu
+}
+
+// ClearWorkerID clears the value of the "worker_id" field.
+func (nu *NotificationUpdate) ClearWorkerID() *NotificationUpdate {
+	nu.mutation.ClearWorkerID()
+	return nu
+}
+
+// SetDedupKey sets the "dedup_key" field.
+func (nu *NotificationUpdate) SetDedupKey(s string) *NotificationUpdate {
+	nu.mutation.SetDedupKey(s)
+	return nu
+}
+
+// SetNillableDedupKey sets the "dedup_key" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableDedupKey(s *string) *NotificationUpdate {
+	if s != nil {
+		nu.SetDedupKey(*s)
+	}
+	return nu
+}
+
+// ClearDedupKey clears the value of the "dedup_key" field.
+func (nu *NotificationUpdate) ClearDedupKey() *NotificationUpdate {
+	nu.mutation.ClearDedupKey()
+	return nu
+}
+
+// SetOriginalID sets the "original_id" field.
+func (nu *NotificationUpdate) SetOriginalID(i int) *NotificationUpdate {
+	nu.mutation.ResetOriginalID()
+	nu.mutation.SetOriginalID(i)
+	return nu
+}
+
+// SetNillableOriginalID sets the "original_id" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableOriginalID(i *int) *NotificationUpdate {
+	if i != nil {
+		nu.SetOriginalID(*i)
+	}
+	return nu
+}
+
+// AddOriginalID adds i to the "original_id" field.
+func (nu *NotificationUpdate) AddOriginalID(i int) *NotificationUpdate {
+	nu.mutation.AddOriginalID(i)
+	return nu
+}
+
+// ClearOriginalID clears the value of the "original_id" field.
+func (nu *NotificationUpdate) ClearOriginalID() *NotificationUpdate {
+	nu.mutation.ClearOriginalID()
+	return nu
+}
+
+// SetLocale sets the "locale" field.
+func (nu *NotificationUpdate) SetLocale(s string) *NotificationUpdate {
+	nu.mutation.SetLocale(s)
+	return nu
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableLocale(s *string) *NotificationUpdate {
+	if s != nil {
+		nu.SetLocale(*s)
+	}
+	return nu
+}
+
+// ClearLocale clears the value of the "locale" field.
+func (nu *NotificationUpdate) ClearLocale() *NotificationUpdate {
+	nu.mutation.ClearLocale()
+	return nu
+}
+
+// SetDigestKey sets the "digest_key" field.
+func (nu *NotificationUpdate) SetDigestKey(s string) *NotificationUpdate {
+	nu.mutation.SetDigestKey(s)
+	return nu
+}
+
+// SetNillableDigestKey sets the "digest_key" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableDigestKey(s *string) *NotificationUpdate {
+	if s != nil {
+		nu.SetDigestKey(*s)
+	}
+	return nu
+}
+
+// ClearDigestKey clears the value of the "digest_key" field.
+func (nu *NotificationUpdate) ClearDigestKey() *NotificationUpdate {
+	nu.mutation.ClearDigestKey()
+	return nu
+}
+
+// SetDigestID sets the "digest_id" field.
+func (nu *NotificationUpdate) SetDigestID(i int) *NotificationUpdate {
+	nu.mutation.ResetDigestID()
+	nu.mutation.SetDigestID(i)
+	return nu
+}
+
+// SetNillableDigestID sets the "digest_id" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableDigestID(i *int) *NotificationUpdate {
+	if i != nil {
+		nu.SetDigestID(*i)
+	}
+	return nu
+}
+
+// AddDigestID adds i to the "digest_id" field.
+func (nu *NotificationUpdate) AddDigestID(i int) *NotificationUpdate {
+	nu.mutation.AddDigestID(i)
+	return nu
+}
+
+// ClearDigestID clears the value of the "digest_id" field.
+func (nu *NotificationUpdate) ClearDigestID() *NotificationUpdate {
+	nu.mutation.ClearDigestID()
+	return nu
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (nu *NotificationUpdate) SetScheduleID(i int) *NotificationUpdate {
+	nu.mutation.ResetScheduleID()
+	nu.mutation.SetScheduleID(i)
+	return nu
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableScheduleID(i *int) *NotificationUpdate {
+	if i != nil {
+		nu.SetScheduleID(*i)
+	}
+	return nu
+}
+
+// AddScheduleID adds i to the "schedule_id" field.
+func (nu *NotificationUpdate) AddScheduleID(i int) *NotificationUpdate {
+	nu.mutation.AddScheduleID(i)
+	return nu
+}
+
+// ClearScheduleID clears the value of the "schedule_id" field.
+func (nu *NotificationUpdate) ClearScheduleID() *NotificationUpdate {
+	nu.mutation.ClearScheduleID()
+	return nu
+}
+
+// SetOccurrenceAt sets the "occurrence_at" field.
+func (nu *NotificationUpdate) SetOccurrenceAt(t time.Time) *NotificationUpdate {
+	nu.mutation.SetOccurrenceAt(t)
+	return nu
+}
+
+// SetNillableOccurrenceAt sets the "occurrence_at" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableOccurrenceAt(t *time.Time) *NotificationUpdate {
+	if t != nil {
+		nu.SetOccurrenceAt(*t)
+	}
+	return nu
+}
+
+// ClearOccurrenceAt clears the value of the "occurrence_at" field.
+func (nu *NotificationUpdate) ClearOccurrenceAt() *NotificationUpdate {
+	nu.mutation.ClearOccurrenceAt()
+	return nu
+}
+
+// SetTraceContext sets the "trace_context" field.
+func (nu *NotificationUpdate) SetTraceContext(m map[string]string) *NotificationUpdate {
+	nu.mutation.SetTraceContext(m)
+	return nu
+}
+
+// ClearTraceContext clears the value of the "trace_context" field.
+func (nu *NotificationUpdate) ClearTraceContext() *NotificationUpdate {
+	nu.mutation.ClearTraceContext()
+	return nu
+}
+
 // Mutation returns the NotificationMutation object of the builder.
 func (nu *NotificationUpdate) Mutation() *NotificationMutation {
 	return nu.mutation
@@ -291,6 +589,19 @@ func (nu *NotificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: notification.FieldSenderID,
 		})
 	}
+	if value, ok := nu.mutation.Tenant(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldTenant,
+		})
+	}
+	if nu.mutation.TenantCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: notification.FieldTenant,
+		})
+	}
 	if value, ok := nu.mutation.GetType(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -367,6 +678,59 @@ func (nu *NotificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: notification.FieldRetries,
 		})
 	}
+	if value, ok := nu.mutation.Priority(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: notification.FieldPriority,
+		})
+	}
+	if value, ok := nu.mutation.AddedPriority(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: notification.FieldPriority,
+		})
+	}
+	if value, ok := nu.mutation.RetryPolicy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: notification.FieldRetryPolicy,
+		})
+	}
+	if nu.mutation.RetryPolicyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: notification.FieldRetryPolicy,
+		})
+	}
+	if value, ok := nu.mutation.Category(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldCategory,
+		})
+	}
+	if nu.mutation.CategoryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: notification.FieldCategory,
+		})
+	}
+	if value, ok := nu.mutation.DeliveryWindow(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: notification.FieldDeliveryWindow,
+		})
+	}
+	if nu.mutation.DeliveryWindowCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: notification.FieldDeliveryWindow,
+		})
+	}
 	if value, ok := nu.mutation.SentAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -380,58 +744,229 @@ func (nu *NotificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: notification.FieldSentAt,
 		})
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{notification.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	if value, ok := nu.mutation.LeaseUntil(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: notification.FieldLeaseUntil,
+		})
 	}
-	return n, nil
-}
-
-// NotificationUpdateOne is the builder for updating a single Notification entity.
-type NotificationUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *NotificationMutation
-}
-
-// SetSenderID sets the "sender_id" field.
-func (nuo *NotificationUpdateOne) SetSenderID(i int) *NotificationUpdateOne {
-	nuo.mutation.ResetSenderID()
-	nuo.mutation.SetSenderID(i)
-	return nuo
-}
-
-// AddSenderID adds i to the "sender_id" field.
-func (nuo *NotificationUpdateOne) AddSenderID(i int) *NotificationUpdateOne {
-	nuo.mutation.AddSenderID(i)
-	return nuo
-}
-
-// SetType sets the "type" field.
-func (nuo *NotificationUpdateOne) SetType(st schema.NotificationType) *NotificationUpdateOne {
-	nuo.mutation.SetType(st)
-	return nuo
-}
-
-// SetNillableType sets the "type" field if the given value is not nil.
-func (nuo *NotificationUpdateOne) SetNillableType(st *schema.NotificationType) *NotificationUpdateOne {
-	if st != nil {
-		nuo.SetType(*st)
+	if nu.mutation.LeaseUntilCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: notification.FieldLeaseUntil,
+		})
 	}
-	return nuo
-}
-
-// SetPayload sets the "payload" field.
-func (nuo *NotificationUpdateOne) SetPayload(s schema.Payload) *NotificationUpdateOne {
-	nuo.mutation.SetPayload(s)
-	return nuo
-}
-
+	if value, ok := nu.mutation.WorkerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldWorkerID,
+		})
+	}
+	if nu.mutation.WorkerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: notification.FieldWorkerID,
+		})
+	}
+	if value, ok := nu.mutation.DedupKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldDedupKey,
+		})
+	}
+	if nu.mutation.DedupKeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: notification.FieldDedupKey,
+		})
+	}
+	if value, ok := nu.mutation.OriginalID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: notification.FieldOriginalID,
+		})
+	}
+	if value, ok := nu.mutation.AddedOriginalID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: notification.FieldOriginalID,
+		})
+	}
+	if nu.mutation.OriginalIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: notification.FieldOriginalID,
+		})
+	}
+	if value, ok := nu.mutation.Locale(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldLocale,
+		})
+	}
+	if nu.mutation.LocaleCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: notification.FieldLocale,
+		})
+	}
+	if value, ok := nu.mutation.DigestKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldDigestKey,
+		})
+	}
+	if nu.mutation.DigestKeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: notification.FieldDigestKey,
+		})
+	}
+	if value, ok := nu.mutation.DigestID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: notification.FieldDigestID,
+		})
+	}
+	if value, ok := nu.mutation.AddedDigestID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: notification.FieldDigestID,
+		})
+	}
+	if nu.mutation.DigestIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: notification.FieldDigestID,
+		})
+	}
+	if value, ok := nu.mutation.ScheduleID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: notification.FieldScheduleID,
+		})
+	}
+	if value, ok := nu.mutation.AddedScheduleID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: notification.FieldScheduleID,
+		})
+	}
+	if nu.mutation.ScheduleIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: notification.FieldScheduleID,
+		})
+	}
+	if value, ok := nu.mutation.OccurrenceAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: notification.FieldOccurrenceAt,
+		})
+	}
+	if nu.mutation.OccurrenceAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: notification.FieldOccurrenceAt,
+		})
+	}
+	if value, ok := nu.mutation.TraceContext(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: notification.FieldTraceContext,
+		})
+	}
+	if nu.mutation.TraceContextCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: notification.FieldTraceContext,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// NotificationUpdateOne is the builder for updating a single Notification entity.
+type NotificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationMutation
+}
+
+// SetSenderID sets the "sender_id" field.
+func (nuo *NotificationUpdateOne) SetSenderID(i int) *NotificationUpdateOne {
+	nuo.mutation.ResetSenderID()
+	nuo.mutation.SetSenderID(i)
+	return nuo
+}
+
+// AddSenderID adds i to the "sender_id" field.
+func (nuo *NotificationUpdateOne) AddSenderID(i int) *NotificationUpdateOne {
+	nuo.mutation.AddSenderID(i)
+	return nuo
+}
+
+// SetTenant sets the "tenant" field.
+func (nuo *NotificationUpdateOne) SetTenant(s string) *NotificationUpdateOne {
+	nuo.mutation.SetTenant(s)
+	return nuo
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableTenant(s *string) *NotificationUpdateOne {
+	if s != nil {
+		nuo.SetTenant(*s)
+	}
+	return nuo
+}
+
+// ClearTenant clears the value of the "tenant" field.
+func (nuo *NotificationUpdateOne) ClearTenant() *NotificationUpdateOne {
+	nuo.mutation.ClearTenant()
+	return nuo
+}
+
+// SetType sets the "type" field.
+func (nuo *NotificationUpdateOne) SetType(st schema.NotificationType) *NotificationUpdateOne {
+	nuo.mutation.SetType(st)
+	return nuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableType(st *schema.NotificationType) *NotificationUpdateOne {
+	if st != nil {
+		nuo.SetType(*st)
+	}
+	return nuo
+}
+
+// SetPayload sets the "payload" field.
+func (nuo *NotificationUpdateOne) SetPayload(s schema.Payload) *NotificationUpdateOne {
+	nuo.mutation.SetPayload(s)
+	return nuo
+}
+
 // SetTTL sets the "ttl" field.
 func (nuo *NotificationUpdateOne) SetTTL(i int) *NotificationUpdateOne {
 	nuo.mutation.ResetTTL()
@@ -520,6 +1055,71 @@ func (nuo *NotificationUpdateOne) AddRetries(i int) *NotificationUpdateOne {
 	return nuo
 }
 
+// SetPriority sets the "priority" field.
+func (nuo *NotificationUpdateOne) SetPriority(i int) *NotificationUpdateOne {
+	nuo.mutation.ResetPriority()
+	nuo.mutation.SetPriority(i)
+	return nuo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillablePriority(i *int) *NotificationUpdateOne {
+	if i != nil {
+		nuo.SetPriority(*i)
+	}
+	return nuo
+}
+
+// AddPriority adds i to the "priority" field.
+func (nuo *NotificationUpdateOne) AddPriority(i int) *NotificationUpdateOne {
+	nuo.mutation.AddPriority(i)
+	return nuo
+}
+
+// SetRetryPolicy sets the "retry_policy" field.
+func (nuo *NotificationUpdateOne) SetRetryPolicy(sp *schema.RetryPolicy) *NotificationUpdateOne {
+	nuo.mutation.SetRetryPolicy(sp)
+	return nuo
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (nuo *NotificationUpdateOne) ClearRetryPolicy() *NotificationUpdateOne {
+	nuo.mutation.ClearRetryPolicy()
+	return nuo
+}
+
+// SetCategory sets the "category" field.
+func (nuo *NotificationUpdateOne) SetCategory(s string) *NotificationUpdateOne {
+	nuo.mutation.SetCategory(s)
+	return nuo
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableCategory(s *string) *NotificationUpdateOne {
+	if s != nil {
+		nuo.SetCategory(*s)
+	}
+	return nuo
+}
+
+// ClearCategory clears the value of the "category" field.
+func (nuo *NotificationUpdateOne) ClearCategory() *NotificationUpdateOne {
+	nuo.mutation.ClearCategory()
+	return nuo
+}
+
+// SetDeliveryWindow sets the "delivery_window" field.
+func (nuo *NotificationUpdateOne) SetDeliveryWindow(sw *schema.DeliveryWindow) *NotificationUpdateOne {
+	nuo.mutation.SetDeliveryWindow(sw)
+	return nuo
+}
+
+// ClearDeliveryWindow clears the value of the "delivery_window" field.
+func (nuo *NotificationUpdateOne) ClearDeliveryWindow() *NotificationUpdateOne {
+	nuo.mutation.ClearDeliveryWindow()
+	return nuo
+}
+
 // SetSentAt sets the "sent_at" field.
 func (nuo *NotificationUpdateOne) SetSentAt(t time.Time) *NotificationUpdateOne {
 	nuo.mutation.SetSentAt(t)
@@ -540,6 +1140,219 @@ func (nuo *NotificationUpdateOne) ClearSentAt() *NotificationUpdateOne {
 	return nuo
 }
 
+// SetLeaseUntil sets the "lease_until" field.
+func (nuo *NotificationUpdateOne) SetLeaseUntil(t time.Time) *NotificationUpdateOne {
+	nuo.mutation.SetLeaseUntil(t)
+	return nuo
+}
+
+// SetNillableLeaseUntil sets the "lease_until" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableLeaseUntil(t *time.Time) *NotificationUpdateOne {
+	if t != nil {
+		nuo.SetLeaseUntil(*t)
+	}
+	return nuo
+}
+
+// ClearLeaseUntil clears the value of the "lease_until" field.
+func (nuo *NotificationUpdateOne) ClearLeaseUntil() *NotificationUpdateOne {
+	nuo.mutation.ClearLeaseUntil()
+	return nuo
+}
+
+// SetWorkerID sets the "worker_id" field.
+func (nuo *NotificationUpdateOne) SetWorkerID(s string) *NotificationUpdateOne {
+	nuo.mutation.SetWorkerID(s)
+	return nuo
+}
+
+// SetNillableWorkerID sets the "worker_id" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableWorkerID(s *string) *NotificationUpdateOne {
+	if s != nil {
+		nuo.SetWorkerID(*s)
+	}
+	return nuo
+}
+
+// ClearWorkerID clears the value of the "worker_id" field.
+func (nuo *NotificationUpdateOne) ClearWorkerID() *NotificationUpdateOne {
+	nuo.mutation.ClearWorkerID()
+	return nuo
+}
+
+// SetDedupKey sets the "dedup_key" field.
+func (nuo *NotificationUpdateOne) SetDedupKey(s string) *NotificationUpdateOne {
+	nuo.mutation.SetDedupKey(s)
+	return nuo
+}
+
+// SetNillableDedupKey sets the "dedup_key" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableDedupKey(s *string) *NotificationUpdateOne {
+	if s != nil {
+		nuo.SetDedupKey(*s)
+	}
+	return nuo
+}
+
+// ClearDedupKey clears the value of the "dedup_key" field.
+func (nuo *NotificationUpdateOne) ClearDedupKey() *NotificationUpdateOne {
+	nuo.mutation.ClearDedupKey()
+	return nuo
+}
+
+// SetOriginalID sets the "original_id" field.
+func (nuo *NotificationUpdateOne) SetOriginalID(i int) *NotificationUpdateOne {
+	nuo.mutation.ResetOriginalID()
+	nuo.mutation.SetOriginalID(i)
+	return nuo
+}
+
+// SetNillableOriginalID sets the "original_id" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableOriginalID(i *int) *NotificationUpdateOne {
+	if i != nil {
+		nuo.SetOriginalID(*i)
+	}
+	return nuo
+}
+
+// AddOriginalID adds i to the "original_id" field.
+func (nuo *NotificationUpdateOne) AddOriginalID(i int) *NotificationUpdateOne {
+	nuo.mutation.AddOriginalID(i)
+	return nuo
+}
+
+// ClearOriginalID clears the value of the "original_id" field.
+func (nuo *NotificationUpdateOne) ClearOriginalID() *NotificationUpdateOne {
+	nuo.mutation.ClearOriginalID()
+	return nuo
+}
+
+// SetLocale sets the "locale" field.
+func (nuo *NotificationUpdateOne) SetLocale(s string) *NotificationUpdateOne {
+	nuo.mutation.SetLocale(s)
+	return nuo
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableLocale(s *string) *NotificationUpdateOne {
+	if s != nil {
+		nuo.SetLocale(*s)
+	}
+	return nuo
+}
+
+// ClearLocale clears the value of the "locale" field.
+func (nuo *NotificationUpdateOne) ClearLocale() *NotificationUpdateOne {
+	nuo.mutation.ClearLocale()
+	return nuo
+}
+
+// SetDigestKey sets the "digest_key" field.
+func (nuo *NotificationUpdateOne) SetDigestKey(s string) *NotificationUpdateOne {
+	nuo.mutation.SetDigestKey(s)
+	return nuo
+}
+
+// SetNillableDigestKey sets the "digest_key" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableDigestKey(s *string) *NotificationUpdateOne {
+	if s != nil {
+		nuo.SetDigestKey(*s)
+	}
+	return nuo
+}
+
+// ClearDigestKey clears the value of the "digest_key" field.
+func (nuo *NotificationUpdateOne) ClearDigestKey() *NotificationUpdateOne {
+	nuo.mutation.ClearDigestKey()
+	return nuo
+}
+
+// SetDigestID sets the "digest_id" field.
+func (nuo *NotificationUpdateOne) SetDigestID(i int) *NotificationUpdateOne {
+	nuo.mutation.ResetDigestID()
+	nuo.mutation.SetDigestID(i)
+	return nuo
+}
+
+// SetNillableDigestID sets the "digest_id" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableDigestID(i *int) *NotificationUpdateOne {
+	if i != nil {
+		nuo.SetDigestID(*i)
+	}
+	return nuo
+}
+
+// AddDigestID adds i to the "digest_id" field.
+func (nuo *NotificationUpdateOne) AddDigestID(i int) *NotificationUpdateOne {
+	nuo.mutation.AddDigestID(i)
+	return nuo
+}
+
+// ClearDigestID clears the value of the "digest_id" field.
+func (nuo *NotificationUpdateOne) ClearDigestID() *NotificationUpdateOne {
+	nuo.mutation.ClearDigestID()
+	return nuo
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (nuo *NotificationUpdateOne) SetScheduleID(i int) *NotificationUpdateOne {
+	nuo.mutation.ResetScheduleID()
+	nuo.mutation.SetScheduleID(i)
+	return nuo
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableScheduleID(i *int) *NotificationUpdateOne {
+	if i != nil {
+		nuo.SetScheduleID(*i)
+	}
+	return nuo
+}
+
+// AddScheduleID adds i to the "schedule_id" field.
+func (nuo *NotificationUpdateOne) AddScheduleID(i int) *NotificationUpdateOne {
+	nuo.mutation.AddScheduleID(i)
+	return nuo
+}
+
+// ClearScheduleID clears the value of the "schedule_id" field.
+func (nuo *NotificationUpdateOne) ClearScheduleID() *NotificationUpdateOne {
+	nuo.mutation.ClearScheduleID()
+	return nuo
+}
+
+// SetOccurrenceAt sets the "occurrence_at" field.
+func (nuo *NotificationUpdateOne) SetOccurrenceAt(t time.Time) *NotificationUpdateOne {
+	nuo.mutation.SetOccurrenceAt(t)
+	return nuo
+}
+
+// SetNillableOccurrenceAt sets the "occurrence_at" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableOccurrenceAt(t *time.Time) *NotificationUpdateOne {
+	if t != nil {
+		nuo.SetOccurrenceAt(*t)
+	}
+	return nuo
+}
+
+// ClearOccurrenceAt clears the value of the "occurrence_at" field.
+func (nuo *NotificationUpdateOne) ClearOccurrenceAt() *NotificationUpdateOne {
+	nuo.mutation.ClearOccurrenceAt()
+	return nuo
+}
+
+// SetTraceContext sets the "trace_context" field.
+func (nuo *NotificationUpdateOne) SetTraceContext(m map[string]string) *NotificationUpdateOne {
+	nuo.mutation.SetTraceContext(m)
+	return nuo
+}
+
+// ClearTraceContext clears the value of the "trace_context" field.
+func (nuo *NotificationUpdateOne) ClearTraceContext() *NotificationUpdateOne {
+	nuo.mutation.ClearTraceContext()
+	return nuo
+}
+
 // Mutation returns the NotificationMutation object of the builder.
 func (nuo *NotificationUpdateOne) Mutation() *NotificationMutation {
 	return nuo.mutation
@@ -691,6 +1504,19 @@ func (nuo *NotificationUpdateOne) sqlSave(ctx context.Context) (_node *Notificat
 			Column: notification.FieldSenderID,
 		})
 	}
+	if value, ok := nuo.mutation.Tenant(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldTenant,
+		})
+	}
+	if nuo.mutation.TenantCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: notification.FieldTenant,
+		})
+	}
 	if value, ok := nuo.mutation.GetType(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -767,6 +1593,59 @@ func (nuo *NotificationUpdateOne) sqlSave(ctx context.Context) (_node *Notificat
 			Column: notification.FieldRetries,
 		})
 	}
+	if value, ok := nuo.mutation.Priority(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: notification.FieldPriority,
+		})
+	}
+	if value, ok := nuo.mutation.AddedPriority(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: notification.FieldPriority,
+		})
+	}
+	if value, ok := nuo.mutation.RetryPolicy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: notification.FieldRetryPolicy,
+		})
+	}
+	if nuo.mutation.RetryPolicyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: notification.FieldRetryPolicy,
+		})
+	}
+	if value, ok := nuo.mutation.Category(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldCategory,
+		})
+	}
+	if nuo.mutation.CategoryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: notification.FieldCategory,
+		})
+	}
+	if value, ok := nuo.mutation.DeliveryWindow(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: notification.FieldDeliveryWindow,
+		})
+	}
+	if nuo.mutation.DeliveryWindowCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: notification.FieldDeliveryWindow,
+		})
+	}
 	if value, ok := nuo.mutation.SentAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -780,6 +1659,157 @@ func (nuo *NotificationUpdateOne) sqlSave(ctx context.Context) (_node *Notificat
 			Column: notification.FieldSentAt,
 		})
 	}
+	if value, ok := nuo.mutation.LeaseUntil(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: notification.FieldLeaseUntil,
+		})
+	}
+	if nuo.mutation.LeaseUntilCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: notification.FieldLeaseUntil,
+		})
+	}
+	if value, ok := nuo.mutation.WorkerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldWorkerID,
+		})
+	}
+	if nuo.mutation.WorkerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: notification.FieldWorkerID,
+		})
+	}
+	if value, ok := nuo.mutation.DedupKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldDedupKey,
+		})
+	}
+	if nuo.mutation.DedupKeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: notification.FieldDedupKey,
+		})
+	}
+	if value, ok := nuo.mutation.OriginalID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: notification.FieldOriginalID,
+		})
+	}
+	if value, ok := nuo.mutation.AddedOriginalID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: notification.FieldOriginalID,
+		})
+	}
+	if nuo.mutation.OriginalIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: notification.FieldOriginalID,
+		})
+	}
+	if value, ok := nuo.mutation.Locale(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldLocale,
+		})
+	}
+	if nuo.mutation.LocaleCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: notification.FieldLocale,
+		})
+	}
+	if value, ok := nuo.mutation.DigestKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldDigestKey,
+		})
+	}
+	if nuo.mutation.DigestKeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: notification.FieldDigestKey,
+		})
+	}
+	if value, ok := nuo.mutation.DigestID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: notification.FieldDigestID,
+		})
+	}
+	if value, ok := nuo.mutation.AddedDigestID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: notification.FieldDigestID,
+		})
+	}
+	if nuo.mutation.DigestIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: notification.FieldDigestID,
+		})
+	}
+	if value, ok := nuo.mutation.ScheduleID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: notification.FieldScheduleID,
+		})
+	}
+	if value, ok := nuo.mutation.AddedScheduleID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: notification.FieldScheduleID,
+		})
+	}
+	if nuo.mutation.ScheduleIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: notification.FieldScheduleID,
+		})
+	}
+	if value, ok := nuo.mutation.OccurrenceAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: notification.FieldOccurrenceAt,
+		})
+	}
+	if nuo.mutation.OccurrenceAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: notification.FieldOccurrenceAt,
+		})
+	}
+	if value, ok := nuo.mutation.TraceContext(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: notification.FieldTraceContext,
+		})
+	}
+	if nuo.mutation.TraceContextCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: notification.FieldTraceContext,
+		})
+	}
 	_node = &Notification{config: nuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
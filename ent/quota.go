@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"notifications/ent/quota"
+	"notifications/ent/schema"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Quota is the model entity for the Quota schema.
+type Quota struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// SenderID holds the value of the "sender_id" field.
+	SenderID int `json:"sender_id,omitempty"`
+	// types in (plain|sms|email|whatsapp|push|telegram)
+	Type schema.NotificationType `json:"type,omitempty"`
+	// limit of notifications for last minute, unlimited if 0
+	PerMinute int `json:"per_minute,omitempty"`
+	// limit of notifications for last 24 hours, unlimited if 0
+	PerDay int `json:"per_day,omitempty"`
+	// creation time of quota
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// last update time of quota
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Quota) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case quota.FieldID, quota.FieldSenderID, quota.FieldPerMinute, quota.FieldPerDay:
+			values[i] = new(sql.NullInt64)
+		case quota.FieldType:
+			values[i] = new(sql.NullString)
+		case quota.FieldCreatedAt, quota.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Quota", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Quota fields.
+func (q *Quota) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case quota.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			q.ID = int(value.Int64)
+		case quota.FieldSenderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_id", values[i])
+			} else if value.Valid {
+				q.SenderID = int(value.Int64)
+			}
+		case quota.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				q.Type = schema.NotificationType(value.String)
+			}
+		case quota.FieldPerMinute:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field per_minute", values[i])
+			} else if value.Valid {
+				q.PerMinute = int(value.Int64)
+			}
+		case quota.FieldPerDay:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field per_day", values[i])
+			} else if value.Valid {
+				q.PerDay = int(value.Int64)
+			}
+		case quota.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				q.CreatedAt = value.Time
+			}
+		case quota.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				q.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this Quota.
+// Note that you need to call Quota.Unwrap() before calling this method if this Quota
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (q *Quota) Update() *QuotaUpdateOne {
+	return (&QuotaClient{config: q.config}).UpdateOne(q)
+}
+
+// Unwrap unwraps the Quota entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (q *Quota) Unwrap() *Quota {
+	_tx, ok := q.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Quota is not a transactional entity")
+	}
+	q.config.driver = _tx.drv
+	return q
+}
+
+// String implements the fmt.Stringer.
+func (q *Quota) String() string {
+	var builder strings.Builder
+	builder.WriteString("Quota(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", q.ID))
+	builder.WriteString("sender_id=")
+	builder.WriteString(fmt.Sprintf("%v", q.SenderID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", q.Type))
+	builder.WriteString(", ")
+	builder.WriteString("per_minute=")
+	builder.WriteString(fmt.Sprintf("%v", q.PerMinute))
+	builder.WriteString(", ")
+	builder.WriteString("per_day=")
+	builder.WriteString(fmt.Sprintf("%v", q.PerDay))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(q.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(q.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QuotaSlice is a parsable slice of Quota.
+type QuotaSlice []*Quota
+
+func (q QuotaSlice) config(cfg config) {
+	for _i := range q {
+		q[_i].config = cfg
+	}
+}
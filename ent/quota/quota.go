@@ -0,0 +1,68 @@
+// Code generated by ent, DO NOT EDIT.
+
+package quota
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the quota type in the database.
+	Label = "quota"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSenderID holds the string denoting the sender_id field in the database.
+	FieldSenderID = "sender_id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldPerMinute holds the string denoting the per_minute field in the database.
+	FieldPerMinute = "per_minute"
+	// FieldPerDay holds the string denoting the per_day field in the database.
+	FieldPerDay = "per_day"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the quota in the database.
+	Table = "quota"
+)
+
+// Columns holds all SQL columns for quota fields.
+var Columns = []string{
+	FieldID,
+	FieldSenderID,
+	FieldType,
+	FieldPerMinute,
+	FieldPerDay,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	TypeValidator func(string) error
+	// DefaultPerMinute holds the default value on creation for the "per_minute" field.
+	DefaultPerMinute int
+	// PerMinuteValidator is a validator for the "per_minute" field. It is called by the builders before save.
+	PerMinuteValidator func(int) error
+	// DefaultPerDay holds the default value on creation for the "per_day" field.
+	DefaultPerDay int
+	// PerDayValidator is a validator for the "per_day" field. It is called by the builders before save.
+	PerDayValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
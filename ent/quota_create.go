@@ -0,0 +1,382 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"notifications/ent/quota"
+	"notifications/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QuotaCreate is the builder for creating a Quota entity.
+type QuotaCreate struct {
+	config
+	mutation *QuotaMutation
+	hooks    []Hook
+}
+
+// SetSenderID sets the "sender_id" field.
+func (qc *QuotaCreate) SetSenderID(i int) *QuotaCreate {
+	qc.mutation.SetSenderID(i)
+	return qc
+}
+
+// SetType sets the "type" field.
+func (qc *QuotaCreate) SetType(st schema.NotificationType) *QuotaCreate {
+	qc.mutation.SetType(st)
+	return qc
+}
+
+// SetPerMinute sets the "per_minute" field.
+func (qc *QuotaCreate) SetPerMinute(i int) *QuotaCreate {
+	qc.mutation.SetPerMinute(i)
+	return qc
+}
+
+// SetNillablePerMinute sets the "per_minute" field if the given value is not nil.
+func (qc *QuotaCreate) SetNillablePerMinute(i *int) *QuotaCreate {
+	if i != nil {
+		qc.SetPerMinute(*i)
+	}
+	return qc
+}
+
+// SetPerDay sets the "per_day" field.
+func (qc *QuotaCreate) SetPerDay(i int) *QuotaCreate {
+	qc.mutation.SetPerDay(i)
+	return qc
+}
+
+// SetNillablePerDay sets the "per_day" field if the given value is not nil.
+func (qc *QuotaCreate) SetNillablePerDay(i *int) *QuotaCreate {
+	if i != nil {
+		qc.SetPerDay(*i)
+	}
+	return qc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (qc *QuotaCreate) SetCreatedAt(t time.Time) *QuotaCreate {
+	qc.mutation.SetCreatedAt(t)
+	return qc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (qc *QuotaCreate) SetNillableCreatedAt(t *time.Time) *QuotaCreate {
+	if t != nil {
+		qc.SetCreatedAt(*t)
+	}
+	return qc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qc *QuotaCreate) SetUpdatedAt(t time.Time) *QuotaCreate {
+	qc.mutation.SetUpdatedAt(t)
+	return qc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (qc *QuotaCreate) SetNillableUpdatedAt(t *time.Time) *QuotaCreate {
+	if t != nil {
+		qc.SetUpdatedAt(*t)
+	}
+	return qc
+}
+
+// Mutation returns the QuotaMutation object of the builder.
+func (qc *QuotaCreate) Mutation() *QuotaMutation {
+	return qc.mutation
+}
+
+// Save creates the Quota in the database.
+func (qc *QuotaCreate) Save(ctx context.Context) (*Quota, error) {
+	var (
+		err  error
+		node *Quota
+	)
+	qc.defaults()
+	if len(qc.hooks) == 0 {
+		if err = qc.check(); err != nil {
+			return nil, err
+		}
+		node, err = qc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*QuotaMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = qc.check(); err != nil {
+				return nil, err
+			}
+			qc.mutation = mutation
+			if node, err = qc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(qc.hooks) - 1; i >= 0; i-- {
+			if qc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = qc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, qc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Quota)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from QuotaMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qc *QuotaCreate) SaveX(ctx context.Context) *Quota {
+	v, err := qc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qc *QuotaCreate) Exec(ctx context.Context) error {
+	_, err := qc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qc *QuotaCreate) ExecX(ctx context.Context) {
+	if err := qc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qc *QuotaCreate) defaults() {
+	if _, ok := qc.mutation.PerMinute(); !ok {
+		v := quota.DefaultPerMinute
+		qc.mutation.SetPerMinute(v)
+	}
+	if _, ok := qc.mutation.PerDay(); !ok {
+		v := quota.DefaultPerDay
+		qc.mutation.SetPerDay(v)
+	}
+	if _, ok := qc.mutation.CreatedAt(); !ok {
+		v := quota.DefaultCreatedAt()
+		qc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := qc.mutation.UpdatedAt(); !ok {
+		v := quota.DefaultUpdatedAt()
+		qc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qc *QuotaCreate) check() error {
+	if _, ok := qc.mutation.SenderID(); !ok {
+		return &ValidationError{Name: "sender_id", err: errors.New(`ent: missing required field "Quota.sender_id"`)}
+	}
+	if _, ok := qc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Quota.type"`)}
+	}
+	if v, ok := qc.mutation.GetType(); ok {
+		if err := quota.TypeValidator(string(v)); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Quota.type": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.PerMinute(); !ok {
+		return &ValidationError{Name: "per_minute", err: errors.New(`ent: missing required field "Quota.per_minute"`)}
+	}
+	if v, ok := qc.mutation.PerMinute(); ok {
+		if err := quota.PerMinuteValidator(v); err != nil {
+			return &ValidationError{Name: "per_minute", err: fmt.Errorf(`ent: validator failed for field "Quota.per_minute": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.PerDay(); !ok {
+		return &ValidationError{Name: "per_day", err: errors.New(`ent: missing required field "Quota.per_day"`)}
+	}
+	if v, ok := qc.mutation.PerDay(); ok {
+		if err := quota.PerDayValidator(v); err != nil {
+			return &ValidationError{Name: "per_day", err: fmt.Errorf(`ent: validator failed for field "Quota.per_day": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Quota.created_at"`)}
+	}
+	if _, ok := qc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Quota.updated_at"`)}
+	}
+	return nil
+}
+
+func (qc *QuotaCreate) sqlSave(ctx context.Context) (*Quota, error) {
+	_node, _spec := qc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (qc *QuotaCreate) createSpec() (*Quota, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Quota{config: qc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: quota.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: quota.FieldID,
+			},
+		}
+	)
+	if value, ok := qc.mutation.SenderID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: quota.FieldSenderID,
+		})
+		_node.SenderID = value
+	}
+	if value, ok := qc.mutation.GetType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: quota.FieldType,
+		})
+		_node.Type = value
+	}
+	if value, ok := qc.mutation.PerMinute(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: quota.FieldPerMinute,
+		})
+		_node.PerMinute = value
+	}
+	if value, ok := qc.mutation.PerDay(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: quota.FieldPerDay,
+		})
+		_node.PerDay = value
+	}
+	if value, ok := qc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: quota.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := qc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: quota.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// QuotaCreateBulk is the builder for creating many Quota entities in bulk.
+type QuotaCreateBulk struct {
+	config
+	builders []*QuotaCreate
+}
+
+// Save creates the Quota entities in the database.
+func (qcb *QuotaCreateBulk) Save(ctx context.Context) ([]*Quota, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(qcb.builders))
+	nodes := make([]*Quota, len(qcb.builders))
+	mutators := make([]Mutator, len(qcb.builders))
+	for i := range qcb.builders {
+		func(i int, root context.Context) {
+			builder := qcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuotaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qcb *QuotaCreateBulk) SaveX(ctx context.Context) []*Quota {
+	v, err := qcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qcb *QuotaCreateBulk) Exec(ctx context.Context) error {
+	_, err := qcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qcb *QuotaCreateBulk) ExecX(ctx context.Context) {
+	if err := qcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
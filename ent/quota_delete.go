@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"notifications/ent/predicate"
+	"notifications/ent/quota"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QuotaDelete is the builder for deleting a Quota entity.
+type QuotaDelete struct {
+	config
+	hooks    []Hook
+	mutation *QuotaMutation
+}
+
+// Where appends a list predicates to the QuotaDelete builder.
+func (qd *QuotaDelete) Where(ps ...predicate.Quota) *QuotaDelete {
+	qd.mutation.Where(ps...)
+	return qd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (qd *QuotaDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(qd.hooks) == 0 {
+		affected, err = qd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*QuotaMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			qd.mutation = mutation
+			affected, err = qd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(qd.hooks) - 1; i >= 0; i-- {
+			if qd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = qd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, qd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qd *QuotaDelete) ExecX(ctx context.Context) int {
+	n, err := qd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (qd *QuotaDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: quota.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: quota.FieldID,
+			},
+		},
+	}
+	if ps := qd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, qd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// QuotaDeleteOne is the builder for deleting a single Quota entity.
+type QuotaDeleteOne struct {
+	qd *QuotaDelete
+}
+
+// Exec executes the deletion query.
+func (qdo *QuotaDeleteOne) Exec(ctx context.Context) error {
+	n, err := qdo.qd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{quota.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qdo *QuotaDeleteOne) ExecX(ctx context.Context) {
+	qdo.qd.ExecX(ctx)
+}
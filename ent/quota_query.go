@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"notifications/ent/predicate"
+	"notifications/ent/quota"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QuotaQuery is the builder for querying Quota entities.
+type QuotaQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.Quota
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the QuotaQuery builder.
+func (qq *QuotaQuery) Where(ps ...predicate.Quota) *QuotaQuery {
+	qq.predicates = append(qq.predicates, ps...)
+	return qq
+}
+
+// Limit adds a limit step to the query.
+func (qq *QuotaQuery) Limit(limit int) *QuotaQuery {
+	qq.limit = &limit
+	return qq
+}
+
+// Offset adds an offset step to the query.
+func (qq *QuotaQuery) Offset(offset int) *QuotaQuery {
+	qq.offset = &offset
+	return qq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (qq *QuotaQuery) Unique(unique bool) *QuotaQuery {
+	qq.unique = &unique
+	return qq
+}
+
+// Order adds an order step to the query.
+func (qq *QuotaQuery) Order(o ...OrderFunc) *QuotaQuery {
+	qq.order = append(qq.order, o...)
+	return qq
+}
+
+// First returns the first Quota entity from the query.
+// Returns a *NotFoundError when no Quota was found.
+func (qq *QuotaQuery) First(ctx context.Context) (*Quota, error) {
+	nodes, err := qq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{quota.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (qq *QuotaQuery) FirstX(ctx context.Context) *Quota {
+	node, err := qq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Quota ID from the query.
+// Returns a *NotFoundError when no Quota ID was found.
+func (qq *QuotaQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = qq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{quota.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (qq *QuotaQuery) FirstIDX(ctx context.Context) int {
+	id, err := qq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Quota entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Quota entity is found.
+// Returns a *NotFoundError when no Quota entities are found.
+func (qq *QuotaQuery) Only(ctx context.Context) (*Quota, error) {
+	nodes, err := qq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{quota.Label}
+	default:
+		return nil, &NotSingularError{quota.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (qq *QuotaQuery) OnlyX(ctx context.Context) *Quota {
+	node, err := qq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Quota ID in the query.
+// Returns a *NotSingularError when more than one Quota ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (qq *QuotaQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = qq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{quota.Label}
+	default:
+		err = &NotSingularError{quota.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (qq *QuotaQuery) OnlyIDX(ctx context.Context) int {
+	id, err := qq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of QuotaSlice.
+func (qq *QuotaQuery) All(ctx context.Context) ([]*Quota, error) {
+	if err := qq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return qq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (qq *QuotaQuery) AllX(ctx context.Context) []*Quota {
+	nodes, err := qq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Quota IDs.
+func (qq *QuotaQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := qq.Select(quota.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (qq *QuotaQuery) IDsX(ctx context.Context) []int {
+	ids, err := qq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (qq *QuotaQuery) Count(ctx context.Context) (int, error) {
+	if err := qq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return qq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (qq *QuotaQuery) CountX(ctx context.Context) int {
+	count, err := qq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (qq *QuotaQuery) Exist(ctx context.Context) (bool, error) {
+	if err := qq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return qq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (qq *QuotaQuery) ExistX(ctx context.Context) bool {
+	exist, err := qq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the QuotaQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (qq *QuotaQuery) Clone() *QuotaQuery {
+	if qq == nil {
+		return nil
+	}
+	return &QuotaQuery{
+		config:     qq.config,
+		limit:      qq.limit,
+		offset:     qq.offset,
+		order:      append([]OrderFunc{}, qq.order...),
+		predicates: append([]predicate.Quota{}, qq.predicates...),
+		// clone intermediate query.
+		sql:    qq.sql.Clone(),
+		path:   qq.path,
+		unique: qq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		SenderID int `json:"sender_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Quota.Query().
+//		GroupBy(quota.FieldSenderID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (qq *QuotaQuery) GroupBy(field string, fields ...string) *QuotaGroupBy {
+	grbuild := &QuotaGroupBy{config: qq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := qq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return qq.sqlQuery(ctx), nil
+	}
+	grbuild.label = quota.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		SenderID int `json:"sender_id,omitempty"`
+//	}
+//
+//	client.Quota.Query().
+//		Select(quota.FieldSenderID).
+//		Scan(ctx, &v)
+func (qq *QuotaQuery) Select(fields ...string) *QuotaSelect {
+	qq.fields = append(qq.fields, fields...)
+	selbuild := &QuotaSelect{QuotaQuery: qq}
+	selbuild.label = quota.Label
+	selbuild.flds, selbuild.scan = &qq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (qq *QuotaQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range qq.fields {
+		if !quota.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if qq.path != nil {
+		prev, err := qq.path(ctx)
+		if err != nil {
+			return err
+		}
+		qq.sql = prev
+	}
+	return nil
+}
+
+func (qq *QuotaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Quota, error) {
+	var (
+		nodes = []*Quota{}
+		_spec = qq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*Quota).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &Quota{config: qq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, qq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (qq *QuotaQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := qq.querySpec()
+	_spec.Node.Columns = qq.fields
+	if len(qq.fields) > 0 {
+		_spec.Unique = qq.unique != nil && *qq.unique
+	}
+	return sqlgraph.CountNodes(ctx, qq.driver, _spec)
+}
+
+func (qq *QuotaQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := qq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (qq *QuotaQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   quota.Table,
+			Columns: quota.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: quota.FieldID,
+			},
+		},
+		From:   qq.sql,
+		Unique: true,
+	}
+	if unique := qq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := qq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, quota.FieldID)
+		for i := range fields {
+			if fields[i] != quota.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := qq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := qq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := qq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := qq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (qq *QuotaQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(qq.driver.Dialect())
+	t1 := builder.Table(quota.Table)
+	columns := qq.fields
+	if len(columns) == 0 {
+		columns = quota.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if qq.sql != nil {
+		selector = qq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if qq.unique != nil && *qq.unique {
+		selector.Distinct()
+	}
+	for _, p := range qq.predicates {
+		p(selector)
+	}
+	for _, p := range qq.order {
+		p(selector)
+	}
+	if offset := qq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := qq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// QuotaGroupBy is the group-by builder for Quota entities.
+type QuotaGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (qgb *QuotaGroupBy) Aggregate(fns ...AggregateFunc) *QuotaGroupBy {
+	qgb.fns = append(qgb.fns, fns...)
+	return qgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (qgb *QuotaGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := qgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	qgb.sql = query
+	return qgb.sqlScan(ctx, v)
+}
+
+func (qgb *QuotaGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range qgb.fields {
+		if !quota.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := qgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (qgb *QuotaGroupBy) sqlQuery() *sql.Selector {
+	selector := qgb.sql.Select()
+	aggregation := make([]string, 0, len(qgb.fns))
+	for _, fn := range qgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(qgb.fields)+len(qgb.fns))
+		for _, f := range qgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(qgb.fields...)...)
+}
+
+// QuotaSelect is the builder for selecting fields of Quota entities.
+type QuotaSelect struct {
+	*QuotaQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qs *QuotaSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := qs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qs.sql = qs.QuotaQuery.sqlQuery(ctx)
+	return qs.sqlScan(ctx, v)
+}
+
+func (qs *QuotaSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := qs.sql.Query()
+	if err := qs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"notifications/ent/predicate"
+	"notifications/ent/quota"
+	"notifications/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QuotaUpdate is the builder for updating Quota entities.
+type QuotaUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QuotaMutation
+}
+
+// Where appends a list predicates to the QuotaUpdate builder.
+func (qu *QuotaUpdate) Where(ps ...predicate.Quota) *QuotaUpdate {
+	qu.mutation.Where(ps...)
+	return qu
+}
+
+// SetSenderID sets the "sender_id" field.
+func (qu *QuotaUpdate) SetSenderID(i int) *QuotaUpdate {
+	qu.mutation.ResetSenderID()
+	qu.mutation.SetSenderID(i)
+	return qu
+}
+
+// AddSenderID adds i to the "sender_id" field.
+func (qu *QuotaUpdate) AddSenderID(i int) *QuotaUpdate {
+	qu.mutation.AddSenderID(i)
+	return qu
+}
+
+// SetType sets the "type" field.
+func (qu *QuotaUpdate) SetType(st schema.NotificationType) *QuotaUpdate {
+	qu.mutation.SetType(st)
+	return qu
+}
+
+// SetPerMinute sets the "per_minute" field.
+func (qu *QuotaUpdate) SetPerMinute(i int) *QuotaUpdate {
+	qu.mutation.ResetPerMinute()
+	qu.mutation.SetPerMinute(i)
+	return qu
+}
+
+// SetNillablePerMinute sets the "per_minute" field if the given value is not nil.
+func (qu *QuotaUpdate) SetNillablePerMinute(i *int) *QuotaUpdate {
+	if i != nil {
+		qu.SetPerMinute(*i)
+	}
+	return qu
+}
+
+// AddPerMinute adds i to the "per_minute" field.
+func (qu *QuotaUpdate) AddPerMinute(i int) *QuotaUpdate {
+	qu.mutation.AddPerMinute(i)
+	return qu
+}
+
+// SetPerDay sets the "per_day" field.
+func (qu *QuotaUpdate) SetPerDay(i int) *QuotaUpdate {
+	qu.mutation.ResetPerDay()
+	qu.mutation.SetPerDay(i)
+	return qu
+}
+
+// SetNillablePerDay sets the "per_day" field if the given value is not nil.
+func (qu *QuotaUpdate) SetNillablePerDay(i *int) *QuotaUpdate {
+	if i != nil {
+		qu.SetPerDay(*i)
+	}
+	return qu
+}
+
+// AddPerDay adds i to the "per_day" field.
+func (qu *QuotaUpdate) AddPerDay(i int) *QuotaUpdate {
+	qu.mutation.AddPerDay(i)
+	return qu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qu *QuotaUpdate) SetUpdatedAt(t time.Time) *QuotaUpdate {
+	qu.mutation.SetUpdatedAt(t)
+	return qu
+}
+
+// Mutation returns the QuotaMutation object of the builder.
+func (qu *QuotaUpdate) Mutation() *QuotaMutation {
+	return qu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qu *QuotaUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	qu.defaults()
+	if len(qu.hooks) == 0 {
+		if err = qu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = qu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*QuotaMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = qu.check(); err != nil {
+				return 0, err
+			}
+			qu.mutation = mutation
+			affected, err = qu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(qu.hooks) - 1; i >= 0; i-- {
+			if qu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = qu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, qu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qu *QuotaUpdate) SaveX(ctx context.Context) int {
+	affected, err := qu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qu *QuotaUpdate) Exec(ctx context.Context) error {
+	_, err := qu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qu *QuotaUpdate) ExecX(ctx context.Context) {
+	if err := qu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qu *QuotaUpdate) defaults() {
+	if _, ok := qu.mutation.UpdatedAt(); !ok {
+		v := quota.UpdateDefaultUpdatedAt()
+		qu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qu *QuotaUpdate) check() error {
+	if v, ok := qu.mutation.GetType(); ok {
+		if err := quota.TypeValidator(string(v)); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Quota.type": %w`, err)}
+		}
+	}
+	if v, ok := qu.mutation.PerMinute(); ok {
+		if err := quota.PerMinuteValidator(v); err != nil {
+			return &ValidationError{Name: "per_minute", err: fmt.Errorf(`ent: validator failed for field "Quota.per_minute": %w`, err)}
+		}
+	}
+	if v, ok := qu.mutation.PerDay(); ok {
+		if err := quota.PerDayValidator(v); err != nil {
+			return &ValidationError{Name: "per_day", err: fmt.Errorf(`ent: validator failed for field "Quota.per_day": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (qu *QuotaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   quota.Table,
+			Columns: quota.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: quota.FieldID,
+			},
+		},
+	}
+	if ps := qu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qu.mutation.SenderID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: quota.FieldSenderID,
+		})
+	}
+	if value, ok := qu.mutation.AddedSenderID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: quota.FieldSenderID,
+		})
+	}
+	if value, ok := qu.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: quota.FieldType,
+		})
+	}
+	if value, ok := qu.mutation.PerMinute(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: quota.FieldPerMinute,
+		})
+	}
+	if value, ok := qu.mutation.AddedPerMinute(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: quota.FieldPerMinute,
+		})
+	}
+	if value, ok := qu.mutation.PerDay(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: quota.FieldPerDay,
+		})
+	}
+	if value, ok := qu.mutation.AddedPerDay(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: quota.FieldPerDay,
+		})
+	}
+	if value, ok := qu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: quota.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, qu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quota.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// QuotaUpdateOne is the builder for updating a single Quota entity.
+type QuotaUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QuotaMutation
+}
+
+// SetSenderID sets the "sender_id" field.
+func (quo *QuotaUpdateOne) SetSenderID(i int) *QuotaUpdateOne {
+	quo.mutation.ResetSenderID()
+	quo.mutation.SetSenderID(i)
+	return quo
+}
+
+// AddSenderID adds i to the "sender_id" field.
+func (quo *QuotaUpdateOne) AddSenderID(i int) *QuotaUpdateOne {
+	quo.mutation.AddSenderID(i)
+	return quo
+}
+
+// SetType sets the "type" field.
+func (quo *QuotaUpdateOne) SetType(st schema.NotificationType) *QuotaUpdateOne {
+	quo.mutation.SetType(st)
+	return quo
+}
+
+// SetPerMinute sets the "per_minute" field.
+func (quo *QuotaUpdateOne) SetPerMinute(i int) *QuotaUpdateOne {
+	quo.mutation.ResetPerMinute()
+	quo.mutation.SetPerMinute(i)
+	return quo
+}
+
+// SetNillablePerMinute sets the "per_minute" field if the given value is not nil.
+func (quo *QuotaUpdateOne) SetNillablePerMinute(i *int) *QuotaUpdateOne {
+	if i != nil {
+		quo.SetPerMinute(*i)
+	}
+	return quo
+}
+
+// AddPerMinute adds i to the "per_minute" field.
+func (quo *QuotaUpdateOne) AddPerMinute(i int) *QuotaUpdateOne {
+	quo.mutation.AddPerMinute(i)
+	return quo
+}
+
+// SetPerDay sets the "per_day" field.
+func (quo *QuotaUpdateOne) SetPerDay(i int) *QuotaUpdateOne {
+	quo.mutation.ResetPerDay()
+	quo.mutation.SetPerDay(i)
+	return quo
+}
+
+// SetNillablePerDay sets the "per_day" field if the given value is not nil.
+func (quo *QuotaUpdateOne) SetNillablePerDay(i *int) *QuotaUpdateOne {
+	if i != nil {
+		quo.SetPerDay(*i)
+	}
+	return quo
+}
+
+// AddPerDay adds i to the "per_day" field.
+func (quo *QuotaUpdateOne) AddPerDay(i int) *QuotaUpdateOne {
+	quo.mutation.AddPerDay(i)
+	return quo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (quo *QuotaUpdateOne) SetUpdatedAt(t time.Time) *QuotaUpdateOne {
+	quo.mutation.SetUpdatedAt(t)
+	return quo
+}
+
+// Mutation returns the QuotaMutation object of the builder.
+func (quo *QuotaUpdateOne) Mutation() *QuotaMutation {
+	return quo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (quo *QuotaUpdateOne) Select(field string, fields ...string) *QuotaUpdateOne {
+	quo.fields = append([]string{field}, fields...)
+	return quo
+}
+
+// Save executes the query and returns the updated Quota entity.
+func (quo *QuotaUpdateOne) Save(ctx context.Context) (*Quota, error) {
+	var (
+		err  error
+		node *Quota
+	)
+	quo.defaults()
+	if len(quo.hooks) == 0 {
+		if err = quo.check(); err != nil {
+			return nil, err
+		}
+		node, err = quo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*QuotaMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = quo.check(); err != nil {
+				return nil, err
+			}
+			quo.mutation = mutation
+			node, err = quo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(quo.hooks) - 1; i >= 0; i-- {
+			if quo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = quo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, quo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Quota)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from QuotaMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (quo *QuotaUpdateOne) SaveX(ctx context.Context) *Quota {
+	node, err := quo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (quo *QuotaUpdateOne) Exec(ctx context.Context) error {
+	_, err := quo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (quo *QuotaUpdateOne) ExecX(ctx context.Context) {
+	if err := quo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (quo *QuotaUpdateOne) defaults() {
+	if _, ok := quo.mutation.UpdatedAt(); !ok {
+		v := quota.UpdateDefaultUpdatedAt()
+		quo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (quo *QuotaUpdateOne) check() error {
+	if v, ok := quo.mutation.GetType(); ok {
+		if err := quota.TypeValidator(string(v)); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Quota.type": %w`, err)}
+		}
+	}
+	if v, ok := quo.mutation.PerMinute(); ok {
+		if err := quota.PerMinuteValidator(v); err != nil {
+			return &ValidationError{Name: "per_minute", err: fmt.Errorf(`ent: validator failed for field "Quota.per_minute": %w`, err)}
+		}
+	}
+	if v, ok := quo.mutation.PerDay(); ok {
+		if err := quota.PerDayValidator(v); err != nil {
+			return &ValidationError{Name: "per_day", err: fmt.Errorf(`ent: validator failed for field "Quota.per_day": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (quo *QuotaUpdateOne) sqlSave(ctx context.Context) (_node *Quota, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   quota.Table,
+			Columns: quota.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: quota.FieldID,
+			},
+		},
+	}
+	id, ok := quo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Quota.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := quo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, quota.FieldID)
+		for _, f := range fields {
+			if !quota.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != quota.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := quo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := quo.mutation.SenderID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: quota.FieldSenderID,
+		})
+	}
+	if value, ok := quo.mutation.AddedSenderID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: quota.FieldSenderID,
+		})
+	}
+	if value, ok := quo.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: quota.FieldType,
+		})
+	}
+	if value, ok := quo.mutation.PerMinute(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: quota.FieldPerMinute,
+		})
+	}
+	if value, ok := quo.mutation.AddedPerMinute(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: quota.FieldPerMinute,
+		})
+	}
+	if value, ok := quo.mutation.PerDay(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: quota.FieldPerDay,
+		})
+	}
+	if value, ok := quo.mutation.AddedPerDay(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: quota.FieldPerDay,
+		})
+	}
+	if value, ok := quo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: quota.FieldUpdatedAt,
+		})
+	}
+	_node = &Quota{config: quo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, quo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quota.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,126 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"notifications/ent/ratelimit"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// RateLimit is the model entity for the RateLimit schema.
+type RateLimit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// key of bucket, e.g. channel or channel with recipient
+	Key string `json:"key,omitempty"`
+	// count of available tokens at updated_at
+	Tokens float64 `json:"tokens,omitempty"`
+	// last time of tokens refill
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RateLimit) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ratelimit.FieldTokens:
+			values[i] = new(sql.NullFloat64)
+		case ratelimit.FieldID:
+			values[i] = new(sql.NullInt64)
+		case ratelimit.FieldKey:
+			values[i] = new(sql.NullString)
+		case ratelimit.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type RateLimit", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RateLimit fields.
+func (rl *RateLimit) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ratelimit.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rl.ID = int(value.Int64)
+		case ratelimit.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				rl.Key = value.String
+			}
+		case ratelimit.FieldTokens:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field tokens", values[i])
+			} else if value.Valid {
+				rl.Tokens = value.Float64
+			}
+		case ratelimit.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rl.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this RateLimit.
+// Note that you need to call RateLimit.Unwrap() before calling this method if this RateLimit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rl *RateLimit) Update() *RateLimitUpdateOne {
+	return (&RateLimitClient{config: rl.config}).UpdateOne(rl)
+}
+
+// Unwrap unwraps the RateLimit entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rl *RateLimit) Unwrap() *RateLimit {
+	_tx, ok := rl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RateLimit is not a transactional entity")
+	}
+	rl.config.driver = _tx.drv
+	return rl
+}
+
+// String implements the fmt.Stringer.
+func (rl *RateLimit) String() string {
+	var builder strings.Builder
+	builder.WriteString("RateLimit(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rl.ID))
+	builder.WriteString("key=")
+	builder.WriteString(rl.Key)
+	builder.WriteString(", ")
+	builder.WriteString("tokens=")
+	builder.WriteString(fmt.Sprintf("%v", rl.Tokens))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rl.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RateLimits is a parsable slice of RateLimit.
+type RateLimits []*RateLimit
+
+func (rl RateLimits) config(cfg config) {
+	for _i := range rl {
+		rl[_i].config = cfg
+	}
+}
@@ -0,0 +1,45 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ratelimit
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the ratelimit type in the database.
+	Label = "rate_limit"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
+	// FieldTokens holds the string denoting the tokens field in the database.
+	FieldTokens = "tokens"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the ratelimit in the database.
+	Table = "rate_limits"
+)
+
+// Columns holds all SQL columns for ratelimit fields.
+var Columns = []string{
+	FieldID,
+	FieldKey,
+	FieldTokens,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+)
@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"notifications/ent/ratelimit"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RateLimitCreate is the builder for creating a RateLimit entity.
+type RateLimitCreate struct {
+	config
+	mutation *RateLimitMutation
+	hooks    []Hook
+}
+
+// SetKey sets the "key" field.
+func (rlc *RateLimitCreate) SetKey(s string) *RateLimitCreate {
+	rlc.mutation.SetKey(s)
+	return rlc
+}
+
+// SetTokens sets the "tokens" field.
+func (rlc *RateLimitCreate) SetTokens(f float64) *RateLimitCreate {
+	rlc.mutation.SetTokens(f)
+	return rlc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rlc *RateLimitCreate) SetUpdatedAt(t time.Time) *RateLimitCreate {
+	rlc.mutation.SetUpdatedAt(t)
+	return rlc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rlc *RateLimitCreate) SetNillableUpdatedAt(t *time.Time) *RateLimitCreate {
+	if t != nil {
+		rlc.SetUpdatedAt(*t)
+	}
+	return rlc
+}
+
+// Mutation returns the RateLimitMutation object of the builder.
+func (rlc *RateLimitCreate) Mutation() *RateLimitMutation {
+	return rlc.mutation
+}
+
+// Save creates the RateLimit in the database.
+func (rlc *RateLimitCreate) Save(ctx context.Context) (*RateLimit, error) {
+	var (
+		err  error
+		node *RateLimit
+	)
+	rlc.defaults()
+	if len(rlc.hooks) == 0 {
+		if err = rlc.check(); err != nil {
+			return nil, err
+		}
+		node, err = rlc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RateLimitMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rlc.check(); err != nil {
+				return nil, err
+			}
+			rlc.mutation = mutation
+			if node, err = rlc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rlc.hooks) - 1; i >= 0; i-- {
+			if rlc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rlc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, rlc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*RateLimit)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from RateLimitMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rlc *RateLimitCreate) SaveX(ctx context.Context) *RateLimit {
+	v, err := rlc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rlc *RateLimitCreate) Exec(ctx context.Context) error {
+	_, err := rlc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rlc *RateLimitCreate) ExecX(ctx context.Context) {
+	if err := rlc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rlc *RateLimitCreate) defaults() {
+	if _, ok := rlc.mutation.UpdatedAt(); !ok {
+		v := ratelimit.DefaultUpdatedAt()
+		rlc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rlc *RateLimitCreate) check() error {
+	if _, ok := rlc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "RateLimit.key"`)}
+	}
+	if _, ok := rlc.mutation.Tokens(); !ok {
+		return &ValidationError{Name: "tokens", err: errors.New(`ent: missing required field "RateLimit.tokens"`)}
+	}
+	if _, ok := rlc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RateLimit.updated_at"`)}
+	}
+	return nil
+}
+
+func (rlc *RateLimitCreate) sqlSave(ctx context.Context) (*RateLimit, error) {
+	_node, _spec := rlc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rlc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (rlc *RateLimitCreate) createSpec() (*RateLimit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RateLimit{config: rlc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: ratelimit.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: ratelimit.FieldID,
+			},
+		}
+	)
+	if value, ok := rlc.mutation.Key(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: ratelimit.FieldKey,
+		})
+		_node.Key = value
+	}
+	if value, ok := rlc.mutation.Tokens(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: ratelimit.FieldTokens,
+		})
+		_node.Tokens = value
+	}
+	if value, ok := rlc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: ratelimit.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// RateLimitCreateBulk is the builder for creating many RateLimit entities in bulk.
+type RateLimitCreateBulk struct {
+	config
+	builders []*RateLimitCreate
+}
+
+// Save creates the RateLimit entities in the database.
+func (rlcb *RateLimitCreateBulk) Save(ctx context.Context) ([]*RateLimit, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(rlcb.builders))
+	nodes := make([]*RateLimit, len(rlcb.builders))
+	mutators := make([]Mutator, len(rlcb.builders))
+	for i := range rlcb.builders {
+		func(i int, root context.Context) {
+			builder := rlcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RateLimitMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rlcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rlcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rlcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rlcb *RateLimitCreateBulk) SaveX(ctx context.Context) []*RateLimit {
+	v, err := rlcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rlcb *RateLimitCreateBulk) Exec(ctx context.Context) error {
+	_, err := rlcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rlcb *RateLimitCreateBulk) ExecX(ctx context.Context) {
+	if err := rlcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"notifications/ent/predicate"
+	"notifications/ent/ratelimit"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RateLimitDelete is the builder for deleting a RateLimit entity.
+type RateLimitDelete struct {
+	config
+	hooks    []Hook
+	mutation *RateLimitMutation
+}
+
+// Where appends a list predicates to the RateLimitDelete builder.
+func (rld *RateLimitDelete) Where(ps ...predicate.RateLimit) *RateLimitDelete {
+	rld.mutation.Where(ps...)
+	return rld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rld *RateLimitDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(rld.hooks) == 0 {
+		affected, err = rld.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RateLimitMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			rld.mutation = mutation
+			affected, err = rld.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(rld.hooks) - 1; i >= 0; i-- {
+			if rld.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rld.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rld.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rld *RateLimitDelete) ExecX(ctx context.Context) int {
+	n, err := rld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rld *RateLimitDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: ratelimit.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: ratelimit.FieldID,
+			},
+		},
+	}
+	if ps := rld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// RateLimitDeleteOne is the builder for deleting a single RateLimit entity.
+type RateLimitDeleteOne struct {
+	rld *RateLimitDelete
+}
+
+// Exec executes the deletion query.
+func (rldo *RateLimitDeleteOne) Exec(ctx context.Context) error {
+	n, err := rldo.rld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{ratelimit.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rldo *RateLimitDeleteOne) ExecX(ctx context.Context) {
+	rldo.rld.ExecX(ctx)
+}
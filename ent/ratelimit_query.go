@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"notifications/ent/predicate"
+	"notifications/ent/ratelimit"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RateLimitQuery is the builder for querying RateLimit entities.
+type RateLimitQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.RateLimit
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RateLimitQuery builder.
+func (rlq *RateLimitQuery) Where(ps ...predicate.RateLimit) *RateLimitQuery {
+	rlq.predicates = append(rlq.predicates, ps...)
+	return rlq
+}
+
+// Limit adds a limit step to the query.
+func (rlq *RateLimitQuery) Limit(limit int) *RateLimitQuery {
+	rlq.limit = &limit
+	return rlq
+}
+
+// Offset adds an offset step to the query.
+func (rlq *RateLimitQuery) Offset(offset int) *RateLimitQuery {
+	rlq.offset = &offset
+	return rlq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rlq *RateLimitQuery) Unique(unique bool) *RateLimitQuery {
+	rlq.unique = &unique
+	return rlq
+}
+
+// Order adds an order step to the query.
+func (rlq *RateLimitQuery) Order(o ...OrderFunc) *RateLimitQuery {
+	rlq.order = append(rlq.order, o...)
+	return rlq
+}
+
+// First returns the first RateLimit entity from the query.
+// Returns a *NotFoundError when no RateLimit was found.
+func (rlq *RateLimitQuery) First(ctx context.Context) (*RateLimit, error) {
+	nodes, err := rlq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{ratelimit.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rlq *RateLimitQuery) FirstX(ctx context.Context) *RateLimit {
+	node, err := rlq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RateLimit ID from the query.
+// Returns a *NotFoundError when no RateLimit ID was found.
+func (rlq *RateLimitQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rlq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{ratelimit.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rlq *RateLimitQuery) FirstIDX(ctx context.Context) int {
+	id, err := rlq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RateLimit entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RateLimit entity is found.
+// Returns a *NotFoundError when no RateLimit entities are found.
+func (rlq *RateLimitQuery) Only(ctx context.Context) (*RateLimit, error) {
+	nodes, err := rlq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{ratelimit.Label}
+	default:
+		return nil, &NotSingularError{ratelimit.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rlq *RateLimitQuery) OnlyX(ctx context.Context) *RateLimit {
+	node, err := rlq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RateLimit ID in the query.
+// Returns a *NotSingularError when more than one RateLimit ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rlq *RateLimitQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rlq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{ratelimit.Label}
+	default:
+		err = &NotSingularError{ratelimit.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rlq *RateLimitQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rlq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RateLimits.
+func (rlq *RateLimitQuery) All(ctx context.Context) ([]*RateLimit, error) {
+	if err := rlq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return rlq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rlq *RateLimitQuery) AllX(ctx context.Context) []*RateLimit {
+	nodes, err := rlq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RateLimit IDs.
+func (rlq *RateLimitQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := rlq.Select(ratelimit.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rlq *RateLimitQuery) IDsX(ctx context.Context) []int {
+	ids, err := rlq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rlq *RateLimitQuery) Count(ctx context.Context) (int, error) {
+	if err := rlq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return rlq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rlq *RateLimitQuery) CountX(ctx context.Context) int {
+	count, err := rlq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rlq *RateLimitQuery) Exist(ctx context.Context) (bool, error) {
+	if err := rlq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return rlq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rlq *RateLimitQuery) ExistX(ctx context.Context) bool {
+	exist, err := rlq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RateLimitQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rlq *RateLimitQuery) Clone() *RateLimitQuery {
+	if rlq == nil {
+		return nil
+	}
+	return &RateLimitQuery{
+		config:     rlq.config,
+		limit:      rlq.limit,
+		offset:     rlq.offset,
+		order:      append([]OrderFunc{}, rlq.order...),
+		predicates: append([]predicate.RateLimit{}, rlq.predicates...),
+		// clone intermediate query.
+		sql:    rlq.sql.Clone(),
+		path:   rlq.path,
+		unique: rlq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Key string `json:"key,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RateLimit.Query().
+//		GroupBy(ratelimit.FieldKey).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rlq *RateLimitQuery) GroupBy(field string, fields ...string) *RateLimitGroupBy {
+	grbuild := &RateLimitGroupBy{config: rlq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := rlq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return rlq.sqlQuery(ctx), nil
+	}
+	grbuild.label = ratelimit.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Key string `json:"key,omitempty"`
+//	}
+//
+//	client.RateLimit.Query().
+//		Select(ratelimit.FieldKey).
+//		Scan(ctx, &v)
+func (rlq *RateLimitQuery) Select(fields ...string) *RateLimitSelect {
+	rlq.fields = append(rlq.fields, fields...)
+	selbuild := &RateLimitSelect{RateLimitQuery: rlq}
+	selbuild.label = ratelimit.Label
+	selbuild.flds, selbuild.scan = &rlq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (rlq *RateLimitQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range rlq.fields {
+		if !ratelimit.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rlq.path != nil {
+		prev, err := rlq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rlq.sql = prev
+	}
+	return nil
+}
+
+func (rlq *RateLimitQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RateLimit, error) {
+	var (
+		nodes = []*RateLimit{}
+		_spec = rlq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*RateLimit).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &RateLimit{config: rlq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rlq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (rlq *RateLimitQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rlq.querySpec()
+	_spec.Node.Columns = rlq.fields
+	if len(rlq.fields) > 0 {
+		_spec.Unique = rlq.unique != nil && *rlq.unique
+	}
+	return sqlgraph.CountNodes(ctx, rlq.driver, _spec)
+}
+
+func (rlq *RateLimitQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := rlq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (rlq *RateLimitQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ratelimit.Table,
+			Columns: ratelimit.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: ratelimit.FieldID,
+			},
+		},
+		From:   rlq.sql,
+		Unique: true,
+	}
+	if unique := rlq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := rlq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ratelimit.FieldID)
+		for i := range fields {
+			if fields[i] != ratelimit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rlq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rlq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rlq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rlq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rlq *RateLimitQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rlq.driver.Dialect())
+	t1 := builder.Table(ratelimit.Table)
+	columns := rlq.fields
+	if len(columns) == 0 {
+		columns = ratelimit.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rlq.sql != nil {
+		selector = rlq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rlq.unique != nil && *rlq.unique {
+		selector.Distinct()
+	}
+	for _, p := range rlq.predicates {
+		p(selector)
+	}
+	for _, p := range rlq.order {
+		p(selector)
+	}
+	if offset := rlq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rlq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RateLimitGroupBy is the group-by builder for RateLimit entities.
+type RateLimitGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rlgb *RateLimitGroupBy) Aggregate(fns ...AggregateFunc) *RateLimitGroupBy {
+	rlgb.fns = append(rlgb.fns, fns...)
+	return rlgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (rlgb *RateLimitGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := rlgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	rlgb.sql = query
+	return rlgb.sqlScan(ctx, v)
+}
+
+func (rlgb *RateLimitGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range rlgb.fields {
+		if !ratelimit.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := rlgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rlgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (rlgb *RateLimitGroupBy) sqlQuery() *sql.Selector {
+	selector := rlgb.sql.Select()
+	aggregation := make([]string, 0, len(rlgb.fns))
+	for _, fn := range rlgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(rlgb.fields)+len(rlgb.fns))
+		for _, f := range rlgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(rlgb.fields...)...)
+}
+
+// RateLimitSelect is the builder for selecting fields of RateLimit entities.
+type RateLimitSelect struct {
+	*RateLimitQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rls *RateLimitSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := rls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	rls.sql = rls.RateLimitQuery.sqlQuery(ctx)
+	return rls.sqlScan(ctx, v)
+}
+
+func (rls *RateLimitSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := rls.sql.Query()
+	if err := rls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,368 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"notifications/ent/predicate"
+	"notifications/ent/ratelimit"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RateLimitUpdate is the builder for updating RateLimit entities.
+type RateLimitUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RateLimitMutation
+}
+
+// Where appends a list predicates to the RateLimitUpdate builder.
+func (rlu *RateLimitUpdate) Where(ps ...predicate.RateLimit) *RateLimitUpdate {
+	rlu.mutation.Where(ps...)
+	return rlu
+}
+
+// SetKey sets the "key" field.
+func (rlu *RateLimitUpdate) SetKey(s string) *RateLimitUpdate {
+	rlu.mutation.SetKey(s)
+	return rlu
+}
+
+// SetTokens sets the "tokens" field.
+func (rlu *RateLimitUpdate) SetTokens(f float64) *RateLimitUpdate {
+	rlu.mutation.ResetTokens()
+	rlu.mutation.SetTokens(f)
+	return rlu
+}
+
+// AddTokens adds f to the "tokens" field.
+func (rlu *RateLimitUpdate) AddTokens(f float64) *RateLimitUpdate {
+	rlu.mutation.AddTokens(f)
+	return rlu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rlu *RateLimitUpdate) SetUpdatedAt(t time.Time) *RateLimitUpdate {
+	rlu.mutation.SetUpdatedAt(t)
+	return rlu
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rlu *RateLimitUpdate) SetNillableUpdatedAt(t *time.Time) *RateLimitUpdate {
+	if t != nil {
+		rlu.SetUpdatedAt(*t)
+	}
+	return rlu
+}
+
+// Mutation returns the RateLimitMutation object of the builder.
+func (rlu *RateLimitUpdate) Mutation() *RateLimitMutation {
+	return rlu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rlu *RateLimitUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(rlu.hooks) == 0 {
+		affected, err = rlu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RateLimitMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			rlu.mutation = mutation
+			affected, err = rlu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(rlu.hooks) - 1; i >= 0; i-- {
+			if rlu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rlu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rlu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rlu *RateLimitUpdate) SaveX(ctx context.Context) int {
+	affected, err := rlu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rlu *RateLimitUpdate) Exec(ctx context.Context) error {
+	_, err := rlu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rlu *RateLimitUpdate) ExecX(ctx context.Context) {
+	if err := rlu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (rlu *RateLimitUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ratelimit.Table,
+			Columns: ratelimit.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: ratelimit.FieldID,
+			},
+		},
+	}
+	if ps := rlu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rlu.mutation.Key(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: ratelimit.FieldKey,
+		})
+	}
+	if value, ok := rlu.mutation.Tokens(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: ratelimit.FieldTokens,
+		})
+	}
+	if value, ok := rlu.mutation.AddedTokens(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: ratelimit.FieldTokens,
+		})
+	}
+	if value, ok := rlu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: ratelimit.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rlu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ratelimit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// RateLimitUpdateOne is the builder for updating a single RateLimit entity.
+type RateLimitUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RateLimitMutation
+}
+
+// SetKey sets the "key" field.
+func (rluo *RateLimitUpdateOne) SetKey(s string) *RateLimitUpdateOne {
+	rluo.mutation.SetKey(s)
+	return rluo
+}
+
+// SetTokens sets the "tokens" field.
+func (rluo *RateLimitUpdateOne) SetTokens(f float64) *RateLimitUpdateOne {
+	rluo.mutation.ResetTokens()
+	rluo.mutation.SetTokens(f)
+	return rluo
+}
+
+// AddTokens adds f to the "tokens" field.
+func (rluo *RateLimitUpdateOne) AddTokens(f float64) *RateLimitUpdateOne {
+	rluo.mutation.AddTokens(f)
+	return rluo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rluo *RateLimitUpdateOne) SetUpdatedAt(t time.Time) *RateLimitUpdateOne {
+	rluo.mutation.SetUpdatedAt(t)
+	return rluo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rluo *RateLimitUpdateOne) SetNillableUpdatedAt(t *time.Time) *RateLimitUpdateOne {
+	if t != nil {
+		rluo.SetUpdatedAt(*t)
+	}
+	return rluo
+}
+
+// Mutation returns the RateLimitMutation object of the builder.
+func (rluo *RateLimitUpdateOne) Mutation() *RateLimitMutation {
+	return rluo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rluo *RateLimitUpdateOne) Select(field string, fields ...string) *RateLimitUpdateOne {
+	rluo.fields = append([]string{field}, fields...)
+	return rluo
+}
+
+// Save executes the query and returns the updated RateLimit entity.
+func (rluo *RateLimitUpdateOne) Save(ctx context.Context) (*RateLimit, error) {
+	var (
+		err  error
+		node *RateLimit
+	)
+	if len(rluo.hooks) == 0 {
+		node, err = rluo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RateLimitMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			rluo.mutation = mutation
+			node, err = rluo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rluo.hooks) - 1; i >= 0; i-- {
+			if rluo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rluo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, rluo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*RateLimit)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from RateLimitMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rluo *RateLimitUpdateOne) SaveX(ctx context.Context) *RateLimit {
+	node, err := rluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rluo *RateLimitUpdateOne) Exec(ctx context.Context) error {
+	_, err := rluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rluo *RateLimitUpdateOne) ExecX(ctx context.Context) {
+	if err := rluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (rluo *RateLimitUpdateOne) sqlSave(ctx context.Context) (_node *RateLimit, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ratelimit.Table,
+			Columns: ratelimit.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: ratelimit.FieldID,
+			},
+		},
+	}
+	id, ok := rluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RateLimit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ratelimit.FieldID)
+		for _, f := range fields {
+			if !ratelimit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ratelimit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rluo.mutation.Key(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: ratelimit.FieldKey,
+		})
+	}
+	if value, ok := rluo.mutation.Tokens(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: ratelimit.FieldTokens,
+		})
+	}
+	if value, ok := rluo.mutation.AddedTokens(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: ratelimit.FieldTokens,
+		})
+	}
+	if value, ok := rluo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: ratelimit.FieldUpdatedAt,
+		})
+	}
+	_node = &RateLimit{config: rluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ratelimit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
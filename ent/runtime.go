@@ -3,8 +3,14 @@
 package ent
 
 import (
+	"notifications/ent/apikey"
+	"notifications/ent/channelcredential"
 	"notifications/ent/notification"
+	"notifications/ent/quota"
+	"notifications/ent/ratelimit"
+	"notifications/ent/schedule"
 	"notifications/ent/schema"
+	"notifications/ent/suppression"
 	"time"
 )
 
@@ -12,36 +18,162 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescPrefix is the schema descriptor for prefix field.
+	apikeyDescPrefix := apikeyFields[3].Descriptor()
+	// apikey.PrefixValidator is a validator for the "prefix" field. It is called by the builders before save.
+	apikey.PrefixValidator = apikeyDescPrefix.Validators[0].(func(string) error)
+	// apikeyDescHash is the schema descriptor for hash field.
+	apikeyDescHash := apikeyFields[4].Descriptor()
+	// apikey.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	apikey.HashValidator = apikeyDescHash.Validators[0].(func(string) error)
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[10].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	// apikeyDescUpdatedAt is the schema descriptor for updated_at field.
+	apikeyDescUpdatedAt := apikeyFields[11].Descriptor()
+	// apikey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	apikey.DefaultUpdatedAt = apikeyDescUpdatedAt.Default.(func() time.Time)
+	// apikey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	apikey.UpdateDefaultUpdatedAt = apikeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	channelcredentialFields := schema.ChannelCredential{}.Fields()
+	_ = channelcredentialFields
+	// channelcredentialDescTenant is the schema descriptor for tenant field.
+	channelcredentialDescTenant := channelcredentialFields[0].Descriptor()
+	// channelcredential.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	channelcredential.TenantValidator = channelcredentialDescTenant.Validators[0].(func(string) error)
+	// channelcredentialDescType is the schema descriptor for type field.
+	channelcredentialDescType := channelcredentialFields[1].Descriptor()
+	// channelcredential.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	channelcredential.TypeValidator = channelcredentialDescType.Validators[0].(func(string) error)
+	// channelcredentialDescCreatedAt is the schema descriptor for created_at field.
+	channelcredentialDescCreatedAt := channelcredentialFields[3].Descriptor()
+	// channelcredential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	channelcredential.DefaultCreatedAt = channelcredentialDescCreatedAt.Default.(func() time.Time)
+	// channelcredentialDescUpdatedAt is the schema descriptor for updated_at field.
+	channelcredentialDescUpdatedAt := channelcredentialFields[4].Descriptor()
+	// channelcredential.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	channelcredential.DefaultUpdatedAt = channelcredentialDescUpdatedAt.Default.(func() time.Time)
+	// channelcredential.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	channelcredential.UpdateDefaultUpdatedAt = channelcredentialDescUpdatedAt.UpdateDefault.(func() time.Time)
 	notificationFields := schema.Notification{}.Fields()
 	_ = notificationFields
 	// notificationDescType is the schema descriptor for type field.
-	notificationDescType := notificationFields[1].Descriptor()
+	notificationDescType := notificationFields[2].Descriptor()
 	// notification.DefaultType holds the default value on creation for the type field.
 	notification.DefaultType = schema.NotificationType(notificationDescType.Default.(string))
 	// notification.TypeValidator is a validator for the "type" field. It is called by the builders before save.
 	notification.TypeValidator = notificationDescType.Validators[0].(func(string) error)
 	// notificationDescStatus is the schema descriptor for status field.
-	notificationDescStatus := notificationFields[4].Descriptor()
+	notificationDescStatus := notificationFields[5].Descriptor()
 	// notification.DefaultStatus holds the default value on creation for the status field.
 	notification.DefaultStatus = schema.NotificationStatus(notificationDescStatus.Default.(string))
 	// notification.StatusValidator is a validator for the "status" field. It is called by the builders before save.
 	notification.StatusValidator = notificationDescStatus.Validators[0].(func(string) error)
 	// notificationDescCreatedAt is the schema descriptor for created_at field.
-	notificationDescCreatedAt := notificationFields[5].Descriptor()
+	notificationDescCreatedAt := notificationFields[6].Descriptor()
 	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
 	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
 	// notificationDescUpdatedAt is the schema descriptor for updated_at field.
-	notificationDescUpdatedAt := notificationFields[6].Descriptor()
+	notificationDescUpdatedAt := notificationFields[7].Descriptor()
 	// notification.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	notification.DefaultUpdatedAt = notificationDescUpdatedAt.Default.(func() time.Time)
 	// notification.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	notification.UpdateDefaultUpdatedAt = notificationDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// notificationDescPlannedAt is the schema descriptor for planned_at field.
-	notificationDescPlannedAt := notificationFields[7].Descriptor()
+	notificationDescPlannedAt := notificationFields[8].Descriptor()
 	// notification.DefaultPlannedAt holds the default value on creation for the planned_at field.
 	notification.DefaultPlannedAt = notificationDescPlannedAt.Default.(func() time.Time)
 	// notificationDescRetries is the schema descriptor for retries field.
-	notificationDescRetries := notificationFields[9].Descriptor()
+	notificationDescRetries := notificationFields[10].Descriptor()
 	// notification.DefaultRetries holds the default value on creation for the retries field.
 	notification.DefaultRetries = notificationDescRetries.Default.(int)
+	// notificationDescPriority is the schema descriptor for priority field.
+	notificationDescPriority := notificationFields[11].Descriptor()
+	// notification.DefaultPriority holds the default value on creation for the priority field.
+	notification.DefaultPriority = notificationDescPriority.Default.(int)
+	quotaFields := schema.Quota{}.Fields()
+	_ = quotaFields
+	// quotaDescType is the schema descriptor for type field.
+	quotaDescType := quotaFields[1].Descriptor()
+	// quota.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	quota.TypeValidator = quotaDescType.Validators[0].(func(string) error)
+	// quotaDescPerMinute is the schema descriptor for per_minute field.
+	quotaDescPerMinute := quotaFields[2].Descriptor()
+	// quota.DefaultPerMinute holds the default value on creation for the per_minute field.
+	quota.DefaultPerMinute = quotaDescPerMinute.Default.(int)
+	// quota.PerMinuteValidator is a validator for the "per_minute" field. It is called by the builders before save.
+	quota.PerMinuteValidator = quotaDescPerMinute.Validators[0].(func(int) error)
+	// quotaDescPerDay is the schema descriptor for per_day field.
+	quotaDescPerDay := quotaFields[3].Descriptor()
+	// quota.DefaultPerDay holds the default value on creation for the per_day field.
+	quota.DefaultPerDay = quotaDescPerDay.Default.(int)
+	// quota.PerDayValidator is a validator for the "per_day" field. It is called by the builders before save.
+	quota.PerDayValidator = quotaDescPerDay.Validators[0].(func(int) error)
+	// quotaDescCreatedAt is the schema descriptor for created_at field.
+	quotaDescCreatedAt := quotaFields[4].Descriptor()
+	// quota.DefaultCreatedAt holds the default value on creation for the created_at field.
+	quota.DefaultCreatedAt = quotaDescCreatedAt.Default.(func() time.Time)
+	// quotaDescUpdatedAt is the schema descriptor for updated_at field.
+	quotaDescUpdatedAt := quotaFields[5].Descriptor()
+	// quota.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	quota.DefaultUpdatedAt = quotaDescUpdatedAt.Default.(func() time.Time)
+	// quota.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	quota.UpdateDefaultUpdatedAt = quotaDescUpdatedAt.UpdateDefault.(func() time.Time)
+	ratelimitFields := schema.RateLimit{}.Fields()
+	_ = ratelimitFields
+	// ratelimitDescUpdatedAt is the schema descriptor for updated_at field.
+	ratelimitDescUpdatedAt := ratelimitFields[2].Descriptor()
+	// ratelimit.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ratelimit.DefaultUpdatedAt = ratelimitDescUpdatedAt.Default.(func() time.Time)
+	scheduleFields := schema.Schedule{}.Fields()
+	_ = scheduleFields
+	// scheduleDescType is the schema descriptor for type field.
+	scheduleDescType := scheduleFields[2].Descriptor()
+	// schedule.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	schedule.TypeValidator = scheduleDescType.Validators[0].(func(string) error)
+	// scheduleDescPriority is the schema descriptor for priority field.
+	scheduleDescPriority := scheduleFields[5].Descriptor()
+	// schedule.DefaultPriority holds the default value on creation for the priority field.
+	schedule.DefaultPriority = scheduleDescPriority.Default.(int)
+	// scheduleDescCron is the schema descriptor for cron field.
+	scheduleDescCron := scheduleFields[7].Descriptor()
+	// schedule.CronValidator is a validator for the "cron" field. It is called by the builders before save.
+	schedule.CronValidator = scheduleDescCron.Validators[0].(func(string) error)
+	// scheduleDescTimezone is the schema descriptor for timezone field.
+	scheduleDescTimezone := scheduleFields[8].Descriptor()
+	// schedule.TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	schedule.TimezoneValidator = scheduleDescTimezone.Validators[0].(func(string) error)
+	// scheduleDescCreatedAt is the schema descriptor for created_at field.
+	scheduleDescCreatedAt := scheduleFields[12].Descriptor()
+	// schedule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	schedule.DefaultCreatedAt = scheduleDescCreatedAt.Default.(func() time.Time)
+	// scheduleDescUpdatedAt is the schema descriptor for updated_at field.
+	scheduleDescUpdatedAt := scheduleFields[13].Descriptor()
+	// schedule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	schedule.DefaultUpdatedAt = scheduleDescUpdatedAt.Default.(func() time.Time)
+	// schedule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	schedule.UpdateDefaultUpdatedAt = scheduleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	suppressionFields := schema.Suppression{}.Fields()
+	_ = suppressionFields
+	// suppressionDescType is the schema descriptor for type field.
+	suppressionDescType := suppressionFields[2].Descriptor()
+	// suppression.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	suppression.TypeValidator = suppressionDescType.Validators[0].(func(string) error)
+	// suppressionDescAddress is the schema descriptor for address field.
+	suppressionDescAddress := suppressionFields[3].Descriptor()
+	// suppression.AddressValidator is a validator for the "address" field. It is called by the builders before save.
+	suppression.AddressValidator = suppressionDescAddress.Validators[0].(func(string) error)
+	// suppressionDescCreatedAt is the schema descriptor for created_at field.
+	suppressionDescCreatedAt := suppressionFields[6].Descriptor()
+	// suppression.DefaultCreatedAt holds the default value on creation for the created_at field.
+	suppression.DefaultCreatedAt = suppressionDescCreatedAt.Default.(func() time.Time)
+	// suppressionDescUpdatedAt is the schema descriptor for updated_at field.
+	suppressionDescUpdatedAt := suppressionFields[7].Descriptor()
+	// suppression.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	suppression.DefaultUpdatedAt = suppressionDescUpdatedAt.Default.(func() time.Time)
+	// suppression.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	suppression.UpdateDefaultUpdatedAt = suppressionDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
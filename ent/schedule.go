@@ -0,0 +1,260 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"notifications/ent/schedule"
+	"notifications/ent/schema"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Schedule is the model entity for the Schedule schema.
+type Schedule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// SenderID holds the value of the "sender_id" field.
+	SenderID int `json:"sender_id,omitempty"`
+	// tenant of sender, every notification of schedule belongs to it
+	Tenant string `json:"tenant,omitempty"`
+	// types in (plain|sms|email|whatsapp|push|telegram)
+	Type schema.NotificationType `json:"type,omitempty"`
+	// message payload of every notification of schedule
+	Payload schema.Payload `json:"payload,omitempty"`
+	// time to live of every notification in seconds
+	TTL int `json:"ttl,omitempty"`
+	// priority of every notification of schedule
+	Priority int `json:"priority,omitempty"`
+	// category of every notification of schedule
+	Category string `json:"category,omitempty"`
+	// cron expression of occurrences, e.g. '0 10 * * 1' or '@weekly'
+	Cron string `json:"cron,omitempty"`
+	// IANA timezone of cron expression, UTC if empty
+	Timezone string `json:"timezone,omitempty"`
+	// no occurrences are materialized after this time
+	EndsAt *time.Time `json:"ends_at,omitempty"`
+	// time of next occurrence, schedule is finished if empty
+	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+	// time of last materialized occurrence
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+	// creation time of schedule
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// last update time of schedule
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Schedule) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case schedule.FieldPayload:
+			values[i] = new([]byte)
+		case schedule.FieldID, schedule.FieldSenderID, schedule.FieldTTL, schedule.FieldPriority:
+			values[i] = new(sql.NullInt64)
+		case schedule.FieldTenant, schedule.FieldType, schedule.FieldCategory, schedule.FieldCron, schedule.FieldTimezone:
+			values[i] = new(sql.NullString)
+		case schedule.FieldEndsAt, schedule.FieldNextRunAt, schedule.FieldLastRunAt, schedule.FieldCreatedAt, schedule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Schedule", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Schedule fields.
+func (s *Schedule) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case schedule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int(value.Int64)
+		case schedule.FieldSenderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_id", values[i])
+			} else if value.Valid {
+				s.SenderID = int(value.Int64)
+			}
+		case schedule.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				s.Tenant = value.String
+			}
+		case schedule.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				s.Type = schema.NotificationType(value.String)
+			}
+		case schedule.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Payload); err != nil {
+					return fmt.Errorf("unmarshal field payload: %w", err)
+				}
+			}
+		case schedule.FieldTTL:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ttl", values[i])
+			} else if value.Valid {
+				s.TTL = int(value.Int64)
+			}
+		case schedule.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				s.Priority = int(value.Int64)
+			}
+		case schedule.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				s.Category = value.String
+			}
+		case schedule.FieldCron:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cron", values[i])
+			} else if value.Valid {
+				s.Cron = value.String
+			}
+		case schedule.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				s.Timezone = value.String
+			}
+		case schedule.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				s.EndsAt = new(time.Time)
+				*s.EndsAt = value.Time
+			}
+		case schedule.FieldNextRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_run_at", values[i])
+			} else if value.Valid {
+				s.NextRunAt = new(time.Time)
+				*s.NextRunAt = value.Time
+			}
+		case schedule.FieldLastRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_run_at", values[i])
+			} else if value.Valid {
+				s.LastRunAt = new(time.Time)
+				*s.LastRunAt = value.Time
+			}
+		case schedule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case schedule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this Schedule.
+// Note that you need to call Schedule.Unwrap() before calling this method if this Schedule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Schedule) Update() *ScheduleUpdateOne {
+	return (&ScheduleClient{config: s.config}).UpdateOne(s)
+}
+
+// Unwrap unwraps the Schedule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Schedule) Unwrap() *Schedule {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Schedule is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Schedule) String() string {
+	var builder strings.Builder
+	builder.WriteString("Schedule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("sender_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.SenderID))
+	builder.WriteString(", ")
+	builder.WriteString("tenant=")
+	builder.WriteString(s.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", s.Type))
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", s.Payload))
+	builder.WriteString(", ")
+	builder.WriteString("ttl=")
+	builder.WriteString(fmt.Sprintf("%v", s.TTL))
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", s.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(s.Category)
+	builder.WriteString(", ")
+	builder.WriteString("cron=")
+	builder.WriteString(s.Cron)
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(s.Timezone)
+	builder.WriteString(", ")
+	if v := s.EndsAt; v != nil {
+		builder.WriteString("ends_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := s.NextRunAt; v != nil {
+		builder.WriteString("next_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := s.LastRunAt; v != nil {
+		builder.WriteString("last_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Schedules is a parsable slice of Schedule.
+type Schedules []*Schedule
+
+func (s Schedules) config(cfg config) {
+	for _i := range s {
+		s[_i].config = cfg
+	}
+}
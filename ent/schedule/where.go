@@ -0,0 +1,1308 @@
+// Code generated by ent, DO NOT EDIT.
+
+package schedule
+
+import (
+	"notifications/ent/predicate"
+	"notifications/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// SenderID applies equality check predicate on the "sender_id" field. It's identical to SenderIDEQ.
+func SenderID(v int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSenderID), v))
+	})
+}
+
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenant), v))
+	})
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v schema.NotificationType) predicate.Schedule {
+	vc := string(v)
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldType), vc))
+	})
+}
+
+// TTL applies equality check predicate on the "ttl" field. It's identical to TTLEQ.
+func TTL(v int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTTL), v))
+	})
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPriority), v))
+	})
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCategory), v))
+	})
+}
+
+// Cron applies equality check predicate on the "cron" field. It's identical to CronEQ.
+func Cron(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCron), v))
+	})
+}
+
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTimezone), v))
+	})
+}
+
+// EndsAt applies equality check predicate on the "ends_at" field. It's identical to EndsAtEQ.
+func EndsAt(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndsAt), v))
+	})
+}
+
+// NextRunAt applies equality check predicate on the "next_run_at" field. It's identical to NextRunAtEQ.
+func NextRunAt(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNextRunAt), v))
+	})
+}
+
+// LastRunAt applies equality check predicate on the "last_run_at" field. It's identical to LastRunAtEQ.
+func LastRunAt(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastRunAt), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// SenderIDEQ applies the EQ predicate on the "sender_id" field.
+func SenderIDEQ(v int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSenderID), v))
+	})
+}
+
+// SenderIDNEQ applies the NEQ predicate on the "sender_id" field.
+func SenderIDNEQ(v int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSenderID), v))
+	})
+}
+
+// SenderIDIn applies the In predicate on the "sender_id" field.
+func SenderIDIn(vs ...int) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldSenderID), v...))
+	})
+}
+
+// SenderIDNotIn applies the NotIn predicate on the "sender_id" field.
+func SenderIDNotIn(vs ...int) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldSenderID), v...))
+	})
+}
+
+// SenderIDGT applies the GT predicate on the "sender_id" field.
+func SenderIDGT(v int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSenderID), v))
+	})
+}
+
+// SenderIDGTE applies the GTE predicate on the "sender_id" field.
+func SenderIDGTE(v int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSenderID), v))
+	})
+}
+
+// SenderIDLT applies the LT predicate on the "sender_id" field.
+func SenderIDLT(v int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSenderID), v))
+	})
+}
+
+// SenderIDLTE applies the LTE predicate on the "sender_id" field.
+func SenderIDLTE(v int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSenderID), v))
+	})
+}
+
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenant), v))
+	})
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenant), v))
+	})
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldTenant), v...))
+	})
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldTenant), v...))
+	})
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenant), v))
+	})
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenant), v))
+	})
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenant), v))
+	})
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenant), v))
+	})
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTenant), v))
+	})
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTenant), v))
+	})
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTenant), v))
+	})
+}
+
+// TenantIsNil applies the IsNil predicate on the "tenant" field.
+func TenantIsNil() predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTenant)))
+	})
+}
+
+// TenantNotNil applies the NotNil predicate on the "tenant" field.
+func TenantNotNil() predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTenant)))
+	})
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTenant), v))
+	})
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTenant), v))
+	})
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v schema.NotificationType) predicate.Schedule {
+	vc := string(v)
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldType), vc))
+	})
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v schema.NotificationType) predicate.Schedule {
+	vc := string(v)
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldType), vc))
+	})
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...schema.NotificationType) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldType), v...))
+	})
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...schema.NotificationType) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldType), v...))
+	})
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v schema.NotificationType) predicate.Schedule {
+	vc := string(v)
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldType), vc))
+	})
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v schema.NotificationType) predicate.Schedule {
+	vc := string(v)
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldType), vc))
+	})
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v schema.NotificationType) predicate.Schedule {
+	vc := string(v)
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldType), vc))
+	})
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v schema.NotificationType) predicate.Schedule {
+	vc := string(v)
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldType), vc))
+	})
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v schema.NotificationType) predicate.Schedule {
+	vc := string(v)
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldType), vc))
+	})
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v schema.NotificationType) predicate.Schedule {
+	vc := string(v)
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldType), vc))
+	})
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v schema.NotificationType) predicate.Schedule {
+	vc := string(v)
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldType), vc))
+	})
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v schema.NotificationType) predicate.Schedule {
+	vc := string(v)
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldType), vc))
+	})
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v schema.NotificationType) predicate.Schedule {
+	vc := string(v)
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldType), vc))
+	})
+}
+
+// TTLEQ applies the EQ predicate on the "ttl" field.
+func TTLEQ(v int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTTL), v))
+	})
+}
+
+// TTLNEQ applies the NEQ predicate on the "ttl" field.
+func TTLNEQ(v int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTTL), v))
+	})
+}
+
+// TTLIn applies the In predicate on the "ttl" field.
+func TTLIn(vs ...int) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldTTL), v...))
+	})
+}
+
+// TTLNotIn applies the NotIn predicate on the "ttl" field.
+func TTLNotIn(vs ...int) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldTTL), v...))
+	})
+}
+
+// TTLGT applies the GT predicate on the "ttl" field.
+func TTLGT(v int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTTL), v))
+	})
+}
+
+// TTLGTE applies the GTE predicate on the "ttl" field.
+func TTLGTE(v int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTTL), v))
+	})
+}
+
+// TTLLT applies the LT predicate on the "ttl" field.
+func TTLLT(v int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTTL), v))
+	})
+}
+
+// TTLLTE applies the LTE predicate on the "ttl" field.
+func TTLLTE(v int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTTL), v))
+	})
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPriority), v))
+	})
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPriority), v))
+	})
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldPriority), v...))
+	})
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldPriority), v...))
+	})
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPriority), v))
+	})
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPriority), v))
+	})
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPriority), v))
+	})
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPriority), v))
+	})
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCategory), v...))
+	})
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCategory), v...))
+	})
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryIsNil applies the IsNil predicate on the "category" field.
+func CategoryIsNil() predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCategory)))
+	})
+}
+
+// CategoryNotNil applies the NotNil predicate on the "category" field.
+func CategoryNotNil() predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCategory)))
+	})
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCategory), v))
+	})
+}
+
+// CronEQ applies the EQ predicate on the "cron" field.
+func CronEQ(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCron), v))
+	})
+}
+
+// CronNEQ applies the NEQ predicate on the "cron" field.
+func CronNEQ(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCron), v))
+	})
+}
+
+// CronIn applies the In predicate on the "cron" field.
+func CronIn(vs ...string) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCron), v...))
+	})
+}
+
+// CronNotIn applies the NotIn predicate on the "cron" field.
+func CronNotIn(vs ...string) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCron), v...))
+	})
+}
+
+// CronGT applies the GT predicate on the "cron" field.
+func CronGT(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCron), v))
+	})
+}
+
+// CronGTE applies the GTE predicate on the "cron" field.
+func CronGTE(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCron), v))
+	})
+}
+
+// CronLT applies the LT predicate on the "cron" field.
+func CronLT(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCron), v))
+	})
+}
+
+// CronLTE applies the LTE predicate on the "cron" field.
+func CronLTE(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCron), v))
+	})
+}
+
+// CronContains applies the Contains predicate on the "cron" field.
+func CronContains(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCron), v))
+	})
+}
+
+// CronHasPrefix applies the HasPrefix predicate on the "cron" field.
+func CronHasPrefix(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCron), v))
+	})
+}
+
+// CronHasSuffix applies the HasSuffix predicate on the "cron" field.
+func CronHasSuffix(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCron), v))
+	})
+}
+
+// CronEqualFold applies the EqualFold predicate on the "cron" field.
+func CronEqualFold(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCron), v))
+	})
+}
+
+// CronContainsFold applies the ContainsFold predicate on the "cron" field.
+func CronContainsFold(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCron), v))
+	})
+}
+
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTimezone), v))
+	})
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTimezone), v))
+	})
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldTimezone), v...))
+	})
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldTimezone), v...))
+	})
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTimezone), v))
+	})
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTimezone), v))
+	})
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTimezone), v))
+	})
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTimezone), v))
+	})
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTimezone), v))
+	})
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTimezone), v))
+	})
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTimezone), v))
+	})
+}
+
+// TimezoneIsNil applies the IsNil predicate on the "timezone" field.
+func TimezoneIsNil() predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTimezone)))
+	})
+}
+
+// TimezoneNotNil applies the NotNil predicate on the "timezone" field.
+func TimezoneNotNil() predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTimezone)))
+	})
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTimezone), v))
+	})
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTimezone), v))
+	})
+}
+
+// EndsAtEQ applies the EQ predicate on the "ends_at" field.
+func EndsAtEQ(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndsAt), v))
+	})
+}
+
+// EndsAtNEQ applies the NEQ predicate on the "ends_at" field.
+func EndsAtNEQ(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEndsAt), v))
+	})
+}
+
+// EndsAtIn applies the In predicate on the "ends_at" field.
+func EndsAtIn(vs ...time.Time) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldEndsAt), v...))
+	})
+}
+
+// EndsAtNotIn applies the NotIn predicate on the "ends_at" field.
+func EndsAtNotIn(vs ...time.Time) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldEndsAt), v...))
+	})
+}
+
+// EndsAtGT applies the GT predicate on the "ends_at" field.
+func EndsAtGT(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEndsAt), v))
+	})
+}
+
+// EndsAtGTE applies the GTE predicate on the "ends_at" field.
+func EndsAtGTE(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEndsAt), v))
+	})
+}
+
+// EndsAtLT applies the LT predicate on the "ends_at" field.
+func EndsAtLT(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEndsAt), v))
+	})
+}
+
+// EndsAtLTE applies the LTE predicate on the "ends_at" field.
+func EndsAtLTE(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEndsAt), v))
+	})
+}
+
+// EndsAtIsNil applies the IsNil predicate on the "ends_at" field.
+func EndsAtIsNil() predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEndsAt)))
+	})
+}
+
+// EndsAtNotNil applies the NotNil predicate on the "ends_at" field.
+func EndsAtNotNil() predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEndsAt)))
+	})
+}
+
+// NextRunAtEQ applies the EQ predicate on the "next_run_at" field.
+func NextRunAtEQ(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNextRunAt), v))
+	})
+}
+
+// NextRunAtNEQ applies the NEQ predicate on the "next_run_at" field.
+func NextRunAtNEQ(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNextRunAt), v))
+	})
+}
+
+// NextRunAtIn applies the In predicate on the "next_run_at" field.
+func NextRunAtIn(vs ...time.Time) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldNextRunAt), v...))
+	})
+}
+
+// NextRunAtNotIn applies the NotIn predicate on the "next_run_at" field.
+func NextRunAtNotIn(vs ...time.Time) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldNextRunAt), v...))
+	})
+}
+
+// NextRunAtGT applies the GT predicate on the "next_run_at" field.
+func NextRunAtGT(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNextRunAt), v))
+	})
+}
+
+// NextRunAtGTE applies the GTE predicate on the "next_run_at" field.
+func NextRunAtGTE(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNextRunAt), v))
+	})
+}
+
+// NextRunAtLT applies the LT predicate on the "next_run_at" field.
+func NextRunAtLT(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNextRunAt), v))
+	})
+}
+
+// NextRunAtLTE applies the LTE predicate on the "next_run_at" field.
+func NextRunAtLTE(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNextRunAt), v))
+	})
+}
+
+// NextRunAtIsNil applies the IsNil predicate on the "next_run_at" field.
+func NextRunAtIsNil() predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldNextRunAt)))
+	})
+}
+
+// NextRunAtNotNil applies the NotNil predicate on the "next_run_at" field.
+func NextRunAtNotNil() predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldNextRunAt)))
+	})
+}
+
+// LastRunAtEQ applies the EQ predicate on the "last_run_at" field.
+func LastRunAtEQ(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastRunAt), v))
+	})
+}
+
+// LastRunAtNEQ applies the NEQ predicate on the "last_run_at" field.
+func LastRunAtNEQ(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLastRunAt), v))
+	})
+}
+
+// LastRunAtIn applies the In predicate on the "last_run_at" field.
+func LastRunAtIn(vs ...time.Time) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldLastRunAt), v...))
+	})
+}
+
+// LastRunAtNotIn applies the NotIn predicate on the "last_run_at" field.
+func LastRunAtNotIn(vs ...time.Time) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldLastRunAt), v...))
+	})
+}
+
+// LastRunAtGT applies the GT predicate on the "last_run_at" field.
+func LastRunAtGT(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLastRunAt), v))
+	})
+}
+
+// LastRunAtGTE applies the GTE predicate on the "last_run_at" field.
+func LastRunAtGTE(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLastRunAt), v))
+	})
+}
+
+// LastRunAtLT applies the LT predicate on the "last_run_at" field.
+func LastRunAtLT(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLastRunAt), v))
+	})
+}
+
+// LastRunAtLTE applies the LTE predicate on the "last_run_at" field.
+func LastRunAtLTE(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLastRunAt), v))
+	})
+}
+
+// LastRunAtIsNil applies the IsNil predicate on the "last_run_at" field.
+func LastRunAtIsNil() predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLastRunAt)))
+	})
+}
+
+// LastRunAtNotNil applies the NotNil predicate on the "last_run_at" field.
+func LastRunAtNotNil() predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLastRunAt)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Schedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Schedule) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Schedule) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Schedule) predicate.Schedule {
+	return predicate.Schedule(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,536 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"notifications/ent/schedule"
+	"notifications/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScheduleCreate is the builder for creating a Schedule entity.
+type ScheduleCreate struct {
+	config
+	mutation *ScheduleMutation
+	hooks    []Hook
+}
+
+// SetSenderID sets the "sender_id" field.
+func (sc *ScheduleCreate) SetSenderID(i int) *ScheduleCreate {
+	sc.mutation.SetSenderID(i)
+	return sc
+}
+
+// SetTenant sets the "tenant" field.
+func (sc *ScheduleCreate) SetTenant(s string) *ScheduleCreate {
+	sc.mutation.SetTenant(s)
+	return sc
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableTenant(s *string) *ScheduleCreate {
+	if s != nil {
+		sc.SetTenant(*s)
+	}
+	return sc
+}
+
+// SetType sets the "type" field.
+func (sc *ScheduleCreate) SetType(st schema.NotificationType) *ScheduleCreate {
+	sc.mutation.SetType(st)
+	return sc
+}
+
+// SetPayload sets the "payload" field.
+func (sc *ScheduleCreate) SetPayload(s schema.Payload) *ScheduleCreate {
+	sc.mutation.SetPayload(s)
+	return sc
+}
+
+// SetTTL sets the "ttl" field.
+func (sc *ScheduleCreate) SetTTL(i int) *ScheduleCreate {
+	sc.mutation.SetTTL(i)
+	return sc
+}
+
+// SetPriority sets the "priority" field.
+func (sc *ScheduleCreate) SetPriority(i int) *ScheduleCreate {
+	sc.mutation.SetPriority(i)
+	return sc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillablePriority(i *int) *ScheduleCreate {
+	if i != nil {
+		sc.SetPriority(*i)
+	}
+	return sc
+}
+
+// SetCategory sets the "category" field.
+func (sc *ScheduleCreate) SetCategory(s string) *ScheduleCreate {
+	sc.mutation.SetCategory(s)
+	return sc
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableCategory(s *string) *ScheduleCreate {
+	if s != nil {
+		sc.SetCategory(*s)
+	}
+	return sc
+}
+
+// SetCron sets the "cron" field.
+func (sc *ScheduleCreate) SetCron(s string) *ScheduleCreate {
+	sc.mutation.SetCron(s)
+	return sc
+}
+
+// SetTimezone sets the "timezone" field.
+func (sc *ScheduleCreate) SetTimezone(s string) *ScheduleCreate {
+	sc.mutation.SetTimezone(s)
+	return sc
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableTimezone(s *string) *ScheduleCreate {
+	if s != nil {
+		sc.SetTimezone(*s)
+	}
+	return sc
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (sc *ScheduleCreate) SetEndsAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetEndsAt(t)
+	return sc
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableEndsAt(t *time.Time) *ScheduleCreate {
+	if t != nil {
+		sc.SetEndsAt(*t)
+	}
+	return sc
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (sc *ScheduleCreate) SetNextRunAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetNextRunAt(t)
+	return sc
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableNextRunAt(t *time.Time) *ScheduleCreate {
+	if t != nil {
+		sc.SetNextRunAt(*t)
+	}
+	return sc
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (sc *ScheduleCreate) SetLastRunAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetLastRunAt(t)
+	return sc
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableLastRunAt(t *time.Time) *ScheduleCreate {
+	if t != nil {
+		sc.SetLastRunAt(*t)
+	}
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *ScheduleCreate) SetCreatedAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableCreatedAt(t *time.Time) *ScheduleCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *ScheduleCreate) SetUpdatedAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableUpdatedAt(t *time.Time) *ScheduleCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// Mutation returns the ScheduleMutation object of the builder.
+func (sc *ScheduleCreate) Mutation() *ScheduleMutation {
+	return sc.mutation
+}
+
+// Save creates the Schedule in the database.
+func (sc *ScheduleCreate) Save(ctx context.Context) (*Schedule, error) {
+	var (
+		err  error
+		node *Schedule
+	)
+	sc.defaults()
+	if len(sc.hooks) == 0 {
+		if err = sc.check(); err != nil {
+			return nil, err
+		}
+		node, err = sc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ScheduleMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sc.check(); err != nil {
+				return nil, err
+			}
+			sc.mutation = mutation
+			if node, err = sc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sc.hooks) - 1; i >= 0; i-- {
+			if sc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, sc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Schedule)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ScheduleMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *ScheduleCreate) SaveX(ctx context.Context) *Schedule {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *ScheduleCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *ScheduleCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *ScheduleCreate) defaults() {
+	if _, ok := sc.mutation.Priority(); !ok {
+		v := schedule.DefaultPriority
+		sc.mutation.SetPriority(v)
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := schedule.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		v := schedule.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *ScheduleCreate) check() error {
+	if _, ok := sc.mutation.SenderID(); !ok {
+		return &ValidationError{Name: "sender_id", err: errors.New(`ent: missing required field "Schedule.sender_id"`)}
+	}
+	if _, ok := sc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Schedule.type"`)}
+	}
+	if v, ok := sc.mutation.GetType(); ok {
+		if err := schedule.TypeValidator(string(v)); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Schedule.type": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "Schedule.payload"`)}
+	}
+	if _, ok := sc.mutation.TTL(); !ok {
+		return &ValidationError{Name: "ttl", err: errors.New(`ent: missing required field "Schedule.ttl"`)}
+	}
+	if _, ok := sc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "Schedule.priority"`)}
+	}
+	if _, ok := sc.mutation.Cron(); !ok {
+		return &ValidationError{Name: "cron", err: errors.New(`ent: missing required field "Schedule.cron"`)}
+	}
+	if v, ok := sc.mutation.Cron(); ok {
+		if err := schedule.CronValidator(v); err != nil {
+			return &ValidationError{Name: "cron", err: fmt.Errorf(`ent: validator failed for field "Schedule.cron": %w`, err)}
+		}
+	}
+	if v, ok := sc.mutation.Timezone(); ok {
+		if err := schedule.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "Schedule.timezone": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Schedule.created_at"`)}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Schedule.updated_at"`)}
+	}
+	return nil
+}
+
+func (sc *ScheduleCreate) sqlSave(ctx context.Context) (*Schedule, error) {
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (sc *ScheduleCreate) createSpec() (*Schedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Schedule{config: sc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: schedule.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: schedule.FieldID,
+			},
+		}
+	)
+	if value, ok := sc.mutation.SenderID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: schedule.FieldSenderID,
+		})
+		_node.SenderID = value
+	}
+	if value, ok := sc.mutation.Tenant(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: schedule.FieldTenant,
+		})
+		_node.Tenant = value
+	}
+	if value, ok := sc.mutation.GetType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: schedule.FieldType,
+		})
+		_node.Type = value
+	}
+	if value, ok := sc.mutation.Payload(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: schedule.FieldPayload,
+		})
+		_node.Payload = value
+	}
+	if value, ok := sc.mutation.TTL(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: schedule.FieldTTL,
+		})
+		_node.TTL = value
+	}
+	if value, ok := sc.mutation.Priority(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: schedule.FieldPriority,
+		})
+		_node.Priority = value
+	}
+	if value, ok := sc.mutation.Category(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: schedule.FieldCategory,
+		})
+		_node.Category = value
+	}
+	if value, ok := sc.mutation.Cron(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: schedule.FieldCron,
+		})
+		_node.Cron = value
+	}
+	if value, ok := sc.mutation.Timezone(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: schedule.FieldTimezone,
+		})
+		_node.Timezone = value
+	}
+	if value, ok := sc.mutation.EndsAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: schedule.FieldEndsAt,
+		})
+		_node.EndsAt = &value
+	}
+	if value, ok := sc.mutation.NextRunAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: schedule.FieldNextRunAt,
+		})
+		_node.NextRunAt = &value
+	}
+	if value, ok := sc.mutation.LastRunAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: schedule.FieldLastRunAt,
+		})
+		_node.LastRunAt = &value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: schedule.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: schedule.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ScheduleCreateBulk is the builder for creating many Schedule entities in bulk.
+type ScheduleCreateBulk struct {
+	config
+	builders []*ScheduleCreate
+}
+
+// Save creates the Schedule entities in the database.
+func (scb *ScheduleCreateBulk) Save(ctx context.Context) ([]*Schedule, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Schedule, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *ScheduleCreateBulk) SaveX(ctx context.Context) []*Schedule {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *ScheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *ScheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
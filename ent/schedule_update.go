@@ -0,0 +1,995 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"notifications/ent/predicate"
+	"notifications/ent/schedule"
+	"notifications/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScheduleUpdate is the builder for updating Schedule entities.
+type ScheduleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScheduleMutation
+}
+
+// Where appends a list predicates to the ScheduleUpdate builder.
+func (su *ScheduleUpdate) Where(ps ...predicate.Schedule) *ScheduleUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetSenderID sets the "sender_id" field.
+func (su *ScheduleUpdate) SetSenderID(i int) *ScheduleUpdate {
+	su.mutation.ResetSenderID()
+	su.mutation.SetSenderID(i)
+	return su
+}
+
+// AddSenderID adds i to the "sender_id" field.
+func (su *ScheduleUpdate) AddSenderID(i int) *ScheduleUpdate {
+	su.mutation.AddSenderID(i)
+	return su
+}
+
+// SetTenant sets the "tenant" field.
+func (su *ScheduleUpdate) SetTenant(s string) *ScheduleUpdate {
+	su.mutation.SetTenant(s)
+	return su
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableTenant(s *string) *ScheduleUpdate {
+	if s != nil {
+		su.SetTenant(*s)
+	}
+	return su
+}
+
+// ClearTenant clears the value of the "tenant" field.
+func (su *ScheduleUpdate) ClearTenant() *ScheduleUpdate {
+	su.mutation.ClearTenant()
+	return su
+}
+
+// SetType sets the "type" field.
+func (su *ScheduleUpdate) SetType(st schema.NotificationType) *ScheduleUpdate {
+	su.mutation.SetType(st)
+	return su
+}
+
+// SetPayload sets the "payload" field.
+func (su *ScheduleUpdate) SetPayload(s schema.Payload) *ScheduleUpdate {
+	su.mutation.SetPayload(s)
+	return su
+}
+
+// SetTTL sets the "ttl" field.
+func (su *ScheduleUpdate) SetTTL(i int) *ScheduleUpdate {
+	su.mutation.ResetTTL()
+	su.mutation.SetTTL(i)
+	return su
+}
+
+// AddTTL adds i to the "ttl" field.
+func (su *ScheduleUpdate) AddTTL(i int) *ScheduleUpdate {
+	su.mutation.AddTTL(i)
+	return su
+}
+
+// SetPriority sets the "priority" field.
+func (su *ScheduleUpdate) SetPriority(i int) *ScheduleUpdate {
+	su.mutation.ResetPriority()
+	su.mutation.SetPriority(i)
+	return su
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillablePriority(i *int) *ScheduleUpdate {
+	if i != nil {
+		su.SetPriority(*i)
+	}
+	return su
+}
+
+// AddPriority adds i to the "priority" field.
+func (su *ScheduleUpdate) AddPriority(i int) *ScheduleUpdate {
+	su.mutation.AddPriority(i)
+	return su
+}
+
+// SetCategory sets the "category" field.
+func (su *ScheduleUpdate) SetCategory(s string) *ScheduleUpdate {
+	su.mutation.SetCategory(s)
+	return su
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableCategory(s *string) *ScheduleUpdate {
+	if s != nil {
+		su.SetCategory(*s)
+	}
+	return su
+}
+
+// ClearCategory clears the value of the "category" field.
+func (su *ScheduleUpdate) ClearCategory() *ScheduleUpdate {
+	su.mutation.ClearCategory()
+	return su
+}
+
+// SetCron sets the "cron" field.
+func (su *ScheduleUpdate) SetCron(s string) *ScheduleUpdate {
+	su.mutation.SetCron(s)
+	return su
+}
+
+// SetTimezone sets the "timezone" field.
+func (su *ScheduleUpdate) SetTimezone(s string) *ScheduleUpdate {
+	su.mutation.SetTimezone(s)
+	return su
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableTimezone(s *string) *ScheduleUpdate {
+	if s != nil {
+		su.SetTimezone(*s)
+	}
+	return su
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (su *ScheduleUpdate) ClearTimezone() *ScheduleUpdate {
+	su.mutation.ClearTimezone()
+	return su
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (su *ScheduleUpdate) SetEndsAt(t time.Time) *ScheduleUpdate {
+	su.mutation.SetEndsAt(t)
+	return su
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableEndsAt(t *time.Time) *ScheduleUpdate {
+	if t != nil {
+		su.SetEndsAt(*t)
+	}
+	return su
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (su *ScheduleUpdate) ClearEndsAt() *ScheduleUpdate {
+	su.mutation.ClearEndsAt()
+	return su
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (su *ScheduleUpdate) SetNextRunAt(t time.Time) *ScheduleUpdate {
+	su.mutation.SetNextRunAt(t)
+	return su
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableNextRunAt(t *time.Time) *ScheduleUpdate {
+	if t != nil {
+		su.SetNextRunAt(*t)
+	}
+	return su
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (su *ScheduleUpdate) ClearNextRunAt() *ScheduleUpdate {
+	su.mutation.ClearNextRunAt()
+	return su
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (su *ScheduleUpdate) SetLastRunAt(t time.Time) *ScheduleUpdate {
+	su.mutation.SetLastRunAt(t)
+	return su
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableLastRunAt(t *time.Time) *ScheduleUpdate {
+	if t != nil {
+		su.SetLastRunAt(*t)
+	}
+	return su
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (su *ScheduleUpdate) ClearLastRunAt() *ScheduleUpdate {
+	su.mutation.ClearLastRunAt()
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *ScheduleUpdate) SetUpdatedAt(t time.Time) *ScheduleUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// Mutation returns the ScheduleMutation object of the builder.
+func (su *ScheduleUpdate) Mutation() *ScheduleMutation {
+	return su.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *ScheduleUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	su.defaults()
+	if len(su.hooks) == 0 {
+		if err = su.check(); err != nil {
+			return 0, err
+		}
+		affected, err = su.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ScheduleMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = su.check(); err != nil {
+				return 0, err
+			}
+			su.mutation = mutation
+			affected, err = su.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(su.hooks) - 1; i >= 0; i-- {
+			if su.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = su.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, su.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *ScheduleUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *ScheduleUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *ScheduleUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *ScheduleUpdate) defaults() {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		v := schedule.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *ScheduleUpdate) check() error {
+	if v, ok := su.mutation.GetType(); ok {
+		if err := schedule.TypeValidator(string(v)); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Schedule.type": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.Cron(); ok {
+		if err := schedule.CronValidator(v); err != nil {
+			return &ValidationError{Name: "cron", err: fmt.Errorf(`ent: validator failed for field "Schedule.cron": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.Timezone(); ok {
+		if err := schedule.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "Schedule.timezone": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (su *ScheduleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   schedule.Table,
+			Columns: schedule.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: schedule.FieldID,
+			},
+		},
+	}
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.SenderID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: schedule.FieldSenderID,
+		})
+	}
+	if value, ok := su.mutation.AddedSenderID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: schedule.FieldSenderID,
+		})
+	}
+	if value, ok := su.mutation.Tenant(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: schedule.FieldTenant,
+		})
+	}
+	if su.mutation.TenantCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: schedule.FieldTenant,
+		})
+	}
+	if value, ok := su.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: schedule.FieldType,
+		})
+	}
+	if value, ok := su.mutation.Payload(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: schedule.FieldPayload,
+		})
+	}
+	if value, ok := su.mutation.TTL(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: schedule.FieldTTL,
+		})
+	}
+	if value, ok := su.mutation.AddedTTL(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: schedule.FieldTTL,
+		})
+	}
+	if value, ok := su.mutation.Priority(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: schedule.FieldPriority,
+		})
+	}
+	if value, ok := su.mutation.AddedPriority(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: schedule.FieldPriority,
+		})
+	}
+	if value, ok := su.mutation.Category(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: schedule.FieldCategory,
+		})
+	}
+	if su.mutation.CategoryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: schedule.FieldCategory,
+		})
+	}
+	if value, ok := su.mutation.Cron(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: schedule.FieldCron,
+		})
+	}
+	if value, ok := su.mutation.Timezone(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: schedule.FieldTimezone,
+		})
+	}
+	if su.mutation.TimezoneCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: schedule.FieldTimezone,
+		})
+	}
+	if value, ok := su.mutation.EndsAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: schedule.FieldEndsAt,
+		})
+	}
+	if su.mutation.EndsAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: schedule.FieldEndsAt,
+		})
+	}
+	if value, ok := su.mutation.NextRunAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: schedule.FieldNextRunAt,
+		})
+	}
+	if su.mutation.NextRunAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: schedule.FieldNextRunAt,
+		})
+	}
+	if value, ok := su.mutation.LastRunAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: schedule.FieldLastRunAt,
+		})
+	}
+	if su.mutation.LastRunAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: schedule.FieldLastRunAt,
+		})
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: schedule.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ScheduleUpdateOne is the builder for updating a single Schedule entity.
+type ScheduleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScheduleMutation
+}
+
+// SetSenderID sets the "sender_id" field.
+func (suo *ScheduleUpdateOne) SetSenderID(i int) *ScheduleUpdateOne {
+	suo.mutation.ResetSenderID()
+	suo.mutation.SetSenderID(i)
+	return suo
+}
+
+// AddSenderID adds i to the "sender_id" field.
+func (suo *ScheduleUpdateOne) AddSenderID(i int) *ScheduleUpdateOne {
+	suo.mutation.AddSenderID(i)
+	return suo
+}
+
+// SetTenant sets the "tenant" field.
+func (suo *ScheduleUpdateOne) SetTenant(s string) *ScheduleUpdateOne {
+	suo.mutation.SetTenant(s)
+	return suo
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableTenant(s *string) *ScheduleUpdateOne {
+	if s != nil {
+		suo.SetTenant(*s)
+	}
+	return suo
+}
+
+// ClearTenant clears the value of the "tenant" field.
+func (suo *ScheduleUpdateOne) ClearTenant() *ScheduleUpdateOne {
+	suo.mutation.ClearTenant()
+	return suo
+}
+
+// SetType sets the "type" field.
+func (suo *ScheduleUpdateOne) SetType(st schema.NotificationType) *ScheduleUpdateOne {
+	suo.mutation.SetType(st)
+	return suo
+}
+
+// SetPayload sets the "payload" field.
+func (suo *ScheduleUpdateOne) SetPayload(s schema.Payload) *ScheduleUpdateOne {
+	suo.mutation.SetPayload(s)
+	return suo
+}
+
+// SetTTL sets the "ttl" field.
+func (suo *ScheduleUpdateOne) SetTTL(i int) *ScheduleUpdateOne {
+	suo.mutation.ResetTTL()
+	suo.mutation.SetTTL(i)
+	return suo
+}
+
+// AddTTL adds i to the "ttl" field.
+func (suo *ScheduleUpdateOne) AddTTL(i int) *ScheduleUpdateOne {
+	suo.mutation.AddTTL(i)
+	return suo
+}
+
+// SetPriority sets the "priority" field.
+func (suo *ScheduleUpdateOne) SetPriority(i int) *ScheduleUpdateOne {
+	suo.mutation.ResetPriority()
+	suo.mutation.SetPriority(i)
+	return suo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillablePriority(i *int) *ScheduleUpdateOne {
+	if i != nil {
+		suo.SetPriority(*i)
+	}
+	return suo
+}
+
+// AddPriority adds i to the "priority" field.
+func (suo *ScheduleUpdateOne) AddPriority(i int) *ScheduleUpdateOne {
+	suo.mutation.AddPriority(i)
+	return suo
+}
+
+// SetCategory sets the "category" field.
+func (suo *ScheduleUpdateOne) SetCategory(s string) *ScheduleUpdateOne {
+	suo.mutation.SetCategory(s)
+	return suo
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableCategory(s *string) *ScheduleUpdateOne {
+	if s != nil {
+		suo.SetCategory(*s)
+	}
+	return suo
+}
+
+// ClearCategory clears the value of the "category" field.
+func (suo *ScheduleUpdateOne) ClearCategory() *ScheduleUpdateOne {
+	suo.mutation.ClearCategory()
+	return suo
+}
+
+// SetCron sets the "cron" field.
+func (suo *ScheduleUpdateOne) SetCron(s string) *ScheduleUpdateOne {
+	suo.mutation.SetCron(s)
+	return suo
+}
+
+// SetTimezone sets the "timezone" field.
+func (suo *ScheduleUpdateOne) SetTimezone(s string) *ScheduleUpdateOne {
+	suo.mutation.SetTimezone(s)
+	return suo
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableTimezone(s *string) *ScheduleUpdateOne {
+	if s != nil {
+		suo.SetTimezone(*s)
+	}
+	return suo
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (suo *ScheduleUpdateOne) ClearTimezone() *ScheduleUpdateOne {
+	suo.mutation.ClearTimezone()
+	return suo
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (suo *ScheduleUpdateOne) SetEndsAt(t time.Time) *ScheduleUpdateOne {
+	suo.mutation.SetEndsAt(t)
+	return suo
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableEndsAt(t *time.Time) *ScheduleUpdateOne {
+	if t != nil {
+		suo.SetEndsAt(*t)
+	}
+	return suo
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (suo *ScheduleUpdateOne) ClearEndsAt() *ScheduleUpdateOne {
+	suo.mutation.ClearEndsAt()
+	return suo
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (suo *ScheduleUpdateOne) SetNextRunAt(t time.Time) *ScheduleUpdateOne {
+	suo.mutation.SetNextRunAt(t)
+	return suo
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableNextRunAt(t *time.Time) *ScheduleUpdateOne {
+	if t != nil {
+		suo.SetNextRunAt(*t)
+	}
+	return suo
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (suo *ScheduleUpdateOne) ClearNextRunAt() *ScheduleUpdateOne {
+	suo.mutation.ClearNextRunAt()
+	return suo
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (suo *ScheduleUpdateOne) SetLastRunAt(t time.Time) *ScheduleUpdateOne {
+	suo.mutation.SetLastRunAt(t)
+	return suo
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableLastRunAt(t *time.Time) *ScheduleUpdateOne {
+	if t != nil {
+		suo.SetLastRunAt(*t)
+	}
+	return suo
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (suo *ScheduleUpdateOne) ClearLastRunAt() *ScheduleUpdateOne {
+	suo.mutation.ClearLastRunAt()
+	return suo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *ScheduleUpdateOne) SetUpdatedAt(t time.Time) *ScheduleUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// Mutation returns the ScheduleMutation object of the builder.
+func (suo *ScheduleUpdateOne) Mutation() *ScheduleMutation {
+	return suo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *ScheduleUpdateOne) Select(field string, fields ...string) *ScheduleUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Schedule entity.
+func (suo *ScheduleUpdateOne) Save(ctx context.Context) (*Schedule, error) {
+	var (
+		err  error
+		node *Schedule
+	)
+	suo.defaults()
+	if len(suo.hooks) == 0 {
+		if err = suo.check(); err != nil {
+			return nil, err
+		}
+		node, err = suo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ScheduleMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = suo.check(); err != nil {
+				return nil, err
+			}
+			suo.mutation = mutation
+			node, err = suo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(suo.hooks) - 1; i >= 0; i-- {
+			if suo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = suo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, suo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Schedule)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ScheduleMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *ScheduleUpdateOne) SaveX(ctx context.Context) *Schedule {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *ScheduleUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *ScheduleUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *ScheduleUpdateOne) defaults() {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		v := schedule.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *ScheduleUpdateOne) check() error {
+	if v, ok := suo.mutation.GetType(); ok {
+		if err := schedule.TypeValidator(string(v)); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Schedule.type": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.Cron(); ok {
+		if err := schedule.CronValidator(v); err != nil {
+			return &ValidationError{Name: "cron", err: fmt.Errorf(`ent: validator failed for field "Schedule.cron": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.Timezone(); ok {
+		if err := schedule.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "Schedule.timezone": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (suo *ScheduleUpdateOne) sqlSave(ctx context.Context) (_node *Schedule, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   schedule.Table,
+			Columns: schedule.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: schedule.FieldID,
+			},
+		},
+	}
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Schedule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, schedule.FieldID)
+		for _, f := range fields {
+			if !schedule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != schedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.SenderID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: schedule.FieldSenderID,
+		})
+	}
+	if value, ok := suo.mutation.AddedSenderID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: schedule.FieldSenderID,
+		})
+	}
+	if value, ok := suo.mutation.Tenant(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: schedule.FieldTenant,
+		})
+	}
+	if suo.mutation.TenantCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: schedule.FieldTenant,
+		})
+	}
+	if value, ok := suo.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: schedule.FieldType,
+		})
+	}
+	if value, ok := suo.mutation.Payload(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: schedule.FieldPayload,
+		})
+	}
+	if value, ok := suo.mutation.TTL(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: schedule.FieldTTL,
+		})
+	}
+	if value, ok := suo.mutation.AddedTTL(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: schedule.FieldTTL,
+		})
+	}
+	if value, ok := suo.mutation.Priority(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: schedule.FieldPriority,
+		})
+	}
+	if value, ok := suo.mutation.AddedPriority(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: schedule.FieldPriority,
+		})
+	}
+	if value, ok := suo.mutation.Category(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: schedule.FieldCategory,
+		})
+	}
+	if suo.mutation.CategoryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: schedule.FieldCategory,
+		})
+	}
+	if value, ok := suo.mutation.Cron(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: schedule.FieldCron,
+		})
+	}
+	if value, ok := suo.mutation.Timezone(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: schedule.FieldTimezone,
+		})
+	}
+	if suo.mutation.TimezoneCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: schedule.FieldTimezone,
+		})
+	}
+	if value, ok := suo.mutation.EndsAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: schedule.FieldEndsAt,
+		})
+	}
+	if suo.mutation.EndsAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: schedule.FieldEndsAt,
+		})
+	}
+	if value, ok := suo.mutation.NextRunAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: schedule.FieldNextRunAt,
+		})
+	}
+	if suo.mutation.NextRunAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: schedule.FieldNextRunAt,
+		})
+	}
+	if value, ok := suo.mutation.LastRunAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: schedule.FieldLastRunAt,
+		})
+	}
+	if suo.mutation.LastRunAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: schedule.FieldLastRunAt,
+		})
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: schedule.FieldUpdatedAt,
+		})
+	}
+	_node = &Schedule{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
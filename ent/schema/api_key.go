@@ -0,0 +1,91 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// APIKey holds the schema definition for the APIKey entity — key of sender for integrations without JWT.
+type APIKey struct {
+	ent.Schema
+}
+
+// Fields of the APIKey.
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("sender_id").
+			Comment("owner of key, notifications are sent by this sender"),
+
+		field.String("tenant").
+			Optional().
+			Comment("tenant of sender, notifications are sent by credentials of this tenant"),
+
+		field.String("name").
+			Optional().
+			Comment("name of integration using key"),
+
+		field.String("prefix").
+			NotEmpty().
+			Comment("first symbols of key to recognize it, key itself is not stored"),
+
+		field.String("hash").
+			NotEmpty().
+			Unique().
+			Sensitive().
+			Comment("SHA-256 hash of key"),
+
+		field.Strings("scopes").
+			Optional().
+			Comment("scopes allowed by key, the same as scopes of JWT"),
+
+		field.Strings("channels").
+			Optional().
+			Comment("types of notifications allowed by key, all types if empty"),
+
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("key is not accepted after this time, key is permanent if empty"),
+
+		field.Time("last_used_at").
+			Optional().
+			Nillable().
+			Comment("time of the last request authenticated by key"),
+
+		field.Time("revoked_at").
+			Optional().
+			Nillable().
+			Comment("key is not accepted after revocation"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("creation time of key"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Annotations(
+				&entsql.Annotation{
+					Default: "CURRENT_TIMESTAMP",
+				},
+			).
+			Comment("last update time of key"),
+	}
+}
+
+// Indexes of the schema.
+func (APIKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("sender_id"),
+	}
+}
+
+// Edges of the APIKey.
+func (APIKey) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,113 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+var (
+	// CredentialTypes are types of notifications sent by credentials of tenant
+	CredentialTypes = []NotificationType{TypeEmail, TypeSMS, TypeTelegram}
+)
+
+// ChannelCredential holds the schema definition for the ChannelCredential entity — credentials of tenant
+// for notification channel: SMTP relay, Telegram bot or SMS account.
+type ChannelCredential struct {
+	ent.Schema
+}
+
+// Fields of the ChannelCredential.
+func (ChannelCredential) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("tenant").
+			NotEmpty().
+			Comment("tenant of senders using credentials"),
+
+		field.String("type").
+			Validate(ValidateCredentialType).
+			GoType(NotificationType(``)).
+			Comment("types in (email|sms|telegram)"),
+
+		field.JSON("credentials", Credentials{}).
+			Sensitive().
+			Comment("credentials of channel, fields are variable by type"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("creation time of credentials"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Annotations(
+				&entsql.Annotation{
+					Default: "CURRENT_TIMESTAMP",
+				},
+			).
+			Comment("last update time of credentials, senders of tenant are rebuilt after update"),
+	}
+}
+
+// Indexes of the schema.
+func (ChannelCredential) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant", "type").Unique(),
+	}
+}
+
+// Edges of the ChannelCredential.
+func (ChannelCredential) Edges() []ent.Edge {
+	return nil
+}
+
+// Credentials of channel: SMTP relay for email, bot for telegram and SMS Aero account for sms
+type Credentials struct {
+	From     string `json:"from,omitempty"`     // Sender of emails, e.g. "John Doe <johndoe@mail.example>"
+	Address  string `json:"address,omitempty"`  // Address of SMTP relay, e.g. smtp.mail.example:587
+	Username string `json:"username,omitempty"` // Username of SMTP relay
+	Password string `json:"password,omitempty"` // Password of SMTP relay
+	BotToken string `json:"botToken,omitempty"` // Token of Telegram bot
+	Email    string `json:"email,omitempty"`    // Email of SMS Aero account
+	APIKey   string `json:"apiKey,omitempty"`   // API key of SMS Aero account
+}
+
+// Validate checks fields required by type of notifications
+func (c Credentials) Validate(notificationType NotificationType) error {
+	switch notificationType {
+	case TypeEmail:
+		if c.From == "" || c.Address == "" {
+			return errors.New(`email credentials have empty field from or address`)
+		}
+		if host, port, err := net.SplitHostPort(c.Address); err != nil || host == "" || port == "" {
+			return fmt.Errorf(`smtp relay address '%s' is incorrect, host:port is expected`, c.Address)
+		}
+	case TypeTelegram:
+		if c.BotToken == "" {
+			return errors.New(`telegram credentials have empty field botToken`)
+		}
+	case TypeSMS:
+		if c.Email == "" || c.APIKey == "" {
+			return errors.New(`sms credentials have empty field email or apiKey`)
+		}
+	default:
+		return fmt.Errorf(`credentials of type %s are not supported`, notificationType)
+	}
+	return nil
+}
+
+func ValidateCredentialType(notificationType string) error {
+	for _, validType := range CredentialTypes {
+		if NotificationType(notificationType) == validType {
+			return nil
+		}
+	}
+	return fmt.Errorf(`credentials of type %s are not supported`, notificationType)
+}
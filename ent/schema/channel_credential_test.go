@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCredentials_Validate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		credentials Credentials
+		as          NotificationType
+		invalid     bool
+	}{
+		{
+			name:        "email-host",
+			credentials: Credentials{From: `acme@mail.example`, Address: `smtp.mail.example:587`},
+			as:          TypeEmail,
+		},
+		{
+			name:        "email-ip",
+			credentials: Credentials{From: `acme@mail.example`, Address: `127.0.0.1:25`},
+			as:          TypeEmail,
+		},
+		{
+			name:        "email-without-port",
+			credentials: Credentials{From: `acme@mail.example`, Address: `smtp.mail.example`},
+			as:          TypeEmail,
+			invalid:     true,
+		},
+		{
+			name:        "email-without-host",
+			credentials: Credentials{From: `acme@mail.example`, Address: `:587`},
+			as:          TypeEmail,
+			invalid:     true,
+		},
+		{
+			name:        "telegram",
+			credentials: Credentials{BotToken: `123456:ABC-DEF`},
+			as:          TypeTelegram,
+		},
+		{
+			name:        "sms-without-key",
+			credentials: Credentials{Email: `acme@mail.example`},
+			as:          TypeSMS,
+			invalid:     true,
+		},
+		{
+			name:        "unsupported",
+			credentials: Credentials{BotToken: `123456:ABC-DEF`},
+			as:          TypePush,
+			invalid:     true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				err := testCase.credentials.Validate(testCase.as)
+				if testCase.invalid {
+					require.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+			},
+		)
+	}
+}
@@ -23,11 +23,15 @@ const (
 	TypeWhatsApp NotificationType = `whatsapp`
 	TypeTelegram NotificationType = `telegram`
 
-	StatusDraft   NotificationStatus = `draft`
-	StatusPending NotificationStatus = `pending`
-	StatusSent    NotificationStatus = `sent`
-	StatusRetry   NotificationStatus = `retry`
-	StatusFail    NotificationStatus = `fail`
+	StatusDraft      NotificationStatus = `draft`
+	StatusPending    NotificationStatus = `pending`
+	StatusSent       NotificationStatus = `sent`
+	StatusRetry      NotificationStatus = `retry`
+	StatusFail       NotificationStatus = `fail`
+	StatusProcessing NotificationStatus = `processing`
+	StatusSuppressed NotificationStatus = `suppressed`
+	StatusHeld       NotificationStatus = `held`
+	StatusDigested   NotificationStatus = `digested`
 )
 
 var (
@@ -46,6 +50,10 @@ var (
 		StatusSent,
 		StatusRetry,
 		StatusFail,
+		StatusProcessing,
+		StatusSuppressed,
+		StatusHeld,
+		StatusDigested,
 	}
 )
 
@@ -59,6 +67,10 @@ func (Notification) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("sender_id"),
 
+		field.String("tenant").
+			Optional().
+			Comment("tenant of sender, selects credentials of channel, credentials of config are used if empty"),
+
 		field.String("type").
 			Default(TypeEmail.String()).
 			Validate(ValidateType).
@@ -75,7 +87,7 @@ func (Notification) Fields() []ent.Field {
 			Default(StatusDraft.String()).
 			Validate(ValidateStatus).
 			GoType(NotificationStatus(``)).
-			Comment("statuses in (draft|pending|sent|retry|fail)"),
+			Comment("statuses in (draft|pending|sent|retry|fail|processing|suppressed|held|digested)"),
 
 		field.Time("created_at").
 			Default(time.Now).
@@ -105,10 +117,72 @@ func (Notification) Fields() []ent.Field {
 			Default(0).
 			Comment("count of retries to send notification"),
 
+		field.Int("priority").
+			Default(0).
+			Comment("notifications with higher priority are sent first"),
+
+		field.JSON("retry_policy", &RetryPolicy{}).
+			Optional().
+			Comment("retry policy of notification, overrides configured policy for type"),
+
+		field.String("category").
+			Optional().
+			Comment("category of notification, e.g. marketing, selects configured delivery window"),
+
+		field.JSON("delivery_window", &DeliveryWindow{}).
+			Optional().
+			Comment("delivery window and timezone of recipient, overrides configured window"),
+
 		field.Time("sent_at").
 			Optional().
 			Nillable().
 			Comment("time of notification was sent"),
+
+		field.Time("lease_until").
+			Optional().
+			Nillable().
+			Comment("time until notification in processing is claimed by worker, reclaimed after"),
+
+		field.String("worker_id").
+			Optional().
+			Nillable().
+			Comment("identifier of worker processing notification"),
+
+		field.String("dedup_key").
+			Optional().
+			Comment("hash of type, recipient and payload, the same notifications of sender are suppressed within window"),
+
+		field.Int("original_id").
+			Optional().
+			Nillable().
+			Comment("original notification of suppressed duplicate"),
+
+		field.String("locale").
+			Optional().
+			Comment("locale of recipient, e.g. ru or kk-KZ, selects localized templates"),
+
+		field.String("digest_key").
+			Optional().
+			Comment("notifications of recipient with the same digest key are held until planned time and merged into digest"),
+
+		field.Int("digest_id").
+			Optional().
+			Nillable().
+			Comment("digest notification merged this notification"),
+
+		field.Int("schedule_id").
+			Optional().
+			Nillable().
+			Comment("schedule materialized notification, notification is unique for schedule and occurrence"),
+
+		field.Time("occurrence_at").
+			Optional().
+			Nillable().
+			Comment("occurrence of schedule materialized notification, planned time may be deferred to delivery window"),
+
+		field.JSON("trace_context", map[string]string{}).
+			Optional().
+			Comment("trace context of request enqueued notification, worker continues trace with it"),
 	}
 }
 
@@ -119,6 +193,12 @@ func (Notification) Indexes() []ent.Index {
 		index.Fields("status"),
 		index.Fields("planned_at"),
 		index.Fields("sent_at"),
+		index.Fields("sender_id", "type", "created_at"),
+		index.Fields("priority", "created_at"),
+		index.Fields("lease_until"),
+		index.Fields("sender_id", "dedup_key", "created_at"),
+		index.Fields("schedule_id", "occurrence_at").Unique(),
+		index.Fields("sender_id", "digest_key", "status"),
 	}
 }
 
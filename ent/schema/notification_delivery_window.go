@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"fmt"
+	"time"
+	_ "time/tzdata" // timezones of recipients are resolved in images without tzdata
+)
+
+const deliveryWindowLayout = `15:04`
+
+// DeliveryWindow is daily period of local time of recipient when notification may be sent. Zero fields are not set
+type DeliveryWindow struct {
+	From     string `json:"from,omitempty"`     // Start of window as HH:MM
+	To       string `json:"to,omitempty"`       // End of window as HH:MM, window passes midnight if it is less than From
+	Timezone string `json:"timezone,omitempty"` // IANA timezone of recipient, UTC if empty
+}
+
+func (dw DeliveryWindow) Validate() error {
+	if (dw.From == ``) != (dw.To == ``) {
+		return fmt.Errorf(`delivery window must have both 'from' and 'to'`)
+	}
+	if dw.From != `` {
+		if _, err := time.Parse(deliveryWindowLayout, dw.From); err != nil {
+			return fmt.Errorf(`delivery window has invalid 'from' %q, expected HH:MM`, dw.From)
+		}
+		if _, err := time.Parse(deliveryWindowLayout, dw.To); err != nil {
+			return fmt.Errorf(`delivery window has invalid 'to' %q, expected HH:MM`, dw.To)
+		}
+	}
+	if _, err := time.LoadLocation(dw.Timezone); err != nil {
+		return fmt.Errorf(`delivery window has unknown 'timezone' %q`, dw.Timezone)
+	}
+	return nil
+}
+
+// IsSet returns true if window limits time of delivery
+func (dw DeliveryWindow) IsSet() bool {
+	return dw.From != `` && dw.To != `` && dw.From != dw.To
+}
+
+// Merge returns copy of window with unset fields taken from fallback
+func (dw DeliveryWindow) Merge(fallback DeliveryWindow) DeliveryWindow {
+	if dw.From == `` && dw.To == `` {
+		dw.From = fallback.From
+		dw.To = fallback.To
+	}
+	if dw.Timezone == `` {
+		dw.Timezone = fallback.Timezone
+	}
+	return dw
+}
+
+// Next returns t if it is inside of window, otherwise start of the next window
+func (dw DeliveryWindow) Next(t time.Time) (time.Time, error) {
+	if !dw.IsSet() {
+		return t, nil
+	}
+	if err := dw.Validate(); err != nil {
+		return t, err
+	}
+	location, _ := time.LoadLocation(dw.Timezone)
+	from, _ := time.Parse(deliveryWindowLayout, dw.From)
+	to, _ := time.Parse(deliveryWindowLayout, dw.To)
+
+	local := t.In(location)
+	clock := func(c time.Time) int { return c.Hour()*60 + c.Minute() }
+	now, start, end := clock(local), clock(from), clock(to)
+
+	inside := start <= now && now < end
+	if start > end { // window passes midnight
+		inside = now >= start || now < end
+	}
+	if inside {
+		return t, nil
+	}
+
+	next := time.Date(local.Year(), local.Month(), local.Day(), from.Hour(), from.Minute(), 0, 0, location)
+	if now >= start {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next, nil
+}
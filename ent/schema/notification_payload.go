@@ -52,6 +52,20 @@ func (p Payload) Validate(as NotificationType) error {
 	return validate()
 }
 
+// Recipient returns address of recipient in payload for notification type
+func (p Payload) Recipient(as NotificationType) string {
+	switch as {
+	case TypeEmail:
+		return p[`to`]
+	case TypeSMS:
+		return p[`phone`]
+	case TypeTelegram:
+		return p[`chat_id`]
+	default:
+		return ""
+	}
+}
+
 func PayloadFromProto(proto map[string]string) (*Payload, error) {
 	payload := Payload(proto)
 	return &payload, nil
@@ -72,18 +86,27 @@ func toPayloadTyped[T PayloadTyped](source Payload) (*T, error) {
 }
 
 func mustToPayloadCommon(source any) Payload {
-	bytes, err := json.Marshal(source)
+	res, err := toPayloadCommon(source)
 	if err != nil {
 		panic(err)
 	}
+	return res
+}
+
+func toPayloadCommon(source any) (Payload, error) {
+	bytes, err := json.Marshal(source)
+	if err != nil {
+		return nil, err
+	}
 	var res Payload
 	if err := json.Unmarshal(bytes, &res); err != nil {
-		panic(err)
+		return nil, err
 	}
-	return res
+	return res, nil
 }
 
 type PayloadTyped interface {
 	MustToPayload() Payload
+	ToPayload() (Payload, error)
 	Validate() error
 }
@@ -15,6 +15,7 @@ type PayloadEmail struct {
 	Subject string `json:"subject"`
 	Body    string `json:"body"`
 	IsHTML  string `json:"is_html"`
+	Text    string `json:"text,omitempty"` // Plain text alternative of HTML body
 }
 
 func (p Payload) ToPayloadEmail() (*PayloadEmail, error) {
@@ -25,6 +26,10 @@ func (pe PayloadEmail) MustToPayload() Payload {
 	return mustToPayloadCommon(pe)
 }
 
+func (pe PayloadEmail) ToPayload() (Payload, error) {
+	return toPayloadCommon(pe)
+}
+
 func (pe PayloadEmail) Validate() error {
 	if pe.To == "" {
 		return errors.New(`payload email has empty field 'to'`)
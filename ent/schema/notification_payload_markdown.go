@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"notifications/internal/pkg/markdown"
+	"notifications/internal/pkg/sms"
+	"notifications/internal/pkg/strings"
+)
+
+const (
+	// PayloadMarkdown is field of channel-neutral message in Markdown subset, see package markdown
+	PayloadMarkdown = `markdown`
+
+	telegramParseModeMarkdownV2 = `markdownv2`
+	telegramParseModeHTML       = `html`
+)
+
+// RenderMarkdown returns copy of payload with message fields of notification type rendered from field markdown.
+// Fields set explicitly are kept, so clients may override rendering of any channel
+func (p Payload) RenderMarkdown(as NotificationType) Payload {
+	source := p[PayloadMarkdown]
+	if source == "" {
+		return p
+	}
+	rendered := Payload{}
+	for key, value := range p {
+		rendered[key] = value
+	}
+	setDefault := func(key, value string) {
+		if rendered[key] == "" {
+			rendered[key] = value
+		}
+	}
+
+	switch as {
+	case TypeEmail:
+		if rendered[`body`] == "" {
+			rendered[`body`] = markdown.HTML(source)
+			rendered[`is_html`] = `true`
+			setDefault(`text`, markdown.Text(source))
+		}
+	case TypeTelegram:
+		if rendered[`text`] == "" {
+			if rendered[`parse_mode`] == telegramParseModeMarkdownV2 {
+				rendered[`text`] = markdown.TelegramMarkdownV2(source)
+			} else {
+				rendered[`parse_mode`] = telegramParseModeHTML
+				rendered[`text`] = markdown.TelegramHTML(source)
+			}
+		}
+	case TypeSMS:
+		setDefault(`text`, sms.Fit(markdown.Text(source), strings.IsTrue(rendered[`split`])))
+	case TypePlain:
+		setDefault(`message`, markdown.Text(source))
+	}
+	return rendered
+}
@@ -16,6 +16,10 @@ func (pp PayloadPlain) MustToPayload() Payload {
 	return mustToPayloadCommon(pp)
 }
 
+func (pp PayloadPlain) ToPayload() (Payload, error) {
+	return toPayloadCommon(pp)
+}
+
 func (pp PayloadPlain) Validate() error {
 	if pp.Message == "" {
 		return errors.New(`payload plain has empty field 'message'`)
@@ -25,6 +25,10 @@ func (ps PayloadSMS) MustToPayload() Payload {
 	return mustToPayloadCommon(ps)
 }
 
+func (ps PayloadSMS) ToPayload() (Payload, error) {
+	return toPayloadCommon(ps)
+}
+
 func (ps PayloadSMS) Validate() error {
 	if ps.Text == "" {
 		return errors.New(`payload sms has empty field text`)
@@ -9,7 +9,7 @@ import (
 )
 
 var (
-	TelegramParseModes = []string{`markdown`, `html`}
+	TelegramParseModes = []string{`markdown`, `markdownv2`, `html`}
 )
 
 type PayloadTelegram struct {
@@ -32,6 +32,10 @@ func (pt PayloadTelegram) MustToPayload() Payload {
 	return mustToPayloadCommon(pt)
 }
 
+func (pt PayloadTelegram) ToPayload() (Payload, error) {
+	return toPayloadCommon(pt)
+}
+
 func (pt PayloadTelegram) Validate() error {
 	if pt.ChatID == "" {
 		return errors.New(`payload telegram has empty field 'chat_id'`)
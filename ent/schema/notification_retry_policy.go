@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"errors"
+	"time"
+)
+
+// RetryPolicy describes exponential backoff for retries of failed notification. Zero fields are not set
+type RetryPolicy struct {
+	InitialInterval time.Duration `json:"initial_interval,omitempty"` // Delay before first retry
+	Multiplier      float64       `json:"multiplier,omitempty"`       // Multiplier of delay for each next retry
+	MaxInterval     time.Duration `json:"max_interval,omitempty"`     // Maximum delay between retries
+	Jitter          float64       `json:"jitter,omitempty"`           // Random deviation of delay as fraction in [0, 1]
+	MaxAttempts     int           `json:"max_attempts,omitempty"`     // Maximum count of retries, unlimited if 0
+}
+
+func (rp RetryPolicy) Validate() error {
+	if rp.InitialInterval < 0 {
+		return errors.New(`retry policy has negative 'initial_interval'`)
+	}
+	if rp.Multiplier != 0 && rp.Multiplier < 1 {
+		return errors.New(`retry policy has 'multiplier' less than 1`)
+	}
+	if rp.MaxInterval < 0 {
+		return errors.New(`retry policy has negative 'max_interval'`)
+	}
+	if rp.Jitter < 0 || rp.Jitter > 1 {
+		return errors.New(`retry policy has 'jitter' out of range [0, 1]`)
+	}
+	if rp.MaxAttempts < 0 {
+		return errors.New(`retry policy has negative 'max_attempts'`)
+	}
+	return nil
+}
+
+// Merge returns copy of policy with unset fields taken from fallback
+func (rp RetryPolicy) Merge(fallback RetryPolicy) RetryPolicy {
+	if rp.InitialInterval == 0 {
+		rp.InitialInterval = fallback.InitialInterval
+	}
+	if rp.Multiplier == 0 {
+		rp.Multiplier = fallback.Multiplier
+	}
+	if rp.MaxInterval == 0 {
+		rp.MaxInterval = fallback.MaxInterval
+	}
+	if rp.Jitter == 0 {
+		rp.Jitter = fallback.Jitter
+	}
+	if rp.MaxAttempts == 0 {
+		rp.MaxAttempts = fallback.MaxAttempts
+	}
+	return rp
+}
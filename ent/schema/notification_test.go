@@ -3,6 +3,7 @@ package schema
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -32,3 +33,152 @@ func TestPayload(t *testing.T) {
 	require.NotNil(t, ruinedPayloadEmail)
 	require.Error(t, ruinedPayloadEmail.Validate())
 }
+
+func TestDeliveryWindow_Next(t *testing.T) {
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+
+	day := DeliveryWindow{From: "09:00", To: "21:00", Timezone: "Europe/Moscow"}
+	night := DeliveryWindow{From: "22:00", To: "06:00", Timezone: "Europe/Moscow"}
+
+	testCases := []struct {
+		name     string
+		window   DeliveryWindow
+		at       time.Time
+		expected time.Time
+	}{
+		{
+			name:     "unset",
+			window:   DeliveryWindow{Timezone: "Europe/Moscow"},
+			at:       time.Date(2022, 9, 1, 3, 0, 0, 0, moscow),
+			expected: time.Date(2022, 9, 1, 3, 0, 0, 0, moscow),
+		},
+		{
+			name:     "inside",
+			window:   day,
+			at:       time.Date(2022, 9, 1, 12, 30, 0, 0, moscow),
+			expected: time.Date(2022, 9, 1, 12, 30, 0, 0, moscow),
+		},
+		{
+			name:     "before",
+			window:   day,
+			at:       time.Date(2022, 9, 1, 3, 0, 0, 0, moscow),
+			expected: time.Date(2022, 9, 1, 9, 0, 0, 0, moscow),
+		},
+		{
+			name:     "after",
+			window:   day,
+			at:       time.Date(2022, 9, 1, 21, 0, 0, 0, moscow),
+			expected: time.Date(2022, 9, 2, 9, 0, 0, 0, moscow),
+		},
+		{
+			name:     "utc",
+			window:   day,
+			at:       time.Date(2022, 9, 1, 20, 0, 0, 0, time.UTC), // 23:00 in Moscow
+			expected: time.Date(2022, 9, 2, 9, 0, 0, 0, moscow),
+		},
+		{
+			name:     "midnight-inside",
+			window:   night,
+			at:       time.Date(2022, 9, 1, 1, 0, 0, 0, moscow),
+			expected: time.Date(2022, 9, 1, 1, 0, 0, 0, moscow),
+		},
+		{
+			name:     "midnight-outside",
+			window:   night,
+			at:       time.Date(2022, 9, 1, 12, 0, 0, 0, moscow),
+			expected: time.Date(2022, 9, 1, 22, 0, 0, 0, moscow),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				next, err := testCase.window.Next(testCase.at)
+				require.NoError(t, err)
+				require.True(t, testCase.expected.Equal(next), "expected %s, got %s", testCase.expected, next)
+			},
+		)
+	}
+
+	_, err = DeliveryWindow{From: "9", To: "21:00"}.Next(time.Now())
+	require.Error(t, err)
+	require.Error(t, DeliveryWindow{Timezone: "Mars/Olympus"}.Validate())
+}
+
+func TestPayload_RenderMarkdown(t *testing.T) {
+	source := "**Order** [#1](https://example.com/orders/1) is paid"
+	testCases := []struct {
+		name     string
+		as       NotificationType
+		payload  Payload
+		expected Payload
+	}{
+		{
+			name:    "email",
+			as:      TypeEmail,
+			payload: Payload{`to`: `a@example.com`, `subject`: `Order`, `markdown`: source},
+			expected: Payload{
+				`to`:       `a@example.com`,
+				`subject`:  `Order`,
+				`markdown`: source,
+				`body`:     `<p><strong>Order</strong> <a href="https://example.com/orders/1">#1</a> is paid</p>`,
+				`is_html`:  `true`,
+				`text`:     `Order #1 (https://example.com/orders/1) is paid`,
+			},
+		},
+		{
+			name:    "telegram",
+			as:      TypeTelegram,
+			payload: Payload{`chat_id`: `1`, `markdown`: source},
+			expected: Payload{
+				`chat_id`:    `1`,
+				`markdown`:   source,
+				`parse_mode`: `html`,
+				`text`:       `<b>Order</b> <a href="https://example.com/orders/1">#1</a> is paid`,
+			},
+		},
+		{
+			name:    "telegram-markdownv2",
+			as:      TypeTelegram,
+			payload: Payload{`chat_id`: `1`, `parse_mode`: `markdownv2`, `markdown`: source},
+			expected: Payload{
+				`chat_id`:    `1`,
+				`markdown`:   source,
+				`parse_mode`: `markdownv2`,
+				`text`:       `*Order* [\#1](https://example.com/orders/1) is paid`,
+			},
+		},
+		{
+			name:    "sms",
+			as:      TypeSMS,
+			payload: Payload{`phone`: `79009009090`, `markdown`: source},
+			expected: Payload{
+				`phone`:    `79009009090`,
+				`markdown`: source,
+				`text`:     `Order #1 (https://example.com/orders/1) is paid`,
+			},
+		},
+		{
+			name:     "explicit-field",
+			as:       TypeSMS,
+			payload:  Payload{`phone`: `79009009090`, `text`: `Paid`, `markdown`: source},
+			expected: Payload{`phone`: `79009009090`, `text`: `Paid`, `markdown`: source},
+		},
+		{
+			name:     "without-markdown",
+			as:       TypeSMS,
+			payload:  Payload{`phone`: `79009009090`, `text`: `Paid`},
+			expected: Payload{`phone`: `79009009090`, `text`: `Paid`},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				rendered := testCase.payload.RenderMarkdown(testCase.as)
+				require.Equal(t, testCase.expected, rendered)
+				require.NoError(t, rendered.Validate(testCase.as))
+			},
+		)
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Quota holds the schema definition for the Quota entity — usage limits of sender for notification type.
+type Quota struct {
+	ent.Schema
+}
+
+// Fields of the Quota.
+func (Quota) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("sender_id"),
+
+		field.String("type").
+			Validate(ValidateType).
+			GoType(NotificationType(``)).
+			Comment("types in (plain|sms|email|whatsapp|push|telegram)"),
+
+		field.Int("per_minute").
+			Default(0).
+			NonNegative().
+			Comment("limit of notifications for last minute, unlimited if 0"),
+
+		field.Int("per_day").
+			Default(0).
+			NonNegative().
+			Comment("limit of notifications for last 24 hours, unlimited if 0"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("creation time of quota"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Annotations(
+				&entsql.Annotation{
+					Default: "CURRENT_TIMESTAMP",
+				},
+			).
+			Comment("last update time of quota"),
+	}
+}
+
+// Indexes of the schema.
+func (Quota) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("sender_id", "type").Unique(),
+	}
+}
+
+// Edges of the Quota.
+func (Quota) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+)
+
+// RateLimit holds the schema definition for the RateLimit entity — token bucket shared between workers.
+type RateLimit struct {
+	ent.Schema
+}
+
+// Fields of the RateLimit.
+func (RateLimit) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			Unique().
+			Comment("key of bucket, e.g. channel or channel with recipient"),
+
+		field.Float("tokens").
+			Comment("count of available tokens at updated_at"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			Annotations(
+				&entsql.Annotation{
+					Default: "CURRENT_TIMESTAMP",
+				},
+			).
+			Comment("last time of tokens refill"),
+	}
+}
+
+// Edges of the RateLimit.
+func (RateLimit) Edges() []ent.Edge {
+	return nil
+}
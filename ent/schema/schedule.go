@@ -0,0 +1,125 @@
+package schema
+
+import (
+	"net/http"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	kratosErrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/robfig/cron/v3"
+)
+
+// CronParser parses standard cron expressions of 5 fields and descriptors like @weekly
+var CronParser = cron.NewParser(
+	cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
+)
+
+// Schedule holds the schema definition for the Schedule entity — recurring notification by cron expression.
+type Schedule struct {
+	ent.Schema
+}
+
+// Fields of the Schedule.
+func (Schedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("sender_id"),
+
+		field.String("tenant").
+			Optional().
+			Comment("tenant of sender, every notification of schedule belongs to it"),
+
+		field.String("type").
+			Validate(ValidateType).
+			GoType(NotificationType(``)).
+			Comment("types in (plain|sms|email|whatsapp|push|telegram)"),
+
+		field.JSON("payload", Payload{}).
+			Comment("message payload of every notification of schedule"),
+
+		field.Int("ttl").
+			Comment("time to live of every notification in seconds"),
+
+		field.Int("priority").
+			Default(0).
+			Comment("priority of every notification of schedule"),
+
+		field.String("category").
+			Optional().
+			Comment("category of every notification of schedule"),
+
+		field.String("cron").
+			Validate(ValidateCron).
+			Comment("cron expression of occurrences, e.g. '0 10 * * 1' or '@weekly'"),
+
+		field.String("timezone").
+			Optional().
+			Validate(ValidateTimezone).
+			Comment("IANA timezone of cron expression, UTC if empty"),
+
+		field.Time("ends_at").
+			Optional().
+			Nillable().
+			Comment("no occurrences are materialized after this time"),
+
+		field.Time("next_run_at").
+			Optional().
+			Nillable().
+			Comment("time of next occurrence, schedule is finished if empty"),
+
+		field.Time("last_run_at").
+			Optional().
+			Nillable().
+			Comment("time of last materialized occurrence"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("creation time of schedule"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Annotations(
+				&entsql.Annotation{
+					Default: "CURRENT_TIMESTAMP",
+				},
+			).
+			Comment("last update time of schedule"),
+	}
+}
+
+// Indexes of the schema.
+func (Schedule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("sender_id"),
+		index.Fields("next_run_at"),
+	}
+}
+
+// Edges of the Schedule.
+func (Schedule) Edges() []ent.Edge {
+	return nil
+}
+
+func ValidateCron(expression string) error {
+	if _, err := CronParser.Parse(expression); err != nil {
+		return kratosErrors.Newf(
+			http.StatusBadRequest, `VALIDATION_ERROR`,
+			`invalid cron expression '%s': %v`, expression, err,
+		)
+	}
+	return nil
+}
+
+func ValidateTimezone(timezone string) error {
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return kratosErrors.Newf(
+			http.StatusBadRequest, `VALIDATION_ERROR`,
+			`invalid timezone: %s`, timezone,
+		)
+	}
+	return nil
+}
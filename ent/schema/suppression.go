@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Suppression holds the schema definition for the Suppression entity — recipient opted out of notification channel.
+type Suppression struct {
+	ent.Schema
+}
+
+// Fields of the Suppression.
+func (Suppression) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("sender_id").
+			Comment("sender of suppression, recipient is suppressed only for notifications of sender"),
+
+		field.String("tenant").
+			Optional().
+			Comment("tenant of sender"),
+
+		field.String("type").
+			Validate(ValidateType).
+			GoType(NotificationType(``)).
+			Comment("channel of suppressed recipient, types in (plain|sms|email|whatsapp|push|telegram)"),
+
+		field.String("address").
+			NotEmpty().
+			Comment("normalized address of recipient: email, phone or chat id"),
+
+		field.String("reason").
+			Optional().
+			Comment("reason of suppression, e.g. unsubscribed or bounced"),
+
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("recipient is not suppressed after this time, suppression is permanent if empty"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("creation time of suppression"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Annotations(
+				&entsql.Annotation{
+					Default: "CURRENT_TIMESTAMP",
+				},
+			).
+			Comment("last update time of suppression"),
+	}
+}
+
+// Indexes of the schema.
+func (Suppression) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("sender_id", "tenant", "type", "address").Unique(),
+	}
+}
+
+// Edges of the Suppression.
+func (Suppression) Edges() []ent.Edge {
+	return nil
+}
+
+// NormalizeAddress returns address of recipient in form stored by suppression list:
+// emails are lowercased, phones are only digits, other addresses are trimmed
+func NormalizeAddress(as NotificationType, address string) string {
+	address = strings.TrimSpace(address)
+	switch as {
+	case TypeEmail:
+		return strings.ToLower(address)
+	case TypeSMS, TypeWhatsApp:
+		return strings.Map(
+			func(r rune) rune {
+				if unicode.IsDigit(r) {
+					return r
+				}
+				return -1
+			}, address,
+		)
+	default:
+		return address
+	}
+}
@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"notifications/ent/schema"
+	"notifications/ent/suppression"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Suppression is the model entity for the Suppression schema.
+type Suppression struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// sender of suppression, recipient is suppressed only for notifications of sender
+	SenderID int `json:"sender_id,omitempty"`
+	// tenant of sender
+	Tenant string `json:"tenant,omitempty"`
+	// channel of suppressed recipient, types in (plain|sms|email|whatsapp|push|telegram)
+	Type schema.NotificationType `json:"type,omitempty"`
+	// normalized address of recipient: email, phone or chat id
+	Address string `json:"address,omitempty"`
+	// reason of suppression, e.g. unsubscribed or bounced
+	Reason string `json:"reason,omitempty"`
+	// recipient is not suppressed after this time, suppression is permanent if empty
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// creation time of suppression
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// last update time of suppression
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Suppression) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case suppression.FieldID, suppression.FieldSenderID:
+			values[i] = new(sql.NullInt64)
+		case suppression.FieldTenant, suppression.FieldType, suppression.FieldAddress, suppression.FieldReason:
+			values[i] = new(sql.NullString)
+		case suppression.FieldExpiresAt, suppression.FieldCreatedAt, suppression.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Suppression", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Suppression fields.
+func (s *Suppression) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case suppression.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int(value.Int64)
+		case suppression.FieldSenderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_id", values[i])
+			} else if value.Valid {
+				s.SenderID = int(value.Int64)
+			}
+		case suppression.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				s.Tenant = value.String
+			}
+		case suppression.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				s.Type = schema.NotificationType(value.String)
+			}
+		case suppression.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				s.Address = value.String
+			}
+		case suppression.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				s.Reason = value.String
+			}
+		case suppression.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				s.ExpiresAt = new(time.Time)
+				*s.ExpiresAt = value.Time
+			}
+		case suppression.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case suppression.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this Suppression.
+// Note that you need to call Suppression.Unwrap() before calling this method if this Suppression
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Suppression) Update() *SuppressionUpdateOne {
+	return (&SuppressionClient{config: s.config}).UpdateOne(s)
+}
+
+// Unwrap unwraps the Suppression entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Suppression) Unwrap() *Suppression {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Suppression is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Suppression) String() string {
+	var builder strings.Builder
+	builder.WriteString("Suppression(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("sender_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.SenderID))
+	builder.WriteString(", ")
+	builder.WriteString("tenant=")
+	builder.WriteString(s.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", s.Type))
+	builder.WriteString(", ")
+	builder.WriteString("address=")
+	builder.WriteString(s.Address)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(s.Reason)
+	builder.WriteString(", ")
+	if v := s.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Suppressions is a parsable slice of Suppression.
+type Suppressions []*Suppression
+
+func (s Suppressions) config(cfg config) {
+	for _i := range s {
+		s[_i].config = cfg
+	}
+}
@@ -0,0 +1,402 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"notifications/ent/schema"
+	"notifications/ent/suppression"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SuppressionCreate is the builder for creating a Suppression entity.
+type SuppressionCreate struct {
+	config
+	mutation *SuppressionMutation
+	hooks    []Hook
+}
+
+// SetSenderID sets the "sender_id" field.
+func (sc *SuppressionCreate) SetSenderID(i int) *SuppressionCreate {
+	sc.mutation.SetSenderID(i)
+	return sc
+}
+
+// SetTenant sets the "tenant" field.
+func (sc *SuppressionCreate) SetTenant(s string) *SuppressionCreate {
+	sc.mutation.SetTenant(s)
+	return sc
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (sc *SuppressionCreate) SetNillableTenant(s *string) *SuppressionCreate {
+	if s != nil {
+		sc.SetTenant(*s)
+	}
+	return sc
+}
+
+// SetType sets the "type" field.
+func (sc *SuppressionCreate) SetType(st schema.NotificationType) *SuppressionCreate {
+	sc.mutation.SetType(st)
+	return sc
+}
+
+// SetAddress sets the "address" field.
+func (sc *SuppressionCreate) SetAddress(s string) *SuppressionCreate {
+	sc.mutation.SetAddress(s)
+	return sc
+}
+
+// SetReason sets the "reason" field.
+func (sc *SuppressionCreate) SetReason(s string) *SuppressionCreate {
+	sc.mutation.SetReason(s)
+	return sc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (sc *SuppressionCreate) SetNillableReason(s *string) *SuppressionCreate {
+	if s != nil {
+		sc.SetReason(*s)
+	}
+	return sc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (sc *SuppressionCreate) SetExpiresAt(t time.Time) *SuppressionCreate {
+	sc.mutation.SetExpiresAt(t)
+	return sc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (sc *SuppressionCreate) SetNillableExpiresAt(t *time.Time) *SuppressionCreate {
+	if t != nil {
+		sc.SetExpiresAt(*t)
+	}
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *SuppressionCreate) SetCreatedAt(t time.Time) *SuppressionCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *SuppressionCreate) SetNillableCreatedAt(t *time.Time) *SuppressionCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *SuppressionCreate) SetUpdatedAt(t time.Time) *SuppressionCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *SuppressionCreate) SetNillableUpdatedAt(t *time.Time) *SuppressionCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// Mutation returns the SuppressionMutation object of the builder.
+func (sc *SuppressionCreate) Mutation() *SuppressionMutation {
+	return sc.mutation
+}
+
+// Save creates the Suppression in the database.
+func (sc *SuppressionCreate) Save(ctx context.Context) (*Suppression, error) {
+	var (
+		err  error
+		node *Suppression
+	)
+	sc.defaults()
+	if len(sc.hooks) == 0 {
+		if err = sc.check(); err != nil {
+			return nil, err
+		}
+		node, err = sc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SuppressionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sc.check(); err != nil {
+				return nil, err
+			}
+			sc.mutation = mutation
+			if node, err = sc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sc.hooks) - 1; i >= 0; i-- {
+			if sc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, sc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Suppression)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from SuppressionMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *SuppressionCreate) SaveX(ctx context.Context) *Suppression {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *SuppressionCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *SuppressionCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SuppressionCreate) defaults() {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := suppression.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		v := suppression.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SuppressionCreate) check() error {
+	if _, ok := sc.mutation.SenderID(); !ok {
+		return &ValidationError{Name: "sender_id", err: errors.New(`ent: missing required field "Suppression.sender_id"`)}
+	}
+	if _, ok := sc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Suppression.type"`)}
+	}
+	if v, ok := sc.mutation.GetType(); ok {
+		if err := suppression.TypeValidator(string(v)); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Suppression.type": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Address(); !ok {
+		return &ValidationError{Name: "address", err: errors.New(`ent: missing required field "Suppression.address"`)}
+	}
+	if v, ok := sc.mutation.Address(); ok {
+		if err := suppression.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Suppression.address": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Suppression.created_at"`)}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Suppression.updated_at"`)}
+	}
+	return nil
+}
+
+func (sc *SuppressionCreate) sqlSave(ctx context.Context) (*Suppression, error) {
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (sc *SuppressionCreate) createSpec() (*Suppression, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Suppression{config: sc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: suppression.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: suppression.FieldID,
+			},
+		}
+	)
+	if value, ok := sc.mutation.SenderID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: suppression.FieldSenderID,
+		})
+		_node.SenderID = value
+	}
+	if value, ok := sc.mutation.Tenant(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: suppression.FieldTenant,
+		})
+		_node.Tenant = value
+	}
+	if value, ok := sc.mutation.GetType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: suppression.FieldType,
+		})
+		_node.Type = value
+	}
+	if value, ok := sc.mutation.Address(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: suppression.FieldAddress,
+		})
+		_node.Address = value
+	}
+	if value, ok := sc.mutation.Reason(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: suppression.FieldReason,
+		})
+		_node.Reason = value
+	}
+	if value, ok := sc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: suppression.FieldExpiresAt,
+		})
+		_node.ExpiresAt = &value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: suppression.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: suppression.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// SuppressionCreateBulk is the builder for creating many Suppression entities in bulk.
+type SuppressionCreateBulk struct {
+	config
+	builders []*SuppressionCreate
+}
+
+// Save creates the Suppression entities in the database.
+func (scb *SuppressionCreateBulk) Save(ctx context.Context) ([]*Suppression, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Suppression, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SuppressionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SuppressionCreateBulk) SaveX(ctx context.Context) []*Suppression {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *SuppressionCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *SuppressionCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
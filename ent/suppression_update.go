@@ -0,0 +1,637 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"notifications/ent/predicate"
+	"notifications/ent/schema"
+	"notifications/ent/suppression"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SuppressionUpdate is the builder for updating Suppression entities.
+type SuppressionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SuppressionMutation
+}
+
+// Where appends a list predicates to the SuppressionUpdate builder.
+func (su *SuppressionUpdate) Where(ps ...predicate.Suppression) *SuppressionUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetSenderID sets the "sender_id" field.
+func (su *SuppressionUpdate) SetSenderID(i int) *SuppressionUpdate {
+	su.mutation.ResetSenderID()
+	su.mutation.SetSenderID(i)
+	return su
+}
+
+// AddSenderID adds i to the "sender_id" field.
+func (su *SuppressionUpdate) AddSenderID(i int) *SuppressionUpdate {
+	su.mutation.AddSenderID(i)
+	return su
+}
+
+// SetTenant sets the "tenant" field.
+func (su *SuppressionUpdate) SetTenant(s string) *SuppressionUpdate {
+	su.mutation.SetTenant(s)
+	return su
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (su *SuppressionUpdate) SetNillableTenant(s *string) *SuppressionUpdate {
+	if s != nil {
+		su.SetTenant(*s)
+	}
+	return su
+}
+
+// ClearTenant clears the value of the "tenant" field.
+func (su *SuppressionUpdate) ClearTenant() *SuppressionUpdate {
+	su.mutation.ClearTenant()
+	return su
+}
+
+// SetType sets the "type" field.
+func (su *SuppressionUpdate) SetType(st schema.NotificationType) *SuppressionUpdate {
+	su.mutation.SetType(st)
+	return su
+}
+
+// SetAddress sets the "address" field.
+func (su *SuppressionUpdate) SetAddress(s string) *SuppressionUpdate {
+	su.mutation.SetAddress(s)
+	return su
+}
+
+// SetReason sets the "reason" field.
+func (su *SuppressionUpdate) SetReason(s string) *SuppressionUpdate {
+	su.mutation.SetReason(s)
+	return su
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (su *SuppressionUpdate) SetNillableReason(s *string) *SuppressionUpdate {
+	if s != nil {
+		su.SetReason(*s)
+	}
+	return su
+}
+
+// ClearReason clears the value of the "reason" field.
+func (su *SuppressionUpdate) ClearReason() *SuppressionUpdate {
+	su.mutation.ClearReason()
+	return su
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (su *SuppressionUpdate) SetExpiresAt(t time.Time) *SuppressionUpdate {
+	su.mutation.SetExpiresAt(t)
+	return su
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (su *SuppressionUpdate) SetNillableExpiresAt(t *time.Time) *SuppressionUpdate {
+	if t != nil {
+		su.SetExpiresAt(*t)
+	}
+	return su
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (su *SuppressionUpdate) ClearExpiresAt() *SuppressionUpdate {
+	su.mutation.ClearExpiresAt()
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *SuppressionUpdate) SetUpdatedAt(t time.Time) *SuppressionUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// Mutation returns the SuppressionMutation object of the builder.
+func (su *SuppressionUpdate) Mutation() *SuppressionMutation {
+	return su.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *SuppressionUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	su.defaults()
+	if len(su.hooks) == 0 {
+		if err = su.check(); err != nil {
+			return 0, err
+		}
+		affected, err = su.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SuppressionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = su.check(); err != nil {
+				return 0, err
+			}
+			su.mutation = mutation
+			affected, err = su.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(su.hooks) - 1; i >= 0; i-- {
+			if su.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = su.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, su.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SuppressionUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SuppressionUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SuppressionUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *SuppressionUpdate) defaults() {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		v := suppression.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *SuppressionUpdate) check() error {
+	if v, ok := su.mutation.GetType(); ok {
+		if err := suppression.TypeValidator(string(v)); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Suppression.type": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.Address(); ok {
+		if err := suppression.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Suppression.address": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (su *SuppressionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   suppression.Table,
+			Columns: suppression.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: suppression.FieldID,
+			},
+		},
+	}
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.SenderID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: suppression.FieldSenderID,
+		})
+	}
+	if value, ok := su.mutation.AddedSenderID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: suppression.FieldSenderID,
+		})
+	}
+	if value, ok := su.mutation.Tenant(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: suppression.FieldTenant,
+		})
+	}
+	if su.mutation.TenantCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: suppression.FieldTenant,
+		})
+	}
+	if value, ok := su.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: suppression.FieldType,
+		})
+	}
+	if value, ok := su.mutation.Address(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: suppression.FieldAddress,
+		})
+	}
+	if value, ok := su.mutation.Reason(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: suppression.FieldReason,
+		})
+	}
+	if su.mutation.ReasonCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: suppression.FieldReason,
+		})
+	}
+	if value, ok := su.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: suppression.FieldExpiresAt,
+		})
+	}
+	if su.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: suppression.FieldExpiresAt,
+		})
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: suppression.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{suppression.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// SuppressionUpdateOne is the builder for updating a single Suppression entity.
+type SuppressionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SuppressionMutation
+}
+
+// SetSenderID sets the "sender_id" field.
+func (suo *SuppressionUpdateOne) SetSenderID(i int) *SuppressionUpdateOne {
+	suo.mutation.ResetSenderID()
+	suo.mutation.SetSenderID(i)
+	return suo
+}
+
+// AddSenderID adds i to the "sender_id" field.
+func (suo *SuppressionUpdateOne) AddSenderID(i int) *SuppressionUpdateOne {
+	suo.mutation.AddSenderID(i)
+	return suo
+}
+
+// SetTenant sets the "tenant" field.
+func (suo *SuppressionUpdateOne) SetTenant(s string) *SuppressionUpdateOne {
+	suo.mutation.SetTenant(s)
+	return suo
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (suo *SuppressionUpdateOne) SetNillableTenant(s *string) *SuppressionUpdateOne {
+	if s != nil {
+		suo.SetTenant(*s)
+	}
+	return suo
+}
+
+// ClearTenant clears the value of the "tenant" field.
+func (suo *SuppressionUpdateOne) ClearTenant() *SuppressionUpdateOne {
+	suo.mutation.ClearTenant()
+	return suo
+}
+
+// SetType sets the "type" field.
+func (suo *SuppressionUpdateOne) SetType(st schema.NotificationType) *SuppressionUpdateOne {
+	suo.mutation.SetType(st)
+	return suo
+}
+
+// SetAddress sets the "address" field.
+func (suo *SuppressionUpdateOne) SetAddress(s string) *SuppressionUpdateOne {
+	suo.mutation.SetAddress(s)
+	return suo
+}
+
+// SetReason sets the "reason" field.
+func (suo *SuppressionUpdateOne) SetReason(s string) *SuppressionUpdateOne {
+	suo.mutation.SetReason(s)
+	return suo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (suo *SuppressionUpdateOne) SetNillableReason(s *string) *SuppressionUpdateOne {
+	if s != nil {
+		suo.SetReason(*s)
+	}
+	return suo
+}
+
+// ClearReason clears the value of the "reason" field.
+func (suo *SuppressionUpdateOne) ClearReason() *SuppressionUpdateOne {
+	suo.mutation.ClearReason()
+	return suo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (suo *SuppressionUpdateOne) SetExpiresAt(t time.Time) *SuppressionUpdateOne {
+	suo.mutation.SetExpiresAt(t)
+	return suo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (suo *SuppressionUpdateOne) SetNillableExpiresAt(t *time.Time) *SuppressionUpdateOne {
+	if t != nil {
+		suo.SetExpiresAt(*t)
+	}
+	return suo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (suo *SuppressionUpdateOne) ClearExpiresAt() *SuppressionUpdateOne {
+	suo.mutation.ClearExpiresAt()
+	return suo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *SuppressionUpdateOne) SetUpdatedAt(t time.Time) *SuppressionUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// Mutation returns the SuppressionMutation object of the builder.
+func (suo *SuppressionUpdateOne) Mutation() *SuppressionMutation {
+	return suo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *SuppressionUpdateOne) Select(field string, fields ...string) *SuppressionUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Suppression entity.
+func (suo *SuppressionUpdateOne) Save(ctx context.Context) (*Suppression, error) {
+	var (
+		err  error
+		node *Suppression
+	)
+	suo.defaults()
+	if len(suo.hooks) == 0 {
+		if err = suo.check(); err != nil {
+			return nil, err
+		}
+		node, err = suo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SuppressionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = suo.check(); err != nil {
+				return nil, err
+			}
+			suo.mutation = mutation
+			node, err = suo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(suo.hooks) - 1; i >= 0; i-- {
+			if suo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = suo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, suo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Suppression)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from SuppressionMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SuppressionUpdateOne) SaveX(ctx context.Context) *Suppression {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SuppressionUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SuppressionUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *SuppressionUpdateOne) defaults() {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		v := suppression.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *SuppressionUpdateOne) check() error {
+	if v, ok := suo.mutation.GetType(); ok {
+		if err := suppression.TypeValidator(string(v)); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Suppression.type": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.Address(); ok {
+		if err := suppression.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Suppression.address": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (suo *SuppressionUpdateOne) sqlSave(ctx context.Context) (_node *Suppression, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   suppression.Table,
+			Columns: suppression.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: suppression.FieldID,
+			},
+		},
+	}
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Suppression.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, suppression.FieldID)
+		for _, f := range fields {
+			if !suppression.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != suppression.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.SenderID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: suppression.FieldSenderID,
+		})
+	}
+	if value, ok := suo.mutation.AddedSenderID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: suppression.FieldSenderID,
+		})
+	}
+	if value, ok := suo.mutation.Tenant(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: suppression.FieldTenant,
+		})
+	}
+	if suo.mutation.TenantCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: suppression.FieldTenant,
+		})
+	}
+	if value, ok := suo.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: suppression.FieldType,
+		})
+	}
+	if value, ok := suo.mutation.Address(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: suppression.FieldAddress,
+		})
+	}
+	if value, ok := suo.mutation.Reason(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: suppression.FieldReason,
+		})
+	}
+	if suo.mutation.ReasonCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: suppression.FieldReason,
+		})
+	}
+	if value, ok := suo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: suppression.FieldExpiresAt,
+		})
+	}
+	if suo.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: suppression.FieldExpiresAt,
+		})
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: suppression.FieldUpdatedAt,
+		})
+	}
+	_node = &Suppression{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{suppression.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+const (
+	// APIKeyHeader is HTTP header or gRPC metadata key of API key
+	APIKeyHeader = `X-API-Key`
+
+	apiKeyPrefix       = `ntf_`
+	apiKeyBytes        = 32
+	apiKeyVisibleChars = 12
+)
+
+// GenerateAPIKey returns new random API key, its visible prefix and hash to store instead of key
+func GenerateAPIKey() (key, prefix, hash string, err error) {
+	random := make([]byte, apiKeyBytes)
+	if _, err = rand.Read(random); err != nil {
+		return "", "", "", err
+	}
+	key = apiKeyPrefix + hex.EncodeToString(random)
+	return key, key[:apiKeyVisibleChars], HashAPIKey(key), nil
+}
+
+// HashAPIKey returns SHA-256 hash of key, keys are random so hash without salt is enough to look them up
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
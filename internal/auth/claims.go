@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"fmt"
+	"strconv"
+
+	"notifications/internal/pkg/slices"
+
+	jwtv4 "github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	ScopeSend        = `notifications:send`        // Send and enqueue notifications
+	ScopeRead        = `notifications:read`        // Check notifications, usage, schedules and suppressions
+	ScopeSchedule    = `notifications:schedule`    // Create, update and delete schedules
+	ScopeSuppress    = `notifications:suppress`    // Create, update and delete suppressions
+	ScopeKeys        = `notifications:keys`        // Issue, list and revoke API keys of sender
+	ScopeCredentials = `notifications:credentials` // Set, list and delete credentials of channels of tenant
+)
+
+var (
+	Scopes = []string{ScopeSend, ScopeRead, ScopeSchedule, ScopeSuppress, ScopeKeys, ScopeCredentials}
+)
+
+// Claims of token: subject is sender id, scopes allow RPCs and channels allow types of notifications,
+// tenant selects credentials of channels
+type Claims struct {
+	jwtv4.RegisteredClaims
+	Scopes   []string `json:"scopes,omitempty"`
+	Channels []string `json:"channels,omitempty"` // All channels are allowed if empty
+	Tenant   string   `json:"tenant,omitempty"`   // Credentials of config are used if empty
+}
+
+// SenderID returns sender id from subject, it is 0 for tokens without subject
+func (c *Claims) SenderID() (int64, error) {
+	if c.Subject == "" {
+		return 0, nil
+	}
+	senderID, err := strconv.ParseInt(c.Subject, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf(`subject of token is not sender id: %w`, err)
+	}
+	return senderID, nil
+}
+
+func (c *Claims) HasScope(scope string) bool {
+	return slices.Includes(scope, c.Scopes)
+}
+
+func (c *Claims) AllowsChannel(channel string) bool {
+	return len(c.Channels) == 0 || slices.Includes(channel, c.Channels)
+}
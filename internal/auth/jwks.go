@@ -0,0 +1,209 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"notifications/internal/pkg/transport"
+)
+
+const (
+	defaultJWKSRefresh = 5 * time.Minute
+	// minJWKSRefresh limits refreshes by unknown kid, so tokens with random kid do not flood auth service
+	minJWKSRefresh = 10 * time.Second
+)
+
+// JWKS caches keys of JSON Web Key Set by URL, keys are refreshed after interval or by unknown kid
+type JWKS struct {
+	url       string
+	client    transport.HTTPClient
+	refresh   time.Duration
+	mu        sync.Mutex
+	keys      []key
+	fetchedAt time.Time     // time of the last fetched keys
+	checkedAt time.Time     // time of the last attempt to fetch keys
+	fetching  chan struct{} // closed when the fetch in progress ends, nil if keys are not being fetched
+	now       func() time.Time
+}
+
+func NewJWKS(url string, client transport.HTTPClient, refresh time.Duration) *JWKS {
+	if refresh <= 0 {
+		refresh = defaultJWKSRefresh
+	}
+	return &JWKS{
+		url:     url,
+		client:  client,
+		refresh: refresh,
+		now:     time.Now,
+	}
+}
+
+// Keys returns cached keys, they are fetched again if cache is expired or has no key with kid.
+// Stale keys are returned if keys are not fetched, so outage of auth service does not reject valid tokens.
+// Keys are fetched without lock, so slow auth service does not block callers having cached keys
+func (j *JWKS) Keys(ctx context.Context, kid string) ([]key, error) {
+	j.mu.Lock()
+	now := j.now()
+	cached, fetching := j.keys, j.fetching
+	fresh := now.Sub(j.fetchedAt) < j.refresh && hasKey(cached, kid)
+	refetch := !fresh && fetching == nil && now.Sub(j.checkedAt) >= minJWKSRefresh
+	if refetch {
+		j.checkedAt = now
+		j.fetching = make(chan struct{})
+	}
+	j.mu.Unlock()
+
+	switch {
+	case refetch:
+		keys, err := j.fetch(ctx)
+		j.mu.Lock()
+		if err == nil {
+			j.keys = keys
+			j.fetchedAt = now
+			cached = keys
+		}
+		close(j.fetching)
+		j.fetching = nil
+		j.mu.Unlock()
+		if err != nil && len(cached) == 0 {
+			return nil, err
+		}
+	case len(cached) == 0 && fetching != nil:
+		// the first keys are awaited as there are no stale keys to return
+		select {
+		case <-fetching:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		j.mu.Lock()
+		cached = j.keys
+		j.mu.Unlock()
+	}
+	if len(cached) == 0 {
+		return nil, errors.New(`keys of jwks are not fetched`)
+	}
+	return cached, nil
+}
+
+func (j *JWKS) fetch(ctx context.Context) ([]key, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, j.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := j.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to fetch jwks: %w`, err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(`failed to fetch jwks: status %d`, response.StatusCode)
+	}
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err = json.NewDecoder(response.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf(`failed to decode jwks: %w`, err)
+	}
+	keys := make([]key, 0, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != `sig` {
+			continue
+		}
+		public, err := k.publicKey()
+		if err != nil {
+			// Unsupported keys are skipped as other keys of set may be used
+			continue
+		}
+		keys = append(keys, key{id: k.Kid, public: public})
+	}
+	return keys, nil
+}
+
+// jwk is public key of JSON Web Key Set, see RFC 7517 and RFC 7518
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jwk) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case `RSA`:
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case `EC`:
+		curves := map[string]elliptic.Curve{`P-256`: elliptic.P256(), `P-384`: elliptic.P384(), `P-521`: elliptic.P521()}
+		curve, ok := curves[k.Crv]
+		if !ok {
+			return nil, fmt.Errorf(`curve %s is unknown`, k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New(`point is not on curve`)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case `OKP`:
+		if k.Crv != `Ed25519` {
+			return nil, fmt.Errorf(`curve %s is unknown`, k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New(`ed25519 key is invalid`)
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf(`key type %s is unknown`, k.Kty)
+	}
+}
+
+func decodeBigInt(encoded string) (*big.Int, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) == 0 {
+		return nil, errors.New(`value of key is empty`)
+	}
+	return new(big.Int).SetBytes(decoded), nil
+}
+
+func hasKey(keys []key, kid string) bool {
+	for _, k := range keys {
+		if k.id == kid {
+			return true
+		}
+	}
+	return false
+}
@@ -1,32 +1,47 @@
 package auth
 
 import (
+	"strconv"
 	"time"
 
 	jwtv4 "github.com/golang-jwt/jwt/v4"
 )
 
 const (
-	issuer = `service-notifications`
+	// Issuer is issuer of tokens made by service
+	Issuer = `service-notifications`
+	// DefaultTTL is lifetime of tokens made by service
+	DefaultTTL = 24 * time.Hour
 )
 
-func CheckJWT(secret string) func(token *jwtv4.Token) (interface{}, error) {
-	return func(token *jwtv4.Token) (interface{}, error) {
-		return []byte(secret), nil
+// MakeJWT signs HS256 token of sender with scopes and allowed channels, all channels are allowed if none passed
+func MakeJWT(secret string, senderID int64, scopes []string, channels []string) string {
+	now := time.Now()
+	claims := &Claims{
+		RegisteredClaims: jwtv4.RegisteredClaims{
+			ExpiresAt: jwtv4.NewNumericDate(now.Add(DefaultTTL)),
+			IssuedAt:  jwtv4.NewNumericDate(now),
+			Issuer:    Issuer,
+		},
+		Scopes:   scopes,
+		Channels: channels,
 	}
-}
-
-func MakeJWT(secret string) string {
-	farFromRefuge := time.Date(2222, 2, 2, 2, 22, 22, 0, time.UTC)
-	claims := &jwtv4.RegisteredClaims{
-		ExpiresAt: jwtv4.NewNumericDate(farFromRefuge),
-		Issuer:    issuer,
+	if senderID != 0 {
+		claims.Subject = strconv.FormatInt(senderID, 10)
 	}
 
-	token := jwtv4.NewWithClaims(jwtv4.SigningMethodHS256, claims)
-	signedString, err := token.SignedString([]byte(secret))
+	signedString, err := Sign(claims, jwtv4.SigningMethodHS256, []byte(secret), ``)
 	if err != nil {
 		panic(err)
 	}
 	return signedString
 }
+
+// Sign signs claims by key with method, kid is put to header if passed to select key of verifier
+func Sign(claims jwtv4.Claims, method jwtv4.SigningMethod, key interface{}, kid string) (string, error) {
+	token := jwtv4.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header[`kid`] = kid
+	}
+	return token.SignedString(key)
+}
@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+
+	jwtv4 "github.com/golang-jwt/jwt/v4"
+)
+
+// key verifies signatures of tokens with kid of key
+type key struct {
+	id     string
+	public interface{} // []byte secret, *rsa.PublicKey, *ecdsa.PublicKey or ed25519.PublicKey
+}
+
+// ParsePublicKey returns RSA, ECDSA or Ed25519 public key of PEM
+func ParsePublicKey(pem []byte) (interface{}, error) {
+	if public, err := jwtv4.ParseRSAPublicKeyFromPEM(pem); err == nil {
+		return public, nil
+	}
+	if public, err := jwtv4.ParseECPublicKeyFromPEM(pem); err == nil {
+		return public, nil
+	}
+	if public, err := jwtv4.ParseEdPublicKeyFromPEM(pem); err == nil {
+		return public, nil
+	}
+	return nil, errors.New(`public key is not RSA, ECDSA or Ed25519 key in PEM`)
+}
+
+// ParsePrivateKey returns RSA, ECDSA or Ed25519 private key of PEM with default signing method of key
+func ParsePrivateKey(pem []byte) (interface{}, jwtv4.SigningMethod, error) {
+	if private, err := jwtv4.ParseRSAPrivateKeyFromPEM(pem); err == nil {
+		return private, jwtv4.SigningMethodRS256, nil
+	}
+	if private, err := jwtv4.ParseECPrivateKeyFromPEM(pem); err == nil {
+		switch private.Curve.Params().BitSize {
+		case 384:
+			return private, jwtv4.SigningMethodES384, nil
+		case 521:
+			return private, jwtv4.SigningMethodES512, nil
+		default:
+			return private, jwtv4.SigningMethodES256, nil
+		}
+	}
+	if private, err := jwtv4.ParseEdPrivateKeyFromPEM(pem); err == nil {
+		return private, jwtv4.SigningMethodEdDSA, nil
+	}
+	return nil, nil, errors.New(`private key is not RSA, ECDSA or Ed25519 key in PEM`)
+}
+
+// verifies reports if key verifies signature of method, so HS256 token is never checked by public key and back
+func (k key) verifies(method jwtv4.SigningMethod) bool {
+	switch k.public.(type) {
+	case []byte:
+		_, ok := method.(*jwtv4.SigningMethodHMAC)
+		return ok
+	case *rsa.PublicKey:
+		_, isRSA := method.(*jwtv4.SigningMethodRSA)
+		_, isPSS := method.(*jwtv4.SigningMethodRSAPSS)
+		return isRSA || isPSS
+	case *ecdsa.PublicKey:
+		ec, ok := method.(*jwtv4.SigningMethodECDSA)
+		return ok && ec.CurveBits == k.public.(*ecdsa.PublicKey).Curve.Params().BitSize
+	case ed25519.PublicKey:
+		_, ok := method.(*jwtv4.SigningMethodEd25519)
+		return ok
+	default:
+		return false
+	}
+}
+
+// matches reports if key has kid, shared secret has no id and matches any kid
+func (k key) matches(kid string) bool {
+	if _, isSecret := k.public.([]byte); isSecret && k.id == "" {
+		return true
+	}
+	return k.id == kid
+}
+
+// selectKey returns the first key with kid verifying signature of method
+func selectKey(keys []key, kid string, method jwtv4.SigningMethod) (interface{}, error) {
+	for _, k := range keys {
+		if k.matches(kid) && k.verifies(method) {
+			return k.public, nil
+		}
+	}
+	return nil, fmt.Errorf(`no key with kid '%s' for %s`, kid, method.Alg())
+}
@@ -0,0 +1,93 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"notifications/internal/conf"
+	"notifications/internal/pkg/slices"
+	"notifications/internal/pkg/transport"
+
+	jwtv4 "github.com/golang-jwt/jwt/v4"
+)
+
+// Verifier checks signature of token by shared secret, configured public keys or keys of JWKS selected by kid
+// and signing method of token, then checks expiry, issuer and audience of token
+type Verifier struct {
+	keys      []key
+	jwks      *JWKS
+	issuers   []string
+	audiences []string
+}
+
+func NewVerifier(c *conf.Auth) (*Verifier, error) {
+	jwt := c.GetJwt()
+	verifier := &Verifier{
+		issuers:   jwt.GetIssuers(),
+		audiences: jwt.GetAudiences(),
+	}
+	if jwt.GetSecret() != "" {
+		verifier.keys = append(verifier.keys, key{public: []byte(jwt.GetSecret())})
+	}
+	for _, k := range jwt.GetKeys() {
+		public, err := ParsePublicKey([]byte(k.GetPublicKey()))
+		if err != nil {
+			return nil, fmt.Errorf(`key '%s' is invalid: %w`, k.GetId(), err)
+		}
+		verifier.keys = append(verifier.keys, key{id: k.GetId(), public: public})
+	}
+	if jwt.GetJwksUrl() != "" {
+		verifier.jwks = NewJWKS(jwt.GetJwksUrl(), transport.NewHTTPClient(), jwt.GetJwksRefresh().AsDuration())
+	}
+	if len(verifier.keys) == 0 && verifier.jwks == nil {
+		return nil, errors.New(`no secret, keys or jwks url to verify tokens`)
+	}
+	return verifier, nil
+}
+
+// Verify returns claims of valid token
+func (v *Verifier) Verify(ctx context.Context, token string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwtv4.ParseWithClaims(
+		token, claims, func(token *jwtv4.Token) (interface{}, error) {
+			return v.key(ctx, token)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if claims.ExpiresAt == nil {
+		return nil, errors.New(`token has no expiry`)
+	}
+	if len(v.issuers) > 0 && !slices.Includes(claims.Issuer, v.issuers) {
+		return nil, fmt.Errorf(`issuer '%s' is unknown`, claims.Issuer)
+	}
+	if len(v.audiences) > 0 && !v.hasAudience(claims) {
+		return nil, errors.New(`token is not issued for audience of service`)
+	}
+	return claims, nil
+}
+
+// key returns key of token, configured keys take precedence over keys of JWKS
+func (v *Verifier) key(ctx context.Context, token *jwtv4.Token) (interface{}, error) {
+	kid, _ := token.Header[`kid`].(string)
+	public, err := selectKey(v.keys, kid, token.Method)
+	if err == nil || v.jwks == nil {
+		return public, err
+	}
+	keys, err := v.jwks.Keys(ctx, kid)
+	if err != nil {
+		return nil, err
+	}
+	return selectKey(keys, kid, token.Method)
+}
+
+func (v *Verifier) hasAudience(claims *Claims) bool {
+	for _, audience := range v.audiences {
+		if claims.VerifyAudience(audience, true) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,288 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"notifications/internal/conf"
+
+	jwtv4 "github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func publicPEM(t *testing.T, public interface{}) string {
+	der, err := x509.MarshalPKIXPublicKey(public)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: `PUBLIC KEY`, Bytes: der}))
+}
+
+func claimsOf(subject string, ttl time.Duration) *Claims {
+	claims := &Claims{
+		RegisteredClaims: jwtv4.RegisteredClaims{
+			Subject:  subject,
+			Issuer:   Issuer,
+			Audience: jwtv4.ClaimStrings{`notifications`},
+		},
+		Scopes: []string{ScopeSend},
+	}
+	if ttl != 0 {
+		claims.ExpiresAt = jwtv4.NewNumericDate(time.Now().Add(ttl))
+	}
+	return claims
+}
+
+func sign(t *testing.T, claims jwtv4.Claims, method jwtv4.SigningMethod, key interface{}, kid string) string {
+	token, err := Sign(claims, method, key, kid)
+	require.NoError(t, err)
+	return token
+}
+
+func TestVerifier(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	verifier, err := NewVerifier(
+		&conf.Auth{
+			Jwt: &conf.Auth_JWT{
+				Secret: `secret`,
+				Keys: []*conf.Auth_JWT_Key{
+					{Id: `rsa`, PublicKey: publicPEM(t, &rsaKey.PublicKey)},
+					{Id: `ec`, PublicKey: publicPEM(t, &ecKey.PublicKey)},
+				},
+				Issuers:   []string{Issuer},
+				Audiences: []string{`notifications`},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name  string
+		token string
+		valid bool
+	}{
+		{
+			name:  "hs256",
+			token: sign(t, claimsOf(`42`, time.Hour), jwtv4.SigningMethodHS256, []byte(`secret`), ``),
+			valid: true,
+		},
+		{
+			name:  "hs256-with-kid",
+			token: sign(t, claimsOf(`42`, time.Hour), jwtv4.SigningMethodHS256, []byte(`secret`), `2022-09`),
+			valid: true,
+		},
+		{
+			name:  "hs256-by-other-secret",
+			token: sign(t, claimsOf(`42`, time.Hour), jwtv4.SigningMethodHS256, []byte(`other`), `2022-09`),
+		},
+		{
+			name:  "rs256",
+			token: sign(t, claimsOf(`42`, time.Hour), jwtv4.SigningMethodRS256, rsaKey, `rsa`),
+			valid: true,
+		},
+		{
+			name:  "es256",
+			token: sign(t, claimsOf(`42`, time.Hour), jwtv4.SigningMethodES256, ecKey, `ec`),
+			valid: true,
+		},
+		{
+			name:  "kid-of-other-key",
+			token: sign(t, claimsOf(`42`, time.Hour), jwtv4.SigningMethodRS256, rsaKey, `ec`),
+		},
+		{
+			name:  "unknown-key",
+			token: sign(t, claimsOf(`42`, time.Hour), jwtv4.SigningMethodRS256, otherKey, `rsa`),
+		},
+		{
+			name: "hs256-by-public-key",
+			token: sign(
+				t, claimsOf(`42`, time.Hour), jwtv4.SigningMethodHS256, []byte(publicPEM(t, &rsaKey.PublicKey)), `rsa`,
+			),
+		},
+		{
+			name:  "expired",
+			token: sign(t, claimsOf(`42`, -time.Minute), jwtv4.SigningMethodRS256, rsaKey, `rsa`),
+		},
+		{
+			name:  "without-expiry",
+			token: sign(t, claimsOf(`42`, 0), jwtv4.SigningMethodRS256, rsaKey, `rsa`),
+		},
+		{
+			name: "unknown-issuer",
+			token: func() string {
+				claims := claimsOf(`42`, time.Hour)
+				claims.Issuer = `someone`
+				return sign(t, claims, jwtv4.SigningMethodRS256, rsaKey, `rsa`)
+			}(),
+		},
+		{
+			name: "other-audience",
+			token: func() string {
+				claims := claimsOf(`42`, time.Hour)
+				claims.Audience = jwtv4.ClaimStrings{`billing`}
+				return sign(t, claims, jwtv4.SigningMethodRS256, rsaKey, `rsa`)
+			}(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				claims, err := verifier.Verify(context.Background(), testCase.token)
+				if !testCase.valid {
+					require.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+				require.Equal(t, `42`, claims.Subject)
+				require.Equal(t, []string{ScopeSend}, claims.Scopes)
+			},
+		)
+	}
+}
+
+type jwksServer struct {
+	mu      sync.Mutex
+	keys    map[string]*rsa.PublicKey
+	fetches int
+}
+
+func (s *jwksServer) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fetches++
+	set := struct {
+		Keys []jwk `json:"keys"`
+	}{}
+	for kid, public := range s.keys {
+		set.Keys = append(
+			set.Keys, jwk{
+				Kty: `RSA`,
+				Kid: kid,
+				Use: `sig`,
+				N:   base64.RawURLEncoding.EncodeToString(public.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes()),
+			},
+		)
+	}
+	_ = json.NewEncoder(w).Encode(set)
+}
+
+func (s *jwksServer) rotate(kid string, public *rsa.PublicKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = map[string]*rsa.PublicKey{kid: public}
+}
+
+func TestVerifierJWKS(t *testing.T) {
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	keys := &jwksServer{keys: map[string]*rsa.PublicKey{`old`: &oldKey.PublicKey}}
+	server := httptest.NewServer(keys)
+	defer server.Close()
+
+	verifier, err := NewVerifier(&conf.Auth{Jwt: &conf.Auth_JWT{JwksUrl: server.URL}})
+	require.NoError(t, err)
+	now := time.Now()
+	verifier.jwks.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	_, err = verifier.Verify(ctx, sign(t, claimsOf(`42`, time.Hour), jwtv4.SigningMethodRS256, oldKey, `old`))
+	require.NoError(t, err)
+	_, err = verifier.Verify(ctx, sign(t, claimsOf(`42`, time.Hour), jwtv4.SigningMethodRS256, oldKey, `old`))
+	require.NoError(t, err)
+	require.Equal(t, 1, keys.fetches, `keys are cached`)
+
+	keys.rotate(`new`, &newKey.PublicKey)
+	newToken := sign(t, claimsOf(`42`, time.Hour), jwtv4.SigningMethodRS256, newKey, `new`)
+
+	_, err = verifier.Verify(ctx, newToken)
+	require.Error(t, err, `unknown kid does not refresh keys too often`)
+	require.Equal(t, 1, keys.fetches)
+
+	now = now.Add(minJWKSRefresh)
+	_, err = verifier.Verify(ctx, newToken)
+	require.NoError(t, err, `unknown kid refreshes keys`)
+	require.Equal(t, 2, keys.fetches)
+
+	server.Close()
+	now = now.Add(defaultJWKSRefresh)
+	_, err = verifier.Verify(ctx, newToken)
+	require.NoError(t, err, `stale keys are used if jwks is unavailable`)
+}
+
+type blockingClient struct {
+	next    http.Handler
+	release chan struct{}
+}
+
+func (c *blockingClient) Do(request *http.Request) (*http.Response, error) {
+	<-c.release
+	recorder := httptest.NewRecorder()
+	c.next.ServeHTTP(recorder, request)
+	return recorder.Result(), nil
+}
+
+func TestJWKSKeysDuringFetch(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keys := &jwksServer{keys: map[string]*rsa.PublicKey{`rsa`: &rsaKey.PublicKey}}
+	client := &blockingClient{next: keys, release: make(chan struct{})}
+	jwks := NewJWKS(`https://auth.example.com/jwks.json`, client, time.Minute)
+	now := time.Now()
+	jwks.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	first := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := jwks.Keys(ctx, `rsa`)
+			first <- err
+		}()
+	}
+	client.release <- struct{}{}
+	require.NoError(t, <-first)
+	require.NoError(t, <-first, `the first keys are awaited`)
+	require.Equal(t, 1, keys.fetches)
+
+	now = now.Add(time.Minute)
+	refreshed := make(chan error, 1)
+	go func() {
+		_, err := jwks.Keys(ctx, `rsa`)
+		refreshed <- err
+	}()
+	require.Eventually(
+		t, func() bool {
+			jwks.mu.Lock()
+			defer jwks.mu.Unlock()
+			return jwks.fetching != nil
+		}, time.Second, time.Millisecond,
+	)
+	cached, err := jwks.Keys(ctx, `rsa`)
+	require.NoError(t, err, `cached keys are returned while keys are fetched`)
+	require.Len(t, cached, 1)
+
+	client.release <- struct{}{}
+	require.NoError(t, <-refreshed)
+	require.Equal(t, 2, keys.fetches)
+}
@@ -0,0 +1,144 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"notifications/ent"
+	"notifications/internal/auth"
+	"notifications/internal/pkg/logger"
+	"notifications/internal/pkg/metrics"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	metricAPIKeyIssueSuccess = `biz.apiKey.issue.success`
+	metricAPIKeyIssueFailure = `biz.apiKey.issue.failure`
+	metricAPIKeyIssueTimings = `biz.apiKey.issue.timings`
+
+	metricAPIKeyAuthenticateSuccess = `biz.apiKey.authenticate.success`
+	metricAPIKeyAuthenticateFailure = `biz.apiKey.authenticate.failure`
+	metricAPIKeyAuthenticateTimings = `biz.apiKey.authenticate.timings`
+
+	// apiKeyTouchInterval limits writes of last usage time to one per interval for every key
+	apiKeyTouchInterval = time.Minute
+)
+
+var (
+	ErrAPIKeyNotFound = errors.New(`api key not found`)
+	ErrAPIKeyInvalid  = errors.New(`api key is invalid, expired or revoked`)
+)
+
+type APIKeyRepo interface {
+	Create(ctx context.Context, k *ent.APIKey) (*ent.APIKey, error)
+
+	FindByID(ctx context.Context, id int) (*ent.APIKey, error)
+
+	FindByHash(ctx context.Context, hash string) (*ent.APIKey, error)
+
+	// Revoke sets revocation time of key
+	Revoke(ctx context.Context, id int, at time.Time) (*ent.APIKey, error)
+
+	TouchLastUsed(ctx context.Context, id int, at time.Time) error
+
+	// ListBySender returns keys of sender and tenant, revoked keys too
+	ListBySender(ctx context.Context, senderID int, tenant string) ([]*ent.APIKey, error)
+}
+
+// APIKeyUsecase issues, revokes and authenticates API keys of senders
+type APIKeyUsecase struct {
+	repo   APIKeyRepo
+	metric metrics.Metrics
+	logs   logger.Logger
+	now    func() time.Time
+}
+
+func NewAPIKeyUsecase(repo APIKeyRepo, metric metrics.Metrics, logs log.Logger) *APIKeyUsecase {
+	return &APIKeyUsecase{
+		repo:   repo,
+		metric: metric,
+		logs:   logger.NewHelper(logs, "ts", log.DefaultTimestamp, "scope", "biz-apikey"),
+		now:    time.Now,
+	}
+}
+
+// Issue creates key and returns it with the key itself, which is shown once and only its hash is stored
+func (uc *APIKeyUsecase) Issue(ctx context.Context, k *ent.APIKey) (*ent.APIKey, string, error) {
+	defer uc.metric.NewTiming().Send(metricAPIKeyIssueTimings)
+	key, prefix, hash, err := auth.GenerateAPIKey()
+	if err == nil {
+		k.Prefix = prefix
+		k.Hash = hash
+		k, err = uc.repo.Create(ctx, k)
+	}
+	if err != nil {
+		uc.metric.Increment(metricAPIKeyIssueFailure)
+		uc.logs.WithContext(ctx).Errorf("failed to issue api key: %v", err)
+		return nil, "", err
+	}
+	uc.metric.Increment(metricAPIKeyIssueSuccess)
+	return k, key, nil
+}
+
+// Revoke revokes key of sender, key of other sender or tenant is not found
+func (uc *APIKeyUsecase) Revoke(ctx context.Context, id int, senderID int, tenant string) (*ent.APIKey, error) {
+	k, err := uc.repo.FindByID(ctx, id)
+	if ent.IsNotFound(err) || (err == nil && (k.SenderID != senderID || k.Tenant != tenant)) {
+		return nil, ErrAPIKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if k.RevokedAt != nil {
+		return k, nil
+	}
+	return uc.repo.Revoke(ctx, id, uc.now())
+}
+
+// List returns keys of sender and tenant
+func (uc *APIKeyUsecase) List(ctx context.Context, senderID int, tenant string) ([]*ent.APIKey, error) {
+	return uc.repo.ListBySender(ctx, senderID, tenant)
+}
+
+// Authenticate returns active key and updates time of its usage
+func (uc *APIKeyUsecase) Authenticate(ctx context.Context, key string) (*ent.APIKey, error) {
+	defer uc.metric.NewTiming().Send(metricAPIKeyAuthenticateTimings)
+	k, err := uc.authenticate(ctx, key)
+	if err != nil {
+		uc.metric.Increment(metricAPIKeyAuthenticateFailure)
+		return nil, err
+	}
+	uc.metric.Increment(metricAPIKeyAuthenticateSuccess)
+	return k, nil
+}
+
+func (uc *APIKeyUsecase) authenticate(ctx context.Context, key string) (*ent.APIKey, error) {
+	if key == "" {
+		return nil, ErrAPIKeyInvalid
+	}
+	k, err := uc.repo.FindByHash(ctx, auth.HashAPIKey(key))
+	if ent.IsNotFound(err) {
+		return nil, ErrAPIKeyInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	now := uc.now()
+	if !IsAPIKeyActive(k, now) {
+		return nil, ErrAPIKeyInvalid
+	}
+	if k.LastUsedAt == nil || now.Sub(*k.LastUsedAt) >= apiKeyTouchInterval {
+		if err = uc.repo.TouchLastUsed(ctx, k.ID, now); err != nil {
+			uc.logs.WithContext(ctx).Warnf("failed to update last usage of api key %d: %v", k.ID, err)
+		}
+		k.LastUsedAt = &now
+	}
+	return k, nil
+}
+
+// IsAPIKeyActive reports whether key is not revoked and not expired at time
+func IsAPIKeyActive(k *ent.APIKey, at time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || k.ExpiresAt.After(at))
+}
@@ -0,0 +1,101 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"notifications/ent"
+	"notifications/internal/auth"
+	"notifications/internal/pkg/metrics"
+
+	"github.com/AlekSi/pointer"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/require"
+)
+
+type apiKeyRepoStub struct {
+	APIKeyRepo
+	keys    []*ent.APIKey
+	touched []int
+}
+
+func (r *apiKeyRepoStub) Create(_ context.Context, k *ent.APIKey) (*ent.APIKey, error) {
+	k.ID = len(r.keys) + 1
+	r.keys = append(r.keys, k)
+	return k, nil
+}
+
+func (r *apiKeyRepoStub) FindByID(_ context.Context, id int) (*ent.APIKey, error) {
+	for _, k := range r.keys {
+		if k.ID == id {
+			return k, nil
+		}
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+func (r *apiKeyRepoStub) FindByHash(_ context.Context, hash string) (*ent.APIKey, error) {
+	for _, k := range r.keys {
+		if k.Hash == hash {
+			return k, nil
+		}
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+func (r *apiKeyRepoStub) Revoke(_ context.Context, id int, at time.Time) (*ent.APIKey, error) {
+	r.keys[id-1].RevokedAt = &at
+	return r.keys[id-1], nil
+}
+
+func (r *apiKeyRepoStub) TouchLastUsed(_ context.Context, id int, _ time.Time) error {
+	r.touched = append(r.touched, id)
+	return nil
+}
+
+func TestAPIKeyUsecase(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2022, 9, 1, 12, 30, 0, 0, time.UTC)
+	metric, err := metrics.New(``, `test`, true)
+	require.NoError(t, err)
+	repo := &apiKeyRepoStub{}
+	uc := NewAPIKeyUsecase(repo, metric, log.NewFilter(log.DefaultLogger, log.FilterLevel(log.LevelFatal)))
+	uc.now = func() time.Time { return now }
+
+	issued, key, err := uc.Issue(ctx, &ent.APIKey{SenderID: 42, Scopes: []string{auth.ScopeSend}})
+	require.NoError(t, err)
+	require.NotEqual(t, key, issued.Hash)
+	require.Equal(t, auth.HashAPIKey(key), issued.Hash)
+	require.Equal(t, key[:len(issued.Prefix)], issued.Prefix)
+
+	authenticated, err := uc.Authenticate(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, 42, authenticated.SenderID)
+	require.Equal(t, []int{issued.ID}, repo.touched)
+
+	_, err = uc.Authenticate(ctx, key)
+	require.NoError(t, err)
+	require.Len(t, repo.touched, 1, "last usage is updated once per interval")
+
+	_, err = uc.Authenticate(ctx, key+`0`)
+	require.ErrorIs(t, err, ErrAPIKeyInvalid)
+
+	_, expired, err := uc.Issue(
+		ctx,
+		&ent.APIKey{SenderID: 42, Scopes: []string{auth.ScopeSend}, ExpiresAt: pointer.ToTime(now)},
+	)
+	require.NoError(t, err)
+	_, err = uc.Authenticate(ctx, expired)
+	require.ErrorIs(t, err, ErrAPIKeyInvalid)
+
+	_, err = uc.Revoke(ctx, issued.ID, 43, ``)
+	require.ErrorIs(t, err, ErrAPIKeyNotFound)
+	_, err = uc.Revoke(ctx, issued.ID, 42, `acme`)
+	require.ErrorIs(t, err, ErrAPIKeyNotFound, `key of sender of other tenant is not found`)
+	revoked, err := uc.Revoke(ctx, issued.ID, 42, ``)
+	require.NoError(t, err)
+	require.True(t, now.Equal(*revoked.RevokedAt))
+	_, err = uc.Authenticate(ctx, key)
+	require.ErrorIs(t, err, ErrAPIKeyInvalid)
+}
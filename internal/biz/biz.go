@@ -3,4 +3,11 @@ package biz
 import "github.com/google/wire"
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewNotificationUsecase)
+var ProviderSet = wire.NewSet(
+	NewNotificationUsecase,
+	NewScheduleUsecase,
+	NewSuppressionUsecase,
+	NewDigestUsecase,
+	NewAPIKeyUsecase,
+	NewChannelCredentialUsecase,
+)
@@ -0,0 +1,114 @@
+package biz
+
+import (
+	"context"
+	"errors"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/pkg/logger"
+	"notifications/internal/pkg/metrics"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	metricChannelCredentialSetSuccess = `biz.channelCredential.set.success`
+	metricChannelCredentialSetFailure = `biz.channelCredential.set.failure`
+	metricChannelCredentialSetTimings = `biz.channelCredential.set.timings`
+
+	metricChannelCredentialDeleteSuccess = `biz.channelCredential.delete.success`
+	metricChannelCredentialDeleteFailure = `biz.channelCredential.delete.failure`
+	metricChannelCredentialDeleteTimings = `biz.channelCredential.delete.timings`
+)
+
+var (
+	ErrChannelCredentialNotFound = errors.New(`channel credential not found`)
+)
+
+type ChannelCredentialRepo interface {
+	Create(ctx context.Context, c *ent.ChannelCredential) (*ent.ChannelCredential, error)
+
+	// Update replaces credentials of channel
+	Update(ctx context.Context, c *ent.ChannelCredential) (*ent.ChannelCredential, error)
+
+	FindByTenantAndType(
+		ctx context.Context,
+		tenant string,
+		notificationType schema.NotificationType,
+	) (*ent.ChannelCredential, error)
+
+	ListByTenant(ctx context.Context, tenant string) ([]*ent.ChannelCredential, error)
+
+	// Delete returns count of deleted credentials
+	Delete(ctx context.Context, tenant string, notificationType schema.NotificationType) (int, error)
+}
+
+// ChannelCredentialUsecase manages credentials of channels of tenants, senders of tenant use them
+// instead of credentials of config
+type ChannelCredentialUsecase struct {
+	repo   ChannelCredentialRepo
+	metric metrics.Metrics
+	logs   logger.Logger
+}
+
+func NewChannelCredentialUsecase(
+	repo ChannelCredentialRepo,
+	metric metrics.Metrics,
+	logs log.Logger,
+) *ChannelCredentialUsecase {
+	return &ChannelCredentialUsecase{
+		repo:   repo,
+		metric: metric,
+		logs:   logger.NewHelper(logs, "ts", log.DefaultTimestamp, "scope", "biz-channel-credential"),
+	}
+}
+
+// Set creates credentials of channel of tenant or replaces existing ones
+func (uc *ChannelCredentialUsecase) Set(
+	ctx context.Context,
+	c *ent.ChannelCredential,
+) (*ent.ChannelCredential, error) {
+	defer uc.metric.NewTiming().Send(metricChannelCredentialSetTimings)
+	existing, err := uc.repo.FindByTenantAndType(ctx, c.Tenant, c.Type)
+	switch {
+	case ent.IsNotFound(err):
+		c, err = uc.repo.Create(ctx, c)
+	case err == nil:
+		c.ID = existing.ID
+		c, err = uc.repo.Update(ctx, c)
+	}
+	if err != nil {
+		uc.metric.Increment(metricChannelCredentialSetFailure)
+		uc.logs.WithContext(ctx).Errorf("failed to set channel credential: %v", err)
+		return nil, err
+	}
+	uc.metric.Increment(metricChannelCredentialSetSuccess)
+	uc.logs.WithContext(ctx).Infof("credentials of %s of tenant %s were set", c.Type, c.Tenant)
+	return c, nil
+}
+
+func (uc *ChannelCredentialUsecase) List(ctx context.Context, tenant string) ([]*ent.ChannelCredential, error) {
+	return uc.repo.ListByTenant(ctx, tenant)
+}
+
+// Delete deletes credentials of channel of tenant, notifications of tenant are sent by credentials of config then
+func (uc *ChannelCredentialUsecase) Delete(
+	ctx context.Context,
+	tenant string,
+	notificationType schema.NotificationType,
+) error {
+	defer uc.metric.NewTiming().Send(metricChannelCredentialDeleteTimings)
+	deleted, err := uc.repo.Delete(ctx, tenant, notificationType)
+	if err != nil {
+		uc.metric.Increment(metricChannelCredentialDeleteFailure)
+		uc.logs.WithContext(ctx).Errorf("failed to delete channel credential: %v", err)
+		return err
+	}
+	if deleted == 0 {
+		return ErrChannelCredentialNotFound
+	}
+	uc.metric.Increment(metricChannelCredentialDeleteSuccess)
+	uc.logs.WithContext(ctx).Infof("credentials of %s of tenant %s were deleted", notificationType, tenant)
+	return nil
+}
@@ -0,0 +1,96 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/pkg/metrics"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/require"
+)
+
+type channelCredentialRepoStub struct {
+	ChannelCredentialRepo
+	credentials []*ent.ChannelCredential
+}
+
+func (r *channelCredentialRepoStub) Create(
+	_ context.Context,
+	c *ent.ChannelCredential,
+) (*ent.ChannelCredential, error) {
+	c.ID = len(r.credentials) + 1
+	r.credentials = append(r.credentials, c)
+	return c, nil
+}
+
+func (r *channelCredentialRepoStub) Update(
+	_ context.Context,
+	c *ent.ChannelCredential,
+) (*ent.ChannelCredential, error) {
+	r.credentials[c.ID-1].Credentials = c.Credentials
+	return r.credentials[c.ID-1], nil
+}
+
+func (r *channelCredentialRepoStub) FindByTenantAndType(
+	_ context.Context,
+	tenant string,
+	notificationType schema.NotificationType,
+) (*ent.ChannelCredential, error) {
+	for _, c := range r.credentials {
+		if c != nil && c.Tenant == tenant && c.Type == notificationType {
+			return c, nil
+		}
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+func (r *channelCredentialRepoStub) Delete(
+	_ context.Context,
+	tenant string,
+	notificationType schema.NotificationType,
+) (int, error) {
+	for i, c := range r.credentials {
+		if c != nil && c.Tenant == tenant && c.Type == notificationType {
+			r.credentials[i] = nil
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+func TestChannelCredentialUsecase(t *testing.T) {
+	ctx := context.Background()
+	metric, err := metrics.New(``, `test`, true)
+	require.NoError(t, err)
+	repo := &channelCredentialRepoStub{}
+	uc := NewChannelCredentialUsecase(repo, metric, log.NewFilter(log.DefaultLogger, log.FilterLevel(log.LevelFatal)))
+
+	created, err := uc.Set(
+		ctx,
+		&ent.ChannelCredential{
+			Tenant:      `acme`,
+			Type:        schema.TypeTelegram,
+			Credentials: schema.Credentials{BotToken: `old`},
+		},
+	)
+	require.NoError(t, err)
+
+	replaced, err := uc.Set(
+		ctx,
+		&ent.ChannelCredential{
+			Tenant:      `acme`,
+			Type:        schema.TypeTelegram,
+			Credentials: schema.Credentials{BotToken: `new`},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, created.ID, replaced.ID, `credentials of channel are replaced`)
+	require.Equal(t, `new`, replaced.Credentials.BotToken)
+	require.Len(t, repo.credentials, 1)
+
+	require.NoError(t, uc.Delete(ctx, `acme`, schema.TypeTelegram))
+	require.ErrorIs(t, uc.Delete(ctx, `acme`, schema.TypeTelegram), ErrChannelCredentialNotFound)
+}
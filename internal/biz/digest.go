@@ -0,0 +1,191 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/conf"
+	"notifications/internal/pkg/logger"
+	"notifications/internal/pkg/metrics"
+
+	"github.com/AlekSi/pointer"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	metricFlushSuccess = `biz.digest.flush.success`
+	metricFlushFailure = `biz.digest.flush.failure`
+	metricFlushTimings = `biz.digest.flush.timings`
+
+	metricFlushDigests  = `biz.digest.flush.digests`
+	metricFlushReleased = `biz.digest.flush.released`
+)
+
+// DigestUsecase merges held notifications of the same digest into digest notifications
+type DigestUsecase struct {
+	notifications NotificationRepo
+	digests       *Digests
+	metric        metrics.Metrics
+	logs          logger.Logger
+	now           func() time.Time
+}
+
+func NewDigestUsecase(
+	notifications NotificationRepo,
+	c *conf.Biz,
+	metric metrics.Metrics,
+	logs log.Logger,
+) *DigestUsecase {
+	return &DigestUsecase{
+		notifications: notifications,
+		digests:       NewDigests(c.GetDigest(), NewLocales(c.GetLocale())),
+		metric:        metric,
+		logs:          logger.NewHelper(logs, "ts", log.DefaultTimestamp, "scope", "biz-digest"),
+		now:           time.Now,
+	}
+}
+
+// Flush creates pending digest notifications of held notifications with passed flush time and links
+// originals to their digests. Held notifications are locked in the same transaction, so every notification
+// is merged once by any of workers. Notifications are released to be sent separately if digest is not rendered.
+// Limit bounds due notifications selecting digests, every selected digest merges all of its due notifications
+func (uc *DigestUsecase) Flush(ctx context.Context, limit int) (int, error) {
+	defer uc.metric.NewTiming().Send(metricFlushTimings)
+	flushed := 0
+	released := 0
+	err := uc.notifications.Transaction(
+		ctx, nil, func(ctx context.Context) error {
+			now := uc.now()
+			due, err := uc.notifications.ListDueHeldWithLock(ctx, now, limit)
+			if err != nil {
+				return err
+			}
+			for _, group := range GroupDigests(due) {
+				if group, err = uc.completeDigest(ctx, group, now); err != nil {
+					return err
+				}
+				flushed += len(group)
+				payload, err := uc.digests.Render(group)
+				if err != nil {
+					uc.logs.WithContext(ctx).Errorf(`failed to render digest, notifications are sent separately: %v`, err)
+					if err = uc.release(ctx, group); err != nil {
+						return err
+					}
+					released += len(group)
+					continue
+				}
+				digest, err := uc.notifications.Create(ctx, notificationOfDigest(group, payload, now))
+				if err != nil {
+					return fmt.Errorf(`failed to create digest %s: %w`, group[0].DigestKey, err)
+				}
+				for _, notification := range group {
+					notification.Status = schema.StatusDigested
+					notification.DigestID = pointer.ToInt(digest.ID)
+					if _, err = uc.notifications.Update(ctx, notification); err != nil {
+						return fmt.Errorf(`failed to link notification %d to digest: %w`, notification.ID, err)
+					}
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		uc.metric.Increment(metricFlushFailure)
+		uc.logs.WithContext(ctx).Errorf("failed to flush digests: %v", err)
+		return 0, err
+	}
+	uc.metric.Increment(metricFlushSuccess)
+	uc.metric.Count(metricFlushDigests, flushed-released)
+	uc.metric.Count(metricFlushReleased, released)
+	return flushed, nil
+}
+
+// completeDigest adds due notifications of digest beyond limit of selected notifications, ordered by id
+func (uc *DigestUsecase) completeDigest(ctx context.Context, group []*ent.Notification, now time.Time) (
+	[]*ent.Notification,
+	error,
+) {
+	first := group[0]
+	held, err := uc.notifications.ListDueHeldOfDigestWithLock(ctx, first.SenderID, first.DigestKey, now)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to list notifications of digest %s: %w`, first.DigestKey, err)
+	}
+	selected := make(map[int]bool, len(group))
+	for _, notification := range group {
+		selected[notification.ID] = true
+	}
+	for _, notification := range held {
+		if !selected[notification.ID] && IsSameDigest(first, notification) {
+			group = append(group, notification)
+		}
+	}
+	sort.SliceStable(
+		group, func(i, j int) bool {
+			return group[i].ID < group[j].ID
+		},
+	)
+	return group, nil
+}
+
+// release moves held notifications to queue to be sent without digest
+func (uc *DigestUsecase) release(ctx context.Context, notifications []*ent.Notification) error {
+	for _, notification := range notifications {
+		notification.Status = schema.StatusPending
+		if _, err := uc.notifications.Update(ctx, notification); err != nil {
+			return fmt.Errorf(`failed to release notification %d: %w`, notification.ID, err)
+		}
+	}
+	return nil
+}
+
+// GroupDigests splits held notifications into digests keeping order of notifications
+func GroupDigests(notifications []*ent.Notification) [][]*ent.Notification {
+	groups := [][]*ent.Notification{}
+	for _, notification := range notifications {
+		found := false
+		for i, group := range groups {
+			if IsSameDigest(group[0], notification) {
+				groups[i] = append(group, notification)
+				found = true
+				break
+			}
+		}
+		if !found {
+			groups = append(groups, []*ent.Notification{notification})
+		}
+	}
+	return groups
+}
+
+// notificationOfDigest inherits options of digest from the first notification, highest priority and longest ttl
+func notificationOfDigest(group []*ent.Notification, payload schema.Payload, now time.Time) *ent.Notification {
+	first := group[0]
+	digest := &ent.Notification{
+		SenderID:       first.SenderID,
+		Tenant:         first.Tenant,
+		Type:           first.Type,
+		Payload:        payload,
+		TTL:            first.TTL,
+		Priority:       first.Priority,
+		RetryPolicy:    first.RetryPolicy,
+		Category:       first.Category,
+		DeliveryWindow: first.DeliveryWindow,
+		Locale:         first.Locale,
+		Status:         schema.StatusPending,
+		PlannedAt:      now,
+		TraceContext:   first.TraceContext,
+	}
+	for _, notification := range group[1:] {
+		if notification.Priority > digest.Priority {
+			digest.Priority = notification.Priority
+		}
+		if notification.TTL > digest.TTL {
+			digest.TTL = notification.TTL
+		}
+	}
+	return digest
+}
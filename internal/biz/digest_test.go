@@ -0,0 +1,321 @@
+package biz
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"testing"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/conf"
+	"notifications/internal/pkg/metrics"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/require"
+)
+
+type heldNotificationRepoStub struct {
+	NotificationRepo
+	held    []*ent.Notification
+	created []*ent.Notification
+	updated []*ent.Notification
+}
+
+func (r *heldNotificationRepoStub) ListDueHeldWithLock(_ context.Context, now time.Time, limit int) (
+	[]*ent.Notification,
+	error,
+) {
+	due := []*ent.Notification{}
+	for _, n := range r.held {
+		if n.Status == schema.StatusHeld && !n.PlannedAt.After(now) && len(due) < limit {
+			due = append(due, n)
+		}
+	}
+	return due, nil
+}
+
+func (r *heldNotificationRepoStub) ListDueHeldOfDigestWithLock(
+	_ context.Context,
+	senderID int,
+	digestKey string,
+	now time.Time,
+) (
+	[]*ent.Notification,
+	error,
+) {
+	due := []*ent.Notification{}
+	for _, n := range r.held {
+		if n.Status == schema.StatusHeld && n.SenderID == senderID && n.DigestKey == digestKey && !n.PlannedAt.After(now) {
+			due = append(due, n)
+		}
+	}
+	return due, nil
+}
+
+func (r *heldNotificationRepoStub) Create(_ context.Context, n *ent.Notification) (*ent.Notification, error) {
+	n.ID = 100 + len(r.created)
+	r.created = append(r.created, n)
+	return n, nil
+}
+
+func (r *heldNotificationRepoStub) Update(_ context.Context, n *ent.Notification) (*ent.Notification, error) {
+	r.updated = append(r.updated, n)
+	return n, nil
+}
+
+func (r *heldNotificationRepoStub) Transaction(
+	ctx context.Context,
+	_ *sql.TxOptions,
+	actions ...func(repoCtx context.Context) error,
+) error {
+	for _, action := range actions {
+		if err := action(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestDigests_Render(t *testing.T) {
+	digests := NewDigests(
+		&conf.Biz_Digest{
+			Keys: map[string]*conf.Biz_Digest_Template{
+				`comments`: {
+					Fields: map[string]string{`subject`: `{{ .count }} new comments`},
+					Locales: map[string]*conf.Biz_Digest_Template_Localized{
+						`ru`: {Fields: map[string]string{`subject`: `{{ .count }} {{ plural .count "комментарий" "комментария" "комментариев" }}`}},
+					},
+				},
+				`broken`: {Fields: map[string]string{`text`: `{{ range .items }}{{ .unknown }}{{ end }}`}},
+			},
+		},
+		NewLocales(&conf.Biz_Locale{Fallbacks: map[string]string{`kk`: `ru`}}),
+	)
+
+	testCases := []struct {
+		name          string
+		notifications []*ent.Notification
+		expected      schema.Payload
+		err           bool
+	}{
+		{
+			name: "email",
+			notifications: []*ent.Notification{
+				{
+					Type:      schema.TypeEmail,
+					DigestKey: `orders`,
+					Payload:   schema.Payload{`to`: `a@example.com`, `subject`: `first`, `body`: `one`, `is_html`: `false`},
+				},
+				{
+					Type:      schema.TypeEmail,
+					DigestKey: `orders`,
+					Payload:   schema.Payload{`to`: `a@example.com`, `subject`: `second`, `body`: `two`},
+				},
+			},
+			expected: schema.Payload{
+				`to`:      `a@example.com`,
+				`subject`: `2 new notifications`,
+				`body`:    "first\n\none\n\nsecond\n\ntwo",
+				`is_html`: `false`,
+			},
+		},
+		{
+			name: "template-of-key",
+			notifications: []*ent.Notification{
+				{
+					Type:      schema.TypeEmail,
+					DigestKey: `comments`,
+					Payload:   schema.Payload{`to`: `a@example.com`, `subject`: `first`, `body`: `one`},
+				},
+			},
+			expected: schema.Payload{
+				`to`:      `a@example.com`,
+				`subject`: `1 new comments`,
+				`body`:    "first\n\none",
+			},
+		},
+		{
+			name: "locale",
+			notifications: []*ent.Notification{
+				{
+					Type:    schema.TypeEmail,
+					Locale:  `ru-RU`,
+					Payload: schema.Payload{`to`: `a@example.com`, `subject`: `first`, `body`: `one`},
+				},
+			},
+			expected: schema.Payload{
+				`to`:      `a@example.com`,
+				`subject`: `1 новое уведомление`,
+				`body`:    "first\n\none",
+			},
+		},
+		{
+			name: "fallback-of-locale",
+			notifications: []*ent.Notification{
+				{
+					Type:      schema.TypeEmail,
+					Locale:    `kk`,
+					DigestKey: `comments`,
+					Payload:   schema.Payload{`to`: `a@example.com`, `subject`: `first`, `body`: `one`},
+				},
+			},
+			expected: schema.Payload{
+				`to`:      `a@example.com`,
+				`subject`: `1 комментарий`,
+				`body`:    "first\n\none",
+			},
+		},
+		{
+			name: "sms",
+			notifications: []*ent.Notification{
+				{Type: schema.TypeSMS, Payload: schema.Payload{`phone`: `79009009090`, `text`: `one`}},
+				{Type: schema.TypeSMS, Payload: schema.Payload{`phone`: `79009009090`, `text`: `two`}},
+			},
+			expected: schema.Payload{`phone`: `79009009090`, `text`: "one\ntwo"},
+		},
+		{
+			name: "broken-template",
+			notifications: []*ent.Notification{
+				{Type: schema.TypeSMS, DigestKey: `broken`, Payload: schema.Payload{`phone`: `79009009090`, `text`: `one`}},
+			},
+			err: true,
+		},
+		{
+			name: "empty",
+			err:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				payload, err := digests.Render(testCase.notifications)
+				if testCase.err {
+					require.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+				require.Equal(t, testCase.expected, payload)
+			},
+		)
+	}
+}
+
+func TestGroupDigests(t *testing.T) {
+	notifications := []*ent.Notification{
+		{ID: 1, SenderID: 1, Type: schema.TypeEmail, DigestKey: `a`, Payload: schema.Payload{`to`: `x@example.com`}},
+		{ID: 2, SenderID: 1, Type: schema.TypeEmail, DigestKey: `b`, Payload: schema.Payload{`to`: `x@example.com`}},
+		{ID: 3, SenderID: 1, Type: schema.TypeEmail, DigestKey: `a`, Payload: schema.Payload{`to`: ` X@Example.com`}},
+		{ID: 4, SenderID: 2, Type: schema.TypeEmail, DigestKey: `a`, Payload: schema.Payload{`to`: `x@example.com`}},
+		{ID: 5, SenderID: 1, Type: schema.TypeEmail, DigestKey: `a`, Payload: schema.Payload{`to`: `y@example.com`}},
+	}
+
+	groups := GroupDigests(notifications)
+	ids := [][]int{}
+	for _, group := range groups {
+		groupIDs := []int{}
+		for _, notification := range group {
+			groupIDs = append(groupIDs, notification.ID)
+		}
+		ids = append(ids, groupIDs)
+	}
+	require.Equal(t, [][]int{{1, 3}, {2}, {4}, {5}}, ids)
+}
+
+func TestDigestUsecase_Flush(t *testing.T) {
+	now := time.Date(2022, 9, 1, 12, 30, 0, 0, time.UTC)
+	flushAt := now.Add(-time.Minute)
+	notifications := &heldNotificationRepoStub{
+		held: []*ent.Notification{
+			{
+				ID: 1, SenderID: 1, Type: schema.TypeSMS, DigestKey: `a`, Status: schema.StatusHeld, PlannedAt: flushAt,
+				TTL: 60, Payload: schema.Payload{`phone`: `79009009090`, `text`: `one`},
+			},
+			{
+				ID: 2, SenderID: 1, Type: schema.TypeSMS, DigestKey: `a`, Status: schema.StatusHeld, PlannedAt: flushAt,
+				TTL: 120, Priority: 5, Payload: schema.Payload{`phone`: `79009009090`, `text`: `two`},
+			},
+			{
+				ID: 3, SenderID: 1, Type: schema.TypeSMS, DigestKey: `a`, Status: schema.StatusHeld,
+				PlannedAt: now.Add(time.Minute), Payload: schema.Payload{`phone`: `79009009091`, `text`: `later`},
+			},
+			{
+				ID: 4, SenderID: 1, Type: schema.TypeSMS, DigestKey: `broken`, Status: schema.StatusHeld, PlannedAt: flushAt,
+				Payload: schema.Payload{`phone`: `79009009092`, `text`: `three`},
+			},
+		},
+	}
+	metric, err := metrics.New(``, `test`, true)
+	require.NoError(t, err)
+
+	uc := NewDigestUsecase(
+		notifications,
+		&conf.Biz{
+			Digest: &conf.Biz_Digest{
+				Keys: map[string]*conf.Biz_Digest_Template{
+					`broken`: {Fields: map[string]string{`text`: `{{ .unknown }}`}},
+				},
+			},
+		},
+		metric,
+		log.NewFilter(log.DefaultLogger, log.FilterLevel(log.LevelFatal)),
+	)
+	uc.now = func() time.Time { return now }
+
+	count, err := uc.Flush(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+
+	require.Len(t, notifications.created, 1)
+	digest := notifications.created[0]
+	require.Equal(t, schema.StatusPending, digest.Status)
+	require.Equal(t, "one\ntwo", digest.Payload[`text`])
+	require.Equal(t, 120, digest.TTL)
+	require.Equal(t, 5, digest.Priority)
+	require.True(t, now.Equal(digest.PlannedAt))
+
+	require.Len(t, notifications.updated, 3)
+	require.Equal(t, schema.StatusPending, notifications.held[3].Status)
+	for _, original := range notifications.held[:2] {
+		require.Equal(t, schema.StatusDigested, original.Status)
+		require.Equal(t, digest.ID, *original.DigestID)
+	}
+	require.Equal(t, schema.StatusHeld, notifications.held[2].Status)
+}
+
+func TestDigestUsecase_FlushBeyondLimit(t *testing.T) {
+	now := time.Date(2022, 9, 1, 12, 30, 0, 0, time.UTC)
+	flushAt := now.Add(-time.Minute)
+	heldOf := func(id int, phone string) *ent.Notification {
+		return &ent.Notification{
+			ID: id, SenderID: 1, Type: schema.TypeSMS, DigestKey: `a`, Status: schema.StatusHeld, PlannedAt: flushAt,
+			Payload: schema.Payload{`phone`: phone, `text`: strconv.Itoa(id)},
+		}
+	}
+	notifications := &heldNotificationRepoStub{
+		held: []*ent.Notification{
+			heldOf(1, `79009009090`),
+			heldOf(2, `79009009091`),
+			heldOf(3, `79009009090`),
+			heldOf(4, `79009009090`),
+		},
+	}
+	metric, err := metrics.New(``, `test`, true)
+	require.NoError(t, err)
+	uc := NewDigestUsecase(
+		notifications,
+		&conf.Biz{},
+		metric,
+		log.NewFilter(log.DefaultLogger, log.FilterLevel(log.LevelFatal)),
+	)
+	uc.now = func() time.Time { return now }
+
+	count, err := uc.Flush(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, 3, count, `digest of selected notification merges its notifications beyond limit`)
+	require.Len(t, notifications.created, 1)
+	require.Equal(t, "1\n3\n4", notifications.created[0].Payload[`text`])
+	require.Equal(t, schema.StatusHeld, notifications.held[1].Status, `digest of other recipient waits next flush`)
+}
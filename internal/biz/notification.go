@@ -10,12 +10,17 @@ import (
 
 	"github.com/AlekSi/pointer"
 	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	v1 "notifications/api/notification/v1"
 	"notifications/ent"
 	"notifications/ent/schema"
+	"notifications/internal/conf"
 	"notifications/internal/pkg/logger"
 	"notifications/internal/pkg/metrics"
+	"notifications/internal/pkg/ratelimit"
+	"notifications/internal/pkg/tracing"
 	"notifications/internal/senders"
 )
 
@@ -34,6 +39,11 @@ const (
 	metricProcessNotificationsFailure = `biz.notification.processNotifications.failure`
 	metricProcessNotificationsTimings = `biz.notification.processNotifications.timings`
 
+	metricProcessNotificationsThrottled  = `biz.notification.processNotifications.throttled`
+	metricProcessNotificationsReclaimed  = `biz.notification.processNotifications.reclaimed`
+	metricProcessNotificationsDeferred   = `biz.notification.processNotifications.deferred`
+	metricProcessNotificationsSuppressed = `biz.notification.processNotifications.suppressed`
+
 	metricSendNotificationSuccess = `biz.notification.sendNotification.success`
 	metricSendNotificationFailure = `biz.notification.sendNotification.failure`
 	metricSendNotificationTimings = `biz.notification.sendNotification.timings`
@@ -46,6 +56,15 @@ const (
 	metricEnqueueNotificationFailure = `biz.notification.enqueueNotification.failure`
 	metricEnqueueNotificationTimings = `biz.notification.enqueueNotification.timings`
 
+	metricSendNotificationAndSaveToRepoDeferred = `biz.notification.sendNotificationAndSaveToRepo.deferred`
+	metricEnqueueNotificationDeferred           = `biz.notification.enqueueNotification.deferred`
+
+	metricUsageSuccess = `biz.notification.usage.success`
+	metricUsageFailure = `biz.notification.usage.failure`
+	metricUsageTimings = `biz.notification.usage.timings`
+
+	metricQuotaExceeded = `biz.notification.quotaExceeded`
+
 	metricProcessEmailNotificationSuccess = `biz.notification.processEmailNotification.success`
 	metricProcessEmailNotificationFailure = `biz.notification.processEmailNotification.failure`
 	metricProcessEmailNotificationTimings = `biz.notification.processEmailNotification.timings`
@@ -61,12 +80,23 @@ const (
 	metricProcessSMSNotificationSuccess = `biz.notification.processSmsNotification.success`
 	metricProcessSMSNotificationFailure = `biz.notification.processSmsNotification.failure`
 	metricProcessSMSNotificationTimings = `biz.notification.processSmsNotification.timings`
+
+	spanProcessNotification = `biz.notification.processNotification`
 )
 
 var (
 	ErrNotificationNotFound = errors.New(`notification not found`)
 )
 
+// Transactor runs actions in one transaction, repositories called with context of action use the transaction
+type Transactor interface {
+	Transaction(
+		ctx context.Context,
+		txOptions *databaseSql.TxOptions,
+		actions ...func(repoCtx context.Context) error,
+	) error
+}
+
 type NotificationRepo interface {
 	Create(context.Context, *ent.Notification) (*ent.Notification, error)
 
@@ -76,55 +106,114 @@ type NotificationRepo interface {
 
 	DeleteByID(ctx context.Context, id int) error
 
-	ListWaitingNotificationsWithLock(ctx context.Context, limit int) (
+	ListWaitingNotificationsWithLock(
+		ctx context.Context,
+		limit int,
+		types []schema.NotificationType,
+		priorities PriorityRange,
+	) (
 		[]*ent.Notification,
 		error,
 	)
 
-	Transaction(
-		ctx context.Context,
-		txOptions *databaseSql.TxOptions,
-		actions ...func(repoCtx context.Context) error,
-	) error
+	Transactor
 
-	CountWaitingNotifications(ctx context.Context) (int, error)
+	CountWaitingNotifications(ctx context.Context, types []schema.NotificationType) (int, error)
+
+	QueueDepth(ctx context.Context, statuses []schema.NotificationStatus) ([]*QueueDepth, error)
+
+	// FindDuplicate returns the first not suppressed notification of sender and tenant with dedup key created since time
+	FindDuplicate(ctx context.Context, senderID int, tenant, dedupKey string, since time.Time) (*ent.Notification, error)
+
+	// ListHeld returns held notifications of sender with digest key
+	ListHeld(ctx context.Context, senderID int, digestKey string) ([]*ent.Notification, error)
+
+	// ListDueHeldWithLock returns held notifications with planned time before now locked until end of transaction
+	ListDueHeldWithLock(ctx context.Context, now time.Time, limit int) ([]*ent.Notification, error)
+
+	// ListDueHeldOfDigestWithLock returns held notifications of sender with digest key and planned time before now
+	// locked until end of transaction
+	ListDueHeldOfDigestWithLock(ctx context.Context, senderID int, digestKey string, now time.Time) (
+		[]*ent.Notification,
+		error,
+	)
+}
+
+// NotificationListener delivers types of notifications that became ready to send, empty type means any type
+type NotificationListener interface {
+	Listen(ctx context.Context) (<-chan schema.NotificationType, error)
 }
 
 type NotificationUsecase struct {
-	repo    NotificationRepo
-	senders *senders.Senders
-	metric  metrics.Metrics
-	logs    logger.Logger
+	repo         NotificationRepo
+	senders      *senders.Senders
+	quotas       *Quotas
+	retries      *RetryPolicies
+	throttle     *Throttle
+	queue        *Queue
+	windows      *DeliveryWindows
+	dedup        *Dedup
+	suppressions *Suppressions
+	digests      *Digests
+	metric       metrics.Metrics
+	logs         logger.Logger
 }
 
 type NotificationInDTO struct { // TODO REVIEW FOR DEPENDENCIES
-	SendType  v1.Type
-	SenderID  int64
-	Payload   *schema.Payload
-	TTL       int
-	PlannedAt *time.Time
+	SendType     v1.Type
+	SenderID     int64
+	Tenant       string
+	Payload      *schema.Payload
+	TTL          int
+	PlannedAt    *time.Time
+	RetryPolicy  *schema.RetryPolicy
+	Priority     int
+	Category     string
+	Window       *schema.DeliveryWindow
+	DedupWindow  time.Duration
+	DigestKey    string
+	DigestWindow time.Duration
+	Locale       string
+	ScheduleID   *int       // schedule of materialized occurrence
+	OccurrenceAt *time.Time // occurrence of schedule, notification is unique for schedule and occurrence
 }
 
 type NotificationOutDTO struct {
-	ID   int64
-	Sent bool
+	ID         int64
+	Sent       bool
+	Suppressed bool  // notification is saved as suppressed and will not be sent
+	OriginalID int64 // original notification of suppressed duplicate
+	Held       bool  // notification is held to be merged into digest
 }
 
 func NewNotificationUsecase(
 	repo NotificationRepo,
+	quotas QuotaRepo,
+	suppressions SuppressionRepo,
+	limiter ratelimit.Limiter,
 	senders *senders.Senders,
+	c *conf.Biz,
 	metric metrics.Metrics,
 	logs log.Logger,
 ) *NotificationUsecase {
 	return &NotificationUsecase{
-		repo:    repo,
-		senders: senders,
-		metric:  metric,
-		logs:    logger.NewHelper(logs, "ts", log.DefaultTimestamp, "scope", "biz-notification"),
+		repo:         repo,
+		senders:      senders,
+		quotas:       NewQuotas(quotas),
+		retries:      NewRetryPolicies(c.GetRetry()),
+		throttle:     NewThrottle(limiter, c.GetRateLimit()),
+		queue:        NewQueue(repo, c.GetQueue(), metric),
+		windows:      NewDeliveryWindows(c.GetDelivery()),
+		dedup:        NewDedup(c.GetDedup()),
+		suppressions: NewSuppressions(suppressions, c.GetSuppression()),
+		digests:      NewDigests(c.GetDigest(), NewLocales(c.GetLocale())),
+		metric:       metric,
+		logs:         logger.NewHelper(logs, "ts", log.DefaultTimestamp, "scope", "biz-notification"),
 	}
 }
 
-func (uc *NotificationUsecase) CheckStatus(ctx context.Context, notificationID int64) (
+// CheckStatus returns status of notification of sender, notification of other sender or tenant is not found
+func (uc *NotificationUsecase) CheckStatus(ctx context.Context, notificationID int64, senderID int, tenant string) (
 	*schema.NotificationStatus,
 	error,
 ) {
@@ -138,15 +227,22 @@ func (uc *NotificationUsecase) CheckStatus(ctx context.Context, notificationID i
 		uc.metric.Increment(metricFindByIDSuccess)
 		uc.logs.WithContext(ctx).Info("successfully check notification status")
 	}
-	if ent.IsNotFound(err) {
+	if ent.IsNotFound(err) || (err == nil && (notification.SenderID != senderID || notification.Tenant != tenant)) {
 		return nil, ErrNotificationNotFound
 	}
-	return &notification.Status, err
+	if err != nil {
+		return nil, err
+	}
+	return &notification.Status, nil
 }
 
-func (uc *NotificationUsecase) CountOfPendingNotifications(ctx context.Context) (int, error) {
+// CountOfPendingNotifications returns count of notifications waiting for send, all types if types are empty
+func (uc *NotificationUsecase) CountOfPendingNotifications(
+	ctx context.Context,
+	types ...schema.NotificationType,
+) (int, error) {
 	defer uc.metric.NewTiming().Send(metricCountOfPendingNotificationsTimings)
-	cnt, err := uc.repo.CountWaitingNotifications(ctx)
+	cnt, err := uc.repo.CountWaitingNotifications(ctx, types)
 	if err != nil {
 		uc.metric.Increment(metricCountOfPendingNotificationsFailure)
 		uc.logs.WithContext(ctx).Errorf("failed to count of pending notifications: %v", err)
@@ -157,65 +253,45 @@ func (uc *NotificationUsecase) CountOfPendingNotifications(ctx context.Context)
 	return cnt, err
 }
 
-// ProcessNotifications concurrency-safe notification processing
-func (uc *NotificationUsecase) ProcessNotifications(ctx context.Context, limit int) (int64, int64, error) {
+// ProcessNotifications claims batch of waiting notifications, sends them outside of transaction
+// and records result of every notification separately. Only notifications of types are processed if types are set
+func (uc *NotificationUsecase) ProcessNotifications(
+	ctx context.Context,
+	limit int,
+	types ...schema.NotificationType,
+) (int64, int64, error) {
 	defer uc.metric.NewTiming().Send(metricProcessNotificationsTimings)
 	found := int64(0)
 	processed := int64(0)
-	transactionOptions := &databaseSql.TxOptions{
-		Isolation: databaseSql.LevelReadCommitted,
-		ReadOnly:  false,
-	}
 
-	transaction := func(repoCtx context.Context) error {
-		list, err := uc.repo.ListWaitingNotificationsWithLock(repoCtx, limit)
-		if err != nil {
-			return err
+	claimed, err := uc.queue.Claim(ctx, limit, types)
+	if err != nil {
+		uc.metric.Increment(metricProcessNotificationsFailure)
+		uc.logs.WithContext(ctx).Errorf("failed to claim notifications: %v", err)
+		return found, processed, err
+	}
+	found = int64(len(claimed))
+
+	failed := 0
+	for _, notification := range claimed {
+		if recordErr := uc.processClaimedNotification(ctx, notification); recordErr != nil {
+			// Notification will be reclaimed by any worker after expiration of its lease
+			uc.logs.WithContext(ctx).Errorf(
+				`failed to record result of notification with id %d: %v`,
+				notification.ID,
+				recordErr,
+			)
+			failed++
+			err = recordErr
+			continue
 		}
-		found = int64(len(list))
-
-		for _, notification := range list {
-			dto := transformNotificationModelToInDTO(notification)
-			err = uc.SendNotificationWithoutSaving(ctx, dto)
-			if err == nil {
-				notification.Status = schema.StatusSent
-				notification.SentAt = pointer.ToTime(time.Now())
-				processed++
-			} else {
-				uc.logs.WithContext(ctx).Warnf(
-					`unsuccessful attempt to send notification with id %d: %v`,
-					notification.ID,
-					err,
-				)
-
-				notification.Status = schema.StatusRetry
-				notification.Retries++
-				notification.RetryAt = pointer.ToTime(time.Now().Add(RetryInterval))
-
-				timeFrom := notification.PlannedAt
-				if notification.RetryAt != nil {
-					timeFrom = *notification.RetryAt
-				}
-
-				live := timeFrom.Sub(notification.PlannedAt)
-				timeToLive := time.Duration(notification.TTL) * time.Second
-
-				if live > timeToLive {
-					uc.logs.WithContext(ctx).Errorf(`failed to send notification with id %d: %v`, notification.ID, err)
-					notification.Status = schema.StatusFail
-				}
-			}
-			if _, err = uc.repo.Update(repoCtx, notification); err != nil {
-				processed = 0
-				return err
-			}
+		if notification.Status == schema.StatusSent {
+			processed++
 		}
-
-		return nil
 	}
 
-	err := uc.repo.Transaction(ctx, transactionOptions, transaction)
-	if err != nil {
+	if failed > 0 {
+		err = fmt.Errorf(`failed to record results of %d notifications: %w`, failed, err)
 		uc.metric.Increment(metricProcessNotificationsFailure)
 		uc.logs.WithContext(ctx).Errorf("failed to process notifications: %v", err)
 	} else {
@@ -225,6 +301,110 @@ func (uc *NotificationUsecase) ProcessNotifications(ctx context.Context, limit i
 	return found, processed, err
 }
 
+// processClaimedNotification sends claimed notification and releases it with new status
+func (uc *NotificationUsecase) processClaimedNotification(
+	ctx context.Context,
+	notification *ent.Notification,
+) (err error) {
+	// Trace of request enqueued notification is continued by worker
+	ctx, span := tracing.Start(
+		tracing.Extract(ctx, notification.TraceContext),
+		spanProcessNotification,
+		trace.SpanKindConsumer,
+		attribute.Int(`notification.id`, notification.ID),
+		attribute.String(`notification.type`, notification.Type.String()),
+		attribute.Int(`notification.retries`, notification.Retries),
+	)
+	defer func() {
+		span.SetAttributes(attribute.String(`notification.status`, notification.Status.String()))
+		tracing.End(span, err)
+	}()
+
+	notification.LeaseUntil = nil
+	notification.WorkerID = nil
+
+	suppression, err := uc.suppressions.Find(ctx, notification, time.Now())
+	if err != nil {
+		// Notification will be reclaimed by any worker after expiration of its lease
+		return err
+	}
+	if suppression != nil {
+		// Recipient opted out after notification was enqueued
+		uc.metric.Increment(metricProcessNotificationsSuppressed)
+		notification.Status = schema.StatusSuppressed
+		notification.RetryAt = nil
+		_, err = uc.repo.Update(ctx, notification)
+		return err
+	}
+
+	if next := uc.deferredTo(ctx, notification, time.Now()); next != nil {
+		// Notification is outside of its delivery window: move it to the next window without spending its attempt
+		uc.metric.Increment(metricProcessNotificationsDeferred)
+		notification.Status = schema.StatusPending
+		if notification.Retries > 0 {
+			notification.Status = schema.StatusRetry
+		}
+		notification.PlannedAt = *next
+		notification.RetryAt = nil
+		_, err = uc.repo.Update(ctx, notification)
+		return err
+	}
+
+	delay, err := uc.throttle.Delay(ctx, notification)
+	if err != nil {
+		// Notification is not sent over limit of channel, it will be reclaimed by any worker after expiration
+		// of its lease as if its result was not recorded
+		return fmt.Errorf(`failed to check rate limit: %w`, err)
+	}
+	if delay > 0 {
+		// Budget of channel is exhausted: postpone notification without spending its attempt
+		uc.metric.Increment(metricProcessNotificationsThrottled)
+		notification.Status = schema.StatusPending
+		if notification.Retries > 0 {
+			notification.Status = schema.StatusRetry
+		}
+		notification.RetryAt = pointer.ToTime(time.Now().Add(delay))
+		_, err = uc.repo.Update(ctx, notification)
+		return err
+	}
+
+	dto := transformNotificationModelToInDTO(notification)
+	err = uc.SendNotificationWithoutSaving(ctx, dto)
+	if err == nil {
+		notification.Status = schema.StatusSent
+		notification.SentAt = pointer.ToTime(time.Now())
+	} else {
+		uc.logs.WithContext(ctx).Warnf(
+			`unsuccessful attempt to send notification with id %d: %v`,
+			notification.ID,
+			err,
+		)
+
+		policy := uc.retries.For(notification)
+		notification.Status = schema.StatusRetry
+		notification.Retries++
+		notification.RetryAt = pointer.ToTime(time.Now().Add(uc.retries.Delay(policy, notification.Retries)))
+
+		timeFrom := notification.PlannedAt
+		if notification.RetryAt != nil {
+			timeFrom = *notification.RetryAt
+		}
+
+		live := timeFrom.Sub(notification.PlannedAt)
+		timeToLive := time.Duration(notification.TTL) * time.Second
+
+		if live > timeToLive || uc.retries.IsExhausted(policy, notification.Retries) {
+			uc.logs.WithContext(ctx).Errorf(`failed to send notification with id %d: %v`, notification.ID, err)
+			notification.Status = schema.StatusFail
+		}
+	}
+	_, err = uc.repo.Update(ctx, notification)
+	if err == nil {
+		uc.reportDelivery(notification)
+	}
+	return err
+}
+
 func (uc *NotificationUsecase) SendNotificationWithoutSaving(ctx context.Context, dto *NotificationInDTO) error {
 	defer uc.metric.NewTiming().Send(metricSendNotificationTimings)
 
@@ -241,7 +421,7 @@ func (uc *NotificationUsecase) SendNotificationWithoutSaving(ctx context.Context
 	if !ok {
 		err = fmt.Errorf(`failed to send notification: unknown type '%s'`, dto.SendType.String())
 	} else {
-		err = processor(ctx, dto.Payload)
+		err = processor(ctx, dto.Tenant, dto.Payload)
 	}
 
 	if err != nil {
@@ -265,7 +445,41 @@ func (uc *NotificationUsecase) SendNotification(ctx context.Context, dto *Notifi
 		ID:   0,
 		Sent: false,
 	}
+	suppressed, err := uc.suppress(ctx, dto)
+	if err != nil {
+		uc.metric.Increment(metricSendNotificationAndSaveToRepoFailure)
+		uc.logs.WithContext(ctx).Errorf("failed to send notification and save to repo: %v", err)
+		return result, err
+	}
+	if suppressed != nil {
+		return suppressed, nil
+	}
+
 	plannedAt := time.Now()
+	err = uc.checkQuota(ctx, dto)
+	if err != nil {
+		uc.metric.Increment(metricSendNotificationAndSaveToRepoFailure)
+		uc.logs.WithContext(ctx).Errorf("failed to send notification and save to repo: %v", err)
+		return result, err
+	}
+
+	if dto.DigestKey != "" {
+		// Notification is merged into digest by worker instead of sending
+		held, err := uc.hold(ctx, dto, plannedAt)
+		if err != nil {
+			uc.metric.Increment(metricSendNotificationAndSaveToRepoFailure)
+			uc.logs.WithContext(ctx).Errorf("failed to hold notification for digest: %v", err)
+			return result, err
+		}
+		return held, nil
+	}
+
+	if next := uc.deferredTo(ctx, transformNotificationInDTOToModel(dto), plannedAt); next != nil {
+		// Notification is outside of its delivery window: it is enqueued to the next window instead of sending
+		uc.metric.Increment(metricSendNotificationAndSaveToRepoDeferred)
+		return uc.enqueue(ctx, dto, *next)
+	}
+
 	err = uc.SendNotificationWithoutSaving(ctx, dto)
 	if err == nil {
 		result.Sent = true
@@ -302,6 +516,47 @@ func (uc *NotificationUsecase) EnqueueNotification(ctx context.Context, dto *Not
 ) {
 	defer uc.metric.NewTiming().Send(metricEnqueueNotificationTimings)
 
+	suppressed, err := uc.suppress(ctx, dto)
+	if err != nil {
+		uc.metric.Increment(metricEnqueueNotificationFailure)
+		uc.logs.WithContext(ctx).Errorf("failed to enqueue notification: %v", err)
+		return &NotificationOutDTO{}, err
+	}
+	if suppressed != nil {
+		return suppressed, nil
+	}
+
+	if err := uc.checkQuota(ctx, dto); err != nil {
+		uc.metric.Increment(metricEnqueueNotificationFailure)
+		uc.logs.WithContext(ctx).Errorf("failed to enqueue notification: %v", err)
+		return &NotificationOutDTO{}, err
+	}
+
+	plannedAt := time.Now()
+	if dto.PlannedAt != nil {
+		plannedAt = *dto.PlannedAt
+	}
+	if dto.DigestKey != "" {
+		held, err := uc.hold(ctx, dto, plannedAt)
+		if err != nil {
+			uc.metric.Increment(metricEnqueueNotificationFailure)
+			uc.logs.WithContext(ctx).Errorf("failed to hold notification for digest: %v", err)
+			return &NotificationOutDTO{}, err
+		}
+		return held, nil
+	}
+	if next := uc.deferredTo(ctx, transformNotificationInDTOToModel(dto), plannedAt); next != nil {
+		uc.metric.Increment(metricEnqueueNotificationDeferred)
+		return uc.enqueue(ctx, dto, *next)
+	}
+	return uc.enqueue(ctx, dto, plannedAt)
+}
+
+// enqueue saves notification as pending to be sent by worker at plannedAt
+func (uc *NotificationUsecase) enqueue(ctx context.Context, dto *NotificationInDTO, plannedAt time.Time) (
+	*NotificationOutDTO,
+	error,
+) {
 	result := &NotificationOutDTO{
 		ID:   0,
 		Sent: false,
@@ -309,6 +564,8 @@ func (uc *NotificationUsecase) EnqueueNotification(ctx context.Context, dto *Not
 	model := transformNotificationInDTOToModel(
 		dto, func(notification *ent.Notification) {
 			notification.Status = schema.StatusPending
+			notification.PlannedAt = plannedAt
+			notification.TraceContext = tracing.Inject(ctx)
 		},
 	)
 	notification, err := uc.repo.Create(ctx, model)
@@ -325,9 +582,45 @@ func (uc *NotificationUsecase) EnqueueNotification(ctx context.Context, dto *Not
 	return result, err
 }
 
-type NotificationProcessor func(context.Context, *schema.Payload) error
+// Usage returns current usage of quotas configured for sender by notifications of tenant
+func (uc *NotificationUsecase) Usage(ctx context.Context, senderID int64, tenant string) ([]*QuotaUsage, error) {
+	defer uc.metric.NewTiming().Send(metricUsageTimings)
+	usages, err := uc.quotas.Usage(ctx, int(senderID), tenant)
+	if err != nil {
+		uc.metric.Increment(metricUsageFailure)
+		uc.logs.WithContext(ctx).Errorf("failed to get usage of sender %d: %v", senderID, err)
+	} else {
+		uc.metric.Increment(metricUsageSuccess)
+		uc.logs.WithContext(ctx).Infof("successfully got usage of sender %d", senderID)
+	}
+	return usages, err
+}
+
+// suppress saves notification as suppressed if its recipient is on suppression list or it is a duplicate
+func (uc *NotificationUsecase) suppress(ctx context.Context, dto *NotificationInDTO) (*NotificationOutDTO, error) {
+	suppressed, err := uc.suppressRecipient(ctx, dto)
+	if err != nil || suppressed != nil {
+		return suppressed, err
+	}
+	return uc.suppressDuplicate(ctx, dto)
+}
+
+func (uc *NotificationUsecase) checkQuota(ctx context.Context, dto *NotificationInDTO) error {
+	err := uc.quotas.Check(ctx, int(dto.SenderID), dto.Tenant, schema.NotificationType(dto.SendType.String()))
+	if errors.Is(err, ErrQuotaExceeded) {
+		uc.metric.Increment(metricQuotaExceeded)
+	}
+	return err
+}
+
+// NotificationProcessor sends payload by sender of tenant
+type NotificationProcessor func(ctx context.Context, tenant string, payload *schema.Payload) error
 
-func (uc *NotificationUsecase) ProcessEmailNotification(ctx context.Context, payload *schema.Payload) error {
+func (uc *NotificationUsecase) ProcessEmailNotification(
+	ctx context.Context,
+	tenant string,
+	payload *schema.Payload,
+) error {
 	defer uc.metric.NewTiming().Send(metricProcessEmailNotificationTimings)
 	var err error
 	defer func() {
@@ -346,15 +639,34 @@ func (uc *NotificationUsecase) ProcessEmailNotification(ctx context.Context, pay
 	if err = payloadEmail.Validate(); err != nil {
 		return err
 	}
-	send := uc.senders.EmailSender.SendText
+	var sender senders.EmailSender
+	sender, err = uc.senders.Email(ctx, tenant)
+	if err != nil {
+		return err
+	}
+	if isTrue(payloadEmail.IsHTML) && payloadEmail.Text != "" {
+		err = sender.SendHTMLWithText(
+			ctx,
+			[]string{payloadEmail.To},
+			payloadEmail.Subject,
+			payloadEmail.Body,
+			payloadEmail.Text,
+		)
+		return err
+	}
+	send := sender.SendText
 	if isTrue(payloadEmail.IsHTML) {
-		send = uc.senders.EmailSender.SendHTML
+		send = sender.SendHTML
 	}
 	err = send(ctx, []string{payloadEmail.To}, payloadEmail.Subject, payloadEmail.Body)
 	return err
 }
 
-func (uc *NotificationUsecase) ProcessPlainNotification(ctx context.Context, payload *schema.Payload) error {
+func (uc *NotificationUsecase) ProcessPlainNotification(
+	ctx context.Context,
+	tenant string,
+	payload *schema.Payload,
+) error {
 	defer uc.metric.NewTiming().Send(metricProcessPlainNotificationTimings)
 	var err error
 	defer func() {
@@ -378,7 +690,11 @@ func (uc *NotificationUsecase) ProcessPlainNotification(ctx context.Context, pay
 	return err
 }
 
-func (uc *NotificationUsecase) ProcessSMSNotification(ctx context.Context, payload *schema.Payload) error {
+func (uc *NotificationUsecase) ProcessSMSNotification(
+	ctx context.Context,
+	tenant string,
+	payload *schema.Payload,
+) error {
 	defer uc.metric.NewTiming().Send(metricProcessSMSNotificationTimings)
 	var err error
 	defer func() {
@@ -398,11 +714,20 @@ func (uc *NotificationUsecase) ProcessSMSNotification(ctx context.Context, paylo
 	if err = payloadSMS.Validate(); err != nil {
 		return err
 	}
-	err = uc.senders.SMSAeroSender.Send(ctx, payloadSMS.Phone, payloadSMS.Text)
+	var sender senders.SMSAeroSender
+	sender, err = uc.senders.SMSAero(ctx, tenant)
+	if err != nil {
+		return err
+	}
+	err = sender.Send(ctx, payloadSMS.Phone, payloadSMS.Text)
 	return err
 }
 
-func (uc *NotificationUsecase) ProcessTelegramNotification(ctx context.Context, payload *schema.Payload) error {
+func (uc *NotificationUsecase) ProcessTelegramNotification(
+	ctx context.Context,
+	tenant string,
+	payload *schema.Payload,
+) error {
 	defer uc.metric.NewTiming().Send(metricProcessTelegramNotificationTimings)
 	var err error
 	defer func() {
@@ -422,6 +747,11 @@ func (uc *NotificationUsecase) ProcessTelegramNotification(ctx context.Context,
 	if err = payloadTelegram.Validate(); err != nil {
 		return err
 	}
+	var sender senders.TelegramSender
+	sender, err = uc.senders.Telegram(ctx, tenant)
+	if err != nil {
+		return err
+	}
 	options := []senders.TelegramSenderOption{}
 	if payloadTelegram.ParseMode != "" {
 		options = append(options, senders.WithParseMode(payloadTelegram.ParseMode))
@@ -435,7 +765,7 @@ func (uc *NotificationUsecase) ProcessTelegramNotification(ctx context.Context,
 	if payloadTelegram.ProtectContent != "" {
 		options = append(options, senders.WithProtectContent(isTrue(payloadTelegram.ProtectContent)))
 	}
-	err = uc.senders.TelegramSender.Send(ctx, payloadTelegram.ChatID, payloadTelegram.Text, options...)
+	err = sender.Send(ctx, payloadTelegram.ChatID, payloadTelegram.Text, options...)
 	return err
 }
 
@@ -0,0 +1,102 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/conf"
+
+	"github.com/AlekSi/pointer"
+)
+
+const (
+	metricNotificationSuppressed = `biz.notification.suppressed`
+)
+
+// Dedup resolves window of suppressing duplicates: request override, then window of sender, then common window
+type Dedup struct {
+	common  time.Duration
+	senders map[int]time.Duration
+}
+
+func NewDedup(c *conf.Biz_Dedup) *Dedup {
+	dedup := &Dedup{
+		senders: map[int]time.Duration{},
+	}
+	if c == nil {
+		return dedup
+	}
+	dedup.common = c.GetWindow().AsDuration()
+	for senderID, window := range c.GetSenders() {
+		dedup.senders[int(senderID)] = window.AsDuration()
+	}
+	return dedup
+}
+
+// Window returns dedup window of notification, duplicates are not suppressed if it is zero
+func (d *Dedup) Window(dto *NotificationInDTO) time.Duration {
+	if dto.DedupWindow > 0 {
+		return dto.DedupWindow
+	}
+	if window, ok := d.senders[int(dto.SenderID)]; ok {
+		return window
+	}
+	return d.common
+}
+
+// DedupKey returns hash of type, recipient and payload of notification
+func DedupKey(notification *ent.Notification) string {
+	hash := sha256.New()
+	hash.Write([]byte(notification.Type.String()))
+	hash.Write([]byte{0})
+	hash.Write([]byte(notification.Payload.Recipient(notification.Type)))
+	hash.Write([]byte{0})
+	hash.Write([]byte(notification.Payload.String())) // keys of map are sorted by json encoding
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// suppressDuplicate saves notification as suppressed if sender of the same tenant created the same notification
+// within dedup window. Result is nil if notification is not a duplicate. Concurrent duplicates may be both accepted
+func (uc *NotificationUsecase) suppressDuplicate(ctx context.Context, dto *NotificationInDTO) (
+	*NotificationOutDTO,
+	error,
+) {
+	window := uc.dedup.Window(dto)
+	if window <= 0 {
+		return nil, nil
+	}
+	now := time.Now()
+	model := transformNotificationInDTOToModel(dto)
+	original, err := uc.repo.FindDuplicate(ctx, model.SenderID, model.Tenant, model.DedupKey, now.Add(-window))
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		// Notification is accepted if duplicates are unknown
+		uc.logs.WithContext(ctx).Warnf(`failed to find duplicate of notification: %v`, err)
+		return nil, nil
+	}
+
+	model.Status = schema.StatusSuppressed
+	model.PlannedAt = now
+	model.OriginalID = pointer.ToInt(original.ID)
+	suppressed, err := uc.repo.Create(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	uc.metric.Increment(metricNotificationSuppressed)
+	uc.logs.WithContext(ctx).Infof(
+		`notification with id %d is suppressed as duplicate of notification with id %d`,
+		suppressed.ID,
+		original.ID,
+	)
+	return &NotificationOutDTO{
+		ID:         int64(suppressed.ID),
+		Suppressed: true,
+		OriginalID: int64(original.ID),
+	}, nil
+}
@@ -0,0 +1,148 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "notifications/api/notification/v1"
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/conf"
+	"notifications/internal/pkg/metrics"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestDedup_Window(t *testing.T) {
+	dedup := NewDedup(
+		&conf.Biz_Dedup{
+			Window: durationpb.New(time.Minute),
+			Senders: map[int64]*durationpb.Duration{
+				7: durationpb.New(time.Hour),
+				8: durationpb.New(0),
+			},
+		},
+	)
+
+	testCases := []struct {
+		name     string
+		dto      *NotificationInDTO
+		expected time.Duration
+	}{
+		{
+			name:     "common",
+			dto:      &NotificationInDTO{SenderID: 1},
+			expected: time.Minute,
+		},
+		{
+			name:     "sender",
+			dto:      &NotificationInDTO{SenderID: 7},
+			expected: time.Hour,
+		},
+		{
+			name:     "sender-disabled",
+			dto:      &NotificationInDTO{SenderID: 8},
+			expected: 0,
+		},
+		{
+			name:     "request",
+			dto:      &NotificationInDTO{SenderID: 7, DedupWindow: 5 * time.Minute},
+			expected: 5 * time.Minute,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				require.Equal(t, testCase.expected, dedup.Window(testCase.dto))
+			},
+		)
+	}
+
+	require.Zero(t, NewDedup(nil).Window(&NotificationInDTO{SenderID: 1}))
+}
+
+func TestDedupKey(t *testing.T) {
+	notification := func(notificationType schema.NotificationType, payload schema.Payload) *ent.Notification {
+		return &ent.Notification{Type: notificationType, Payload: payload}
+	}
+	alert := schema.Payload{`to`: `ops@example.com`, `subject`: `Disk is full`, `body`: `/dev/sda1`}
+
+	require.Equal(
+		t,
+		DedupKey(notification(schema.TypeEmail, alert)),
+		DedupKey(notification(schema.TypeEmail, schema.Payload{`body`: `/dev/sda1`, `subject`: `Disk is full`, `to`: `ops@example.com`})),
+	)
+	require.NotEqual(
+		t,
+		DedupKey(notification(schema.TypeEmail, alert)),
+		DedupKey(notification(schema.TypeEmail, schema.Payload{`to`: `dev@example.com`, `subject`: `Disk is full`, `body`: `/dev/sda1`})),
+	)
+	require.NotEqual(
+		t,
+		DedupKey(notification(schema.TypeEmail, alert)),
+		DedupKey(notification(schema.TypePlain, alert)),
+	)
+}
+
+type duplicateRepoStub struct {
+	NotificationRepo
+	created []*ent.Notification
+}
+
+func (r *duplicateRepoStub) FindDuplicate(_ context.Context, senderID int, tenant, dedupKey string, _ time.Time) (
+	*ent.Notification,
+	error,
+) {
+	for _, n := range r.created {
+		if n.SenderID == senderID && n.Tenant == tenant && n.DedupKey == dedupKey && n.Status != schema.StatusSuppressed {
+			return n, nil
+		}
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+func (r *duplicateRepoStub) Create(_ context.Context, n *ent.Notification) (*ent.Notification, error) {
+	n.ID = len(r.created) + 1
+	r.created = append(r.created, n)
+	return n, nil
+}
+
+func TestNotificationUsecase_suppressDuplicate(t *testing.T) {
+	metric, err := metrics.New(``, `test`, true)
+	require.NoError(t, err)
+	repo := &duplicateRepoStub{}
+	uc := NewNotificationUsecase(
+		repo,
+		nil,
+		nil,
+		nil,
+		nil,
+		&conf.Biz{Dedup: &conf.Biz_Dedup{Window: durationpb.New(time.Hour)}},
+		metric,
+		log.NewFilter(log.DefaultLogger, log.FilterLevel(log.LevelFatal)),
+	)
+	dtoOf := func(tenant string) *NotificationInDTO {
+		return &NotificationInDTO{
+			SendType: v1.Type_plain,
+			SenderID: 1,
+			Tenant:   tenant,
+			Payload:  &schema.Payload{`message`: `Disk is full`},
+		}
+	}
+	ctx := context.Background()
+	_, err = repo.Create(ctx, transformNotificationInDTOToModel(dtoOf(`acme`)))
+	require.NoError(t, err)
+
+	suppressed, err := uc.suppressDuplicate(ctx, dtoOf(`globex`))
+	require.NoError(t, err)
+	require.Nil(t, suppressed, `notification of other tenant with the same sender is not a duplicate`)
+
+	suppressed, err = uc.suppressDuplicate(ctx, dtoOf(`acme`))
+	require.NoError(t, err)
+	require.True(t, suppressed.Suppressed)
+	require.Equal(t, int64(1), suppressed.OriginalID)
+}
@@ -0,0 +1,67 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+)
+
+const (
+	metricDeliveryLatency = `biz.notification.delivery.%s.latency.timings`
+	metricDeliveryRetries = `biz.notification.delivery.%s.%s.retries`
+
+	metricQueueDepthSuccess = `biz.notification.queueDepth.success`
+	metricQueueDepthFailure = `biz.notification.queueDepth.failure`
+	metricQueueDepthTimings = `biz.notification.queueDepth.timings`
+)
+
+var (
+	// QueuedStatuses are statuses of notifications not yet delivered or failed
+	QueuedStatuses = []schema.NotificationStatus{
+		schema.StatusPending,
+		schema.StatusRetry,
+		schema.StatusProcessing,
+	}
+)
+
+// QueueDepth is count of notifications of status and type and planned time of the oldest of them
+type QueueDepth struct {
+	Status          schema.NotificationStatus
+	Type            schema.NotificationType
+	Count           int
+	OldestPlannedAt time.Time
+}
+
+// QueueDepth returns depth of queue by status and type, only existing combinations are returned
+func (uc *NotificationUsecase) QueueDepth(ctx context.Context) ([]*QueueDepth, error) {
+	defer uc.metric.NewTiming().Send(metricQueueDepthTimings)
+	depths, err := uc.repo.QueueDepth(ctx, QueuedStatuses)
+	if err != nil {
+		uc.metric.Increment(metricQueueDepthFailure)
+		uc.logs.WithContext(ctx).Errorf("failed to get queue depth: %v", err)
+		return nil, err
+	}
+	uc.metric.Increment(metricQueueDepthSuccess)
+	return depths, nil
+}
+
+// reportDelivery sends latency of sent notification since its planned time and count of retries
+// made by notification in final status
+func (uc *NotificationUsecase) reportDelivery(notification *ent.Notification) {
+	if notification.Status == schema.StatusSent && notification.SentAt != nil {
+		latency := notification.SentAt.Sub(notification.PlannedAt)
+		if latency < 0 {
+			latency = 0
+		}
+		uc.metric.Timing(fmt.Sprintf(metricDeliveryLatency, notification.Type), latency.Milliseconds())
+	}
+	if notification.Status == schema.StatusSent || notification.Status == schema.StatusFail {
+		uc.metric.Histogram(
+			fmt.Sprintf(metricDeliveryRetries, notification.Type, notification.Status),
+			notification.Retries,
+		)
+	}
+}
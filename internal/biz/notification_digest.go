@@ -0,0 +1,207 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/conf"
+	"notifications/internal/pkg/template"
+	"notifications/internal/pkg/tracing"
+)
+
+const (
+	defaultDigestWindow = 15 * time.Minute
+
+	metricNotificationHeld = `biz.notification.held`
+)
+
+// defaultDigestTemplates render fields of digest payload by locale if they are not configured for digest key or type,
+// empty locale is the last fallback. Other fields of payload are copied from the first notification of digest
+var defaultDigestTemplates = map[schema.NotificationType]map[string]map[string]string{
+	schema.TypePlain: {
+		``: {`message`: `{{ range .items }}{{ .message }}` + "\n" + `{{ end }}`},
+	},
+	schema.TypeEmail: {
+		``: {
+			`body`: `{{ range .items }}{{ .subject }}` + "\n\n" + `{{ .body }}` + "\n\n" + `{{ end }}`,
+		},
+		template.LocaleEnglish: {
+			`subject`: `{{ .count }} new {{ plural .count "notification" "notifications" }}`,
+		},
+		template.LocaleRussian: {
+			`subject`: `{{ .count }} {{ plural .count "новое уведомление" "новых уведомления" "новых уведомлений" }}`,
+		},
+		template.LocaleKazakh: {
+			`subject`: `{{ .count }} жаңа хабарлама`,
+		},
+	},
+	schema.TypeSMS: {
+		``: {`text`: `{{ range .items }}{{ .text }}` + "\n" + `{{ end }}`},
+	},
+	schema.TypeTelegram: {
+		``: {`text`: `{{ range .items }}{{ .text }}` + "\n\n" + `{{ end }}`},
+	},
+	schema.TypeWhatsApp: {
+		``: {`text`: `{{ range .items }}{{ .text }}` + "\n" + `{{ end }}`},
+	},
+}
+
+// Digests resolves window of holding notifications and renders payload of digest by locale of recipient:
+// templates of digest key override templates of type, which override default templates. Within each of them
+// the first locale of fallback chain having template of field is used, fields without locale are the last fallback
+type Digests struct {
+	window  time.Duration
+	keys    map[string]map[string]map[string]string
+	types   map[string]map[string]map[string]string
+	locales *Locales
+}
+
+func NewDigests(c *conf.Biz_Digest, locales *Locales) *Digests {
+	digests := &Digests{
+		window:  defaultDigestWindow,
+		keys:    map[string]map[string]map[string]string{},
+		types:   map[string]map[string]map[string]string{},
+		locales: locales,
+	}
+	if c == nil {
+		return digests
+	}
+	if c.GetWindow().AsDuration() > 0 {
+		digests.window = c.GetWindow().AsDuration()
+	}
+	for key, t := range c.GetKeys() {
+		digests.keys[key] = localizedTemplates(t)
+	}
+	for notificationType, t := range c.GetTypes() {
+		digests.types[notificationType] = localizedTemplates(t)
+	}
+	return digests
+}
+
+// Window returns time to hold notification before merge into digest
+func (d *Digests) Window(dto *NotificationInDTO) time.Duration {
+	if dto.DigestWindow > 0 {
+		return dto.DigestWindow
+	}
+	return d.window
+}
+
+// Templates returns templates of payload fields of digest by field name for fallback chain of locales
+func (d *Digests) Templates(notificationType schema.NotificationType, key string, chain []string) map[string]string {
+	sources := []map[string]map[string]string{
+		d.keys[key],
+		d.types[notificationType.String()],
+		defaultDigestTemplates[notificationType],
+	}
+	locales := append(append([]string{}, chain...), ``)
+
+	// Templates of less specific sources and locales are overwritten by more specific ones
+	templates := map[string]string{}
+	for i := len(sources) - 1; i >= 0; i-- {
+		for j := len(locales) - 1; j >= 0; j-- {
+			for field, t := range sources[i][locales[j]] {
+				templates[field] = t
+			}
+		}
+	}
+	return templates
+}
+
+// Render merges payloads of notifications of the same digest into payload of digest.
+// Templates get key, locale, count of notifications and items as payloads of notifications in order of creation
+func (d *Digests) Render(notifications []*ent.Notification) (schema.Payload, error) {
+	if len(notifications) == 0 {
+		return nil, fmt.Errorf(`digest is empty`)
+	}
+	first := notifications[0]
+	locale := d.locales.Resolve(first)
+	items := make([]map[string]string, 0, len(notifications))
+	for _, notification := range notifications {
+		items = append(items, notification.Payload)
+	}
+	params := map[string]any{
+		`key`:    first.DigestKey,
+		`locale`: locale,
+		`count`:  len(notifications),
+		`items`:  items,
+	}
+
+	payload := schema.Payload{}
+	for field, value := range first.Payload {
+		payload[field] = value
+	}
+	for field, t := range d.Templates(first.Type, first.DigestKey, d.locales.Chain(locale)) {
+		rendered, err := template.InterpolateLocalized(t, params, locale)
+		if err != nil {
+			return nil, fmt.Errorf(`failed to render field %s of digest %s: %w`, field, first.DigestKey, err)
+		}
+		payload[field] = strings.TrimSpace(rendered)
+	}
+	return payload, nil
+}
+
+// localizedTemplates returns templates of fields by locale, fields without locale are stored by empty locale
+func localizedTemplates(t *conf.Biz_Digest_Template) map[string]map[string]string {
+	templates := map[string]map[string]string{
+		``: t.GetFields(),
+	}
+	for locale, localized := range t.GetLocales() {
+		templates[locale] = localized.GetFields()
+	}
+	return templates
+}
+
+// IsSameDigest reports if notifications are merged into the same digest:
+// they have the same sender, tenant, type, normalized recipient and digest key
+func IsSameDigest(a *ent.Notification, b *ent.Notification) bool {
+	return a.SenderID == b.SenderID &&
+		a.Tenant == b.Tenant &&
+		a.Type == b.Type &&
+		a.DigestKey == b.DigestKey &&
+		schema.NormalizeAddress(a.Type, a.Payload.Recipient(a.Type)) ==
+			schema.NormalizeAddress(b.Type, b.Payload.Recipient(b.Type))
+}
+
+// hold saves notification as held until flush time of its digest: the first held notification of digest
+// sets flush time to its planned time plus window, the next ones join it
+func (uc *NotificationUsecase) hold(ctx context.Context, dto *NotificationInDTO, plannedAt time.Time) (
+	*NotificationOutDTO,
+	error,
+) {
+	model := transformNotificationInDTOToModel(
+		dto, func(notification *ent.Notification) {
+			notification.Status = schema.StatusHeld
+			notification.PlannedAt = plannedAt.Add(uc.digests.Window(dto))
+			notification.TraceContext = tracing.Inject(ctx)
+		},
+	)
+	held, err := uc.repo.ListHeld(ctx, model.SenderID, model.DigestKey)
+	if err != nil {
+		return nil, err
+	}
+	for _, notification := range held {
+		if IsSameDigest(notification, model) {
+			model.PlannedAt = notification.PlannedAt
+			break
+		}
+	}
+	notification, err := uc.repo.Create(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	uc.metric.Increment(metricNotificationHeld)
+	uc.logs.WithContext(ctx).Infof(
+		`notification with id %d is held for digest %s until %s`,
+		notification.ID,
+		notification.DigestKey,
+		notification.PlannedAt.Format(time.RFC3339),
+	)
+	return &NotificationOutDTO{
+		ID:   int64(notification.ID),
+		Held: true,
+	}, nil
+}
@@ -0,0 +1,96 @@
+package biz
+
+import (
+	"strings"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/conf"
+	"notifications/internal/pkg/template"
+)
+
+// Locales resolves locale of recipient: locale of request, then locale by phone prefix or email domain
+// of recipient, then default locale. Localized templates are looked up by fallback chain of locale
+type Locales struct {
+	common    string
+	fallbacks map[string]string
+	phones    map[string]string
+	domains   map[string]string
+}
+
+func NewLocales(c *conf.Biz_Locale) *Locales {
+	locales := &Locales{
+		common:    template.LocaleEnglish,
+		fallbacks: map[string]string{},
+		phones:    map[string]string{},
+		domains:   map[string]string{},
+	}
+	if c == nil {
+		return locales
+	}
+	if c.GetDefault() != "" {
+		locales.common = c.GetDefault()
+	}
+	for locale, next := range c.GetFallbacks() {
+		locales.fallbacks[locale] = next
+	}
+	for prefix, locale := range c.GetPhones() {
+		locales.phones[schema.NormalizeAddress(schema.TypeSMS, prefix)] = locale
+	}
+	for domain, locale := range c.GetDomains() {
+		locales.domains[strings.ToLower(strings.Trim(domain, `.@ `))] = locale
+	}
+	return locales
+}
+
+// Resolve returns locale of recipient of notification
+func (l *Locales) Resolve(notification *ent.Notification) string {
+	if notification.Locale != "" {
+		return notification.Locale
+	}
+	address := schema.NormalizeAddress(notification.Type, notification.Payload.Recipient(notification.Type))
+	locale := ""
+	switch notification.Type {
+	case schema.TypeSMS, schema.TypeWhatsApp:
+		locale = longestMatch(l.phones, func(prefix string) bool { return strings.HasPrefix(address, prefix) })
+	case schema.TypeEmail:
+		_, domain, _ := strings.Cut(address, `@`)
+		locale = longestMatch(
+			l.domains, func(suffix string) bool {
+				return domain == suffix || strings.HasSuffix(domain, `.`+suffix)
+			},
+		)
+	}
+	if locale != "" {
+		return locale
+	}
+	return l.common
+}
+
+// Chain returns fallback chain of locale ending with chain of default locale
+func (l *Locales) Chain(locale string) []string {
+	chain := template.Fallbacks(locale, l.fallbacks)
+	for _, next := range template.Fallbacks(l.common, l.fallbacks) {
+		found := false
+		for _, existing := range chain {
+			found = found || existing == next
+		}
+		if !found {
+			chain = append(chain, next)
+		}
+	}
+	return chain
+}
+
+// longestMatch returns value of the longest matched key, e.g. the most specific phone prefix
+func longestMatch(values map[string]string, match func(key string) bool) string {
+	matched := ""
+	value := ""
+	for key, v := range values {
+		if key != "" && len(key) > len(matched) && match(key) {
+			matched = key
+			value = v
+		}
+	}
+	return value
+}
@@ -0,0 +1,78 @@
+package biz
+
+import (
+	"testing"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/conf"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocales_Resolve(t *testing.T) {
+	locales := NewLocales(
+		&conf.Biz_Locale{
+			Default: `ru`,
+			Phones:  map[string]string{`+7`: `ru`, `+7 7`: `kk`},
+			Domains: map[string]string{`.kz`: `kk`, `example.com`: `en`},
+		},
+	)
+
+	testCases := []struct {
+		name         string
+		notification *ent.Notification
+		expected     string
+	}{
+		{
+			name:         "request",
+			notification: &ent.Notification{Type: schema.TypeSMS, Locale: `en`, Payload: schema.Payload{`phone`: `77001234567`}},
+			expected:     `en`,
+		},
+		{
+			name:         "phone",
+			notification: &ent.Notification{Type: schema.TypeSMS, Payload: schema.Payload{`phone`: `+7 (700) 123-45-67`}},
+			expected:     `kk`,
+		},
+		{
+			name:         "shorter-phone-prefix",
+			notification: &ent.Notification{Type: schema.TypeSMS, Payload: schema.Payload{`phone`: `79001234567`}},
+			expected:     `ru`,
+		},
+		{
+			name:         "domain",
+			notification: &ent.Notification{Type: schema.TypeEmail, Payload: schema.Payload{`to`: `somebody@mail.KZ`}},
+			expected:     `kk`,
+		},
+		{
+			name:         "subdomain",
+			notification: &ent.Notification{Type: schema.TypeEmail, Payload: schema.Payload{`to`: `somebody@eu.example.com`}},
+			expected:     `en`,
+		},
+		{
+			name:         "default",
+			notification: &ent.Notification{Type: schema.TypeTelegram, Payload: schema.Payload{`chat_id`: `1`}},
+			expected:     `ru`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				require.Equal(t, testCase.expected, locales.Resolve(testCase.notification))
+			},
+		)
+	}
+}
+
+func TestLocales_Chain(t *testing.T) {
+	locales := NewLocales(
+		&conf.Biz_Locale{
+			Default:   `ru`,
+			Fallbacks: map[string]string{`kk`: `ru`, `ru`: `en`},
+		},
+	)
+	require.Equal(t, []string{`kk-KZ`, `kk`, `ru`, `en`}, locales.Chain(`kk-KZ`))
+	require.Equal(t, []string{`de`, `ru`, `en`}, locales.Chain(`de`))
+	require.Equal(t, []string{`en`}, NewLocales(nil).Chain(`en`))
+}
@@ -0,0 +1,146 @@
+package biz
+
+import (
+	"context"
+	databaseSql "database/sql"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/conf"
+	"notifications/internal/pkg/metrics"
+)
+
+const (
+	// DefaultLease is time for worker to send claimed notifications and record results
+	DefaultLease = 5 * time.Minute
+)
+
+var (
+	// AnyPriority matches notifications of all priorities
+	AnyPriority = PriorityRange{Min: math.MinInt32, Max: math.MaxInt32}
+)
+
+// PriorityRange is inclusive range of notification priorities
+type PriorityRange struct {
+	Min int
+	Max int
+}
+
+// Queue takes waiting notifications by priority and then by creation time.
+// If some slots of batch are reserved, they are given only to high-priority notifications,
+// so burst of regular notifications does not occupy whole capacity of workers
+type Queue struct {
+	repo         NotificationRepo
+	highPriority int
+	reserved     int
+	lease        time.Duration
+	workerID     string
+	now          func() time.Time
+	metric       metrics.Metrics
+}
+
+func NewQueue(repo NotificationRepo, c *conf.Biz_Queue, metric metrics.Metrics) *Queue {
+	lease := DefaultLease
+	if c.GetLease() != nil && c.GetLease().AsDuration() > 0 {
+		lease = c.GetLease().AsDuration()
+	}
+	return &Queue{
+		repo:         repo,
+		highPriority: int(c.GetHighPriority()),
+		reserved:     int(c.GetReserved()),
+		lease:        lease,
+		workerID:     newWorkerID(),
+		now:          time.Now,
+		metric:       metric,
+	}
+}
+
+// Claim marks batch of waiting notifications as processing by this worker until lease expiration in short transaction.
+// Notifications with expired lease are claimed again, so notification may be sent more than once if worker died
+// after sending but before recording result
+func (q *Queue) Claim(ctx context.Context, limit int, types []schema.NotificationType) ([]*ent.Notification, error) {
+	claimed := []*ent.Notification{}
+	transactionOptions := &databaseSql.TxOptions{
+		Isolation: databaseSql.LevelReadCommitted,
+		ReadOnly:  false,
+	}
+
+	transaction := func(repoCtx context.Context) error {
+		list, err := q.ListWaiting(repoCtx, limit, types)
+		if err != nil {
+			return err
+		}
+		leaseUntil := q.now().Add(q.lease)
+		for _, notification := range list {
+			if notification.Status == schema.StatusProcessing {
+				q.metric.Increment(metricProcessNotificationsReclaimed)
+			}
+			notification.Status = schema.StatusProcessing
+			notification.LeaseUntil = &leaseUntil
+			notification.WorkerID = &q.workerID
+			updated, err := q.repo.Update(repoCtx, notification)
+			if err != nil {
+				return err
+			}
+			claimed = append(claimed, updated)
+		}
+		return nil
+	}
+
+	if err := q.repo.Transaction(ctx, transactionOptions, transaction); err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
+
+// ListWaiting returns up to limit waiting notifications of types locked for update, all types if types are empty
+func (q *Queue) ListWaiting(
+	ctx context.Context,
+	limit int,
+	types []schema.NotificationType,
+) ([]*ent.Notification, error) {
+	if q.reserved <= 0 {
+		return q.repo.ListWaitingNotificationsWithLock(ctx, limit, types, AnyPriority)
+	}
+
+	high, err := q.repo.ListWaitingNotificationsWithLock(
+		ctx,
+		limit,
+		types,
+		PriorityRange{Min: q.highPriority, Max: AnyPriority.Max},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	regularLimit := limit - len(high)
+	if limit-q.reserved < regularLimit {
+		regularLimit = limit - q.reserved
+	}
+	if regularLimit <= 0 {
+		return high, nil
+	}
+
+	regular, err := q.repo.ListWaitingNotificationsWithLock(
+		ctx,
+		regularLimit,
+		types,
+		PriorityRange{Min: AnyPriority.Min, Max: q.highPriority - 1},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return append(high, regular...), nil
+}
+
+func newWorkerID() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = `unknown`
+	}
+	return fmt.Sprintf(`%s-%d`, hostname, os.Getpid())
+}
@@ -0,0 +1,97 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/conf"
+
+	"github.com/stretchr/testify/require"
+)
+
+type queueRepoStub struct {
+	NotificationRepo
+	waiting []*ent.Notification
+	calls   []PriorityRange
+}
+
+func (r *queueRepoStub) ListWaitingNotificationsWithLock(
+	_ context.Context,
+	limit int,
+	_ []schema.NotificationType,
+	priorities PriorityRange,
+) ([]*ent.Notification, error) {
+	r.calls = append(r.calls, priorities)
+	list := []*ent.Notification{}
+	for _, n := range r.waiting {
+		if len(list) < limit && n.Priority >= priorities.Min && n.Priority <= priorities.Max {
+			list = append(list, n)
+		}
+	}
+	return list, nil
+}
+
+func makePriorityNotifications(priorities ...int) []*ent.Notification {
+	list := []*ent.Notification{}
+	for i, priority := range priorities {
+		list = append(list, &ent.Notification{ID: i + 1, Priority: priority})
+	}
+	return list
+}
+
+func TestQueue_ListWaiting(t *testing.T) {
+	testCases := []struct {
+		name     string
+		queue    *conf.Biz_Queue
+		waiting  []*ent.Notification
+		limit    int
+		expected []int
+	}{
+		{
+			name:     "without reserve",
+			waiting:  makePriorityNotifications(0, 0, 0, 0),
+			limit:    3,
+			expected: []int{1, 2, 3},
+		},
+		{
+			name:     "reserve is kept for high priority",
+			queue:    &conf.Biz_Queue{HighPriority: 10, Reserved: 2},
+			waiting:  makePriorityNotifications(0, 0, 0, 0, 0),
+			limit:    4,
+			expected: []int{1, 2},
+		},
+		{
+			name:     "high priority takes whole batch",
+			queue:    &conf.Biz_Queue{HighPriority: 10, Reserved: 2},
+			waiting:  makePriorityNotifications(10, 20, 10, 10, 0),
+			limit:    4,
+			expected: []int{1, 2, 3, 4},
+		},
+		{
+			name:     "regular fills rest of batch",
+			queue:    &conf.Biz_Queue{HighPriority: 10, Reserved: 2},
+			waiting:  makePriorityNotifications(15, 0, 0, 0, 0),
+			limit:    4,
+			expected: []int{1, 2, 3},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				queue := NewQueue(&queueRepoStub{waiting: testCase.waiting}, testCase.queue, nil)
+
+				list, err := queue.ListWaiting(context.Background(), testCase.limit, nil)
+				require.NoError(t, err)
+
+				ids := []int{}
+				for _, n := range list {
+					ids = append(ids, n.ID)
+				}
+				require.Equal(t, testCase.expected, ids)
+			},
+		)
+	}
+}
@@ -0,0 +1,147 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+)
+
+const (
+	quotaMinuteWindow = time.Minute
+	quotaDayWindow    = 24 * time.Hour
+)
+
+var (
+	ErrQuotaExceeded = errors.New(`quota exceeded`)
+)
+
+type QuotaRepo interface {
+	ListBySenderID(ctx context.Context, senderID int) ([]*ent.Quota, error)
+
+	FindBySenderIDAndType(ctx context.Context, senderID int, notificationType schema.NotificationType) (
+		*ent.Quota,
+		error,
+	)
+
+	CountNotificationsSince(
+		ctx context.Context,
+		senderID int,
+		tenant string,
+		notificationType schema.NotificationType,
+		since time.Time,
+	) (int, error)
+}
+
+// QuotaUsage is count of notifications of sender by type in sliding windows against its limits
+type QuotaUsage struct {
+	Type        schema.NotificationType
+	MinuteUsed  int
+	MinuteLimit int
+	DayUsed     int
+	DayLimit    int
+}
+
+// IsExceeded returns true if one more notification is not allowed by quota
+func (u *QuotaUsage) IsExceeded() bool {
+	return (u.MinuteLimit > 0 && u.MinuteUsed >= u.MinuteLimit) ||
+		(u.DayLimit > 0 && u.DayUsed >= u.DayLimit)
+}
+
+// Quotas checks per-sender limits configured in database. Sender without quota for type is unlimited
+type Quotas struct {
+	repo QuotaRepo
+	now  func() time.Time
+}
+
+func NewQuotas(repo QuotaRepo) *Quotas {
+	return &Quotas{
+		repo: repo,
+		now:  time.Now,
+	}
+}
+
+// Check returns ErrQuotaExceeded if sender has exhausted quota for notification type by notifications of tenant
+func (q *Quotas) Check(
+	ctx context.Context,
+	senderID int,
+	tenant string,
+	notificationType schema.NotificationType,
+) error {
+	if q.repo == nil {
+		return nil
+	}
+	quota, err := q.repo.FindBySenderIDAndType(ctx, senderID, notificationType)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	usage, err := q.usage(ctx, quota, tenant)
+	if err != nil {
+		return err
+	}
+	if usage.IsExceeded() {
+		return fmt.Errorf(
+			`%w: sender %d sent %d of %d %s notifications per minute and %d of %d per day`,
+			ErrQuotaExceeded,
+			senderID,
+			usage.MinuteUsed,
+			usage.MinuteLimit,
+			notificationType,
+			usage.DayUsed,
+			usage.DayLimit,
+		)
+	}
+	return nil
+}
+
+// Usage returns usage of all quotas of sender by notifications of tenant
+func (q *Quotas) Usage(ctx context.Context, senderID int, tenant string) ([]*QuotaUsage, error) {
+	if q.repo == nil {
+		return []*QuotaUsage{}, nil
+	}
+	quotas, err := q.repo.ListBySenderID(ctx, senderID)
+	if err != nil {
+		return nil, err
+	}
+	usages := make([]*QuotaUsage, 0, len(quotas))
+	for _, quota := range quotas {
+		usage, err := q.usage(ctx, quota, tenant)
+		if err != nil {
+			return nil, err
+		}
+		usages = append(usages, usage)
+	}
+	return usages, nil
+}
+
+// usage counts notifications of tenant, senders of different tenants with the same id have separate usage
+func (q *Quotas) usage(ctx context.Context, quota *ent.Quota, tenant string) (*QuotaUsage, error) {
+	usage := &QuotaUsage{
+		Type:        quota.Type,
+		MinuteLimit: quota.PerMinute,
+		DayLimit:    quota.PerDay,
+	}
+	now := q.now()
+	var err error
+	usage.MinuteUsed, err = q.repo.CountNotificationsSince(
+		ctx,
+		quota.SenderID,
+		tenant,
+		quota.Type,
+		now.Add(-quotaMinuteWindow),
+	)
+	if err != nil {
+		return nil, err
+	}
+	usage.DayUsed, err = q.repo.CountNotificationsSince(ctx, quota.SenderID, tenant, quota.Type, now.Add(-quotaDayWindow))
+	if err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
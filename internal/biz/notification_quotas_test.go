@@ -0,0 +1,128 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+
+	"github.com/stretchr/testify/require"
+)
+
+type quotaRepoStub struct {
+	quotas []*ent.Quota
+	counts map[time.Duration]int
+	now    time.Time
+}
+
+func (r *quotaRepoStub) ListBySenderID(_ context.Context, senderID int) ([]*ent.Quota, error) {
+	list := []*ent.Quota{}
+	for _, quota := range r.quotas {
+		if quota.SenderID == senderID {
+			list = append(list, quota)
+		}
+	}
+	return list, nil
+}
+
+func (r *quotaRepoStub) FindBySenderIDAndType(
+	_ context.Context,
+	senderID int,
+	notificationType schema.NotificationType,
+) (*ent.Quota, error) {
+	for _, quota := range r.quotas {
+		if quota.SenderID == senderID && quota.Type == notificationType {
+			return quota, nil
+		}
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+func (r *quotaRepoStub) CountNotificationsSince(
+	_ context.Context,
+	_ int,
+	_ string,
+	_ schema.NotificationType,
+	since time.Time,
+) (int, error) {
+	return r.counts[r.now.Sub(since)], nil
+}
+
+func TestQuotas_Check(t *testing.T) {
+	now := time.Now()
+	quotas := []*ent.Quota{
+		{SenderID: 1, Type: schema.TypeSMS, PerMinute: 5, PerDay: 100},
+		{SenderID: 1, Type: schema.TypeEmail, PerDay: 10},
+	}
+
+	testCases := []struct {
+		name             string
+		notificationType schema.NotificationType
+		counts           map[time.Duration]int
+		exceeded         bool
+	}{
+		{
+			name:             "unlimited",
+			notificationType: schema.TypeTelegram,
+			counts:           map[time.Duration]int{quotaMinuteWindow: 1000, quotaDayWindow: 1000},
+		},
+		{
+			name:             "within",
+			notificationType: schema.TypeSMS,
+			counts:           map[time.Duration]int{quotaMinuteWindow: 4, quotaDayWindow: 99},
+		},
+		{
+			name:             "minute",
+			notificationType: schema.TypeSMS,
+			counts:           map[time.Duration]int{quotaMinuteWindow: 5, quotaDayWindow: 5},
+			exceeded:         true,
+		},
+		{
+			name:             "day",
+			notificationType: schema.TypeEmail,
+			counts:           map[time.Duration]int{quotaMinuteWindow: 0, quotaDayWindow: 10},
+			exceeded:         true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				q := NewQuotas(&quotaRepoStub{quotas: quotas, counts: testCase.counts, now: now})
+				q.now = func() time.Time { return now }
+
+				err := q.Check(context.Background(), 1, ``, testCase.notificationType)
+				require.Equal(t, testCase.exceeded, errors.Is(err, ErrQuotaExceeded))
+				if !testCase.exceeded {
+					require.NoError(t, err)
+				}
+			},
+		)
+	}
+}
+
+func TestQuotas_Usage(t *testing.T) {
+	now := time.Now()
+	q := NewQuotas(
+		&quotaRepoStub{
+			quotas: []*ent.Quota{
+				{SenderID: 1, Type: schema.TypeSMS, PerMinute: 5, PerDay: 100},
+				{SenderID: 2, Type: schema.TypeSMS, PerMinute: 1},
+			},
+			counts: map[time.Duration]int{quotaMinuteWindow: 3, quotaDayWindow: 42},
+			now:    now,
+		},
+	)
+	q.now = func() time.Time { return now }
+
+	usages, err := q.Usage(context.Background(), 1, ``)
+	require.NoError(t, err)
+	require.Equal(
+		t, []*QuotaUsage{
+			{Type: schema.TypeSMS, MinuteUsed: 3, MinuteLimit: 5, DayUsed: 42, DayLimit: 100},
+		}, usages,
+	)
+}
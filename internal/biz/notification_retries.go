@@ -0,0 +1,94 @@
+package biz
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/conf"
+)
+
+var (
+	// DefaultRetryPolicy keeps fixed RetryInterval between retries until TTL of notification expires
+	DefaultRetryPolicy = schema.RetryPolicy{
+		InitialInterval: RetryInterval,
+		Multiplier:      1,
+	}
+)
+
+// RetryPolicies resolves retry policy of notification: request override, then policy for type, then common policy
+type RetryPolicies struct {
+	common schema.RetryPolicy
+	types  map[schema.NotificationType]schema.RetryPolicy
+	random func() float64
+}
+
+func NewRetryPolicies(c *conf.Biz_Retry) *RetryPolicies {
+	policies := &RetryPolicies{
+		common: DefaultRetryPolicy,
+		types:  map[schema.NotificationType]schema.RetryPolicy{},
+		//nolint:gosec // G404: Use of weak random number generator (math/rand instead of crypto/rand)
+		random: rand.Float64,
+	}
+	if c == nil {
+		return policies
+	}
+	if c.Common != nil {
+		policies.common = retryPolicyFromConf(c.Common).Merge(DefaultRetryPolicy)
+	}
+	for notificationType, policy := range c.Types {
+		policies.types[schema.NotificationType(notificationType)] = retryPolicyFromConf(policy)
+	}
+	return policies
+}
+
+// For returns retry policy for notification
+func (p *RetryPolicies) For(notification *ent.Notification) schema.RetryPolicy {
+	policy := p.common
+	if typed, ok := p.types[notification.Type]; ok {
+		policy = typed.Merge(policy)
+	}
+	if notification.RetryPolicy != nil {
+		policy = notification.RetryPolicy.Merge(policy)
+	}
+	return policy
+}
+
+// Delay returns delay before retry with number attempt (starts from 1)
+func (p *RetryPolicies) Delay(policy schema.RetryPolicy, attempt int) time.Duration {
+	multiplier := policy.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	if attempt < 1 {
+		attempt = 1
+	}
+	delay := float64(policy.InitialInterval) * math.Pow(multiplier, float64(attempt-1))
+	if policy.MaxInterval > 0 && delay > float64(policy.MaxInterval) {
+		delay = float64(policy.MaxInterval)
+	}
+	if policy.Jitter > 0 {
+		delay += delay * policy.Jitter * (2*p.random() - 1)
+	}
+	if delay > math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(delay)
+}
+
+// IsExhausted returns true if notification made all attempts allowed by policy
+func (p *RetryPolicies) IsExhausted(policy schema.RetryPolicy, retries int) bool {
+	return policy.MaxAttempts > 0 && retries >= policy.MaxAttempts
+}
+
+func retryPolicyFromConf(c *conf.Biz_Retry_Policy) schema.RetryPolicy {
+	return schema.RetryPolicy{
+		InitialInterval: c.GetInitialInterval().AsDuration(),
+		Multiplier:      c.GetMultiplier(),
+		MaxInterval:     c.GetMaxInterval().AsDuration(),
+		Jitter:          c.GetJitter(),
+		MaxAttempts:     int(c.GetMaxAttempts()),
+	}
+}
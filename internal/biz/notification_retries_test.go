@@ -0,0 +1,104 @@
+package biz
+
+import (
+	"testing"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/conf"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestRetryPolicies_For(t *testing.T) {
+	policies := NewRetryPolicies(
+		&conf.Biz_Retry{
+			Common: &conf.Biz_Retry_Policy{
+				InitialInterval: durationpb.New(10 * time.Second),
+				Multiplier:      2,
+				MaxInterval:     durationpb.New(time.Minute),
+			},
+			Types: map[string]*conf.Biz_Retry_Policy{
+				schema.TypeSMS.String(): {
+					InitialInterval: durationpb.New(30 * time.Second),
+					MaxAttempts:     5,
+				},
+			},
+		},
+	)
+
+	testCases := []struct {
+		name         string
+		notification *ent.Notification
+		expected     schema.RetryPolicy
+	}{
+		{
+			name:         "common",
+			notification: &ent.Notification{Type: schema.TypeEmail},
+			expected: schema.RetryPolicy{
+				InitialInterval: 10 * time.Second,
+				Multiplier:      2,
+				MaxInterval:     time.Minute,
+			},
+		},
+		{
+			name:         "typed",
+			notification: &ent.Notification{Type: schema.TypeSMS},
+			expected: schema.RetryPolicy{
+				InitialInterval: 30 * time.Second,
+				Multiplier:      2,
+				MaxInterval:     time.Minute,
+				MaxAttempts:     5,
+			},
+		},
+		{
+			name: "overridden",
+			notification: &ent.Notification{
+				Type:        schema.TypeSMS,
+				RetryPolicy: &schema.RetryPolicy{MaxAttempts: 2, Jitter: 0.5},
+			},
+			expected: schema.RetryPolicy{
+				InitialInterval: 30 * time.Second,
+				Multiplier:      2,
+				MaxInterval:     time.Minute,
+				Jitter:          0.5,
+				MaxAttempts:     2,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				require.Equal(t, testCase.expected, policies.For(testCase.notification))
+			},
+		)
+	}
+}
+
+func TestRetryPolicies_Delay(t *testing.T) {
+	policies := NewRetryPolicies(nil)
+	policies.random = func() float64 { return 1 }
+
+	policy := schema.RetryPolicy{
+		InitialInterval: time.Second,
+		Multiplier:      2,
+		MaxInterval:     10 * time.Second,
+	}
+
+	require.Equal(t, time.Second, policies.Delay(policy, 1))
+	require.Equal(t, 2*time.Second, policies.Delay(policy, 2))
+	require.Equal(t, 8*time.Second, policies.Delay(policy, 4))
+	require.Equal(t, 10*time.Second, policies.Delay(policy, 5))
+	require.Equal(t, RetryInterval, policies.Delay(DefaultRetryPolicy, 100))
+
+	policy.Jitter = 0.5
+	require.Equal(t, 3*time.Second, policies.Delay(policy, 2))
+
+	require.False(t, policies.IsExhausted(policy, 100))
+	policy.MaxAttempts = 3
+	require.False(t, policies.IsExhausted(policy, 2))
+	require.True(t, policies.IsExhausted(policy, 3))
+}
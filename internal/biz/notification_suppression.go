@@ -0,0 +1,98 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/conf"
+)
+
+const (
+	metricNotificationRecipientSuppressed = `biz.notification.recipientSuppressed`
+)
+
+var (
+	ErrRecipientSuppressed = errors.New(`recipient is on suppression list`)
+)
+
+// Suppressions checks recipients of notifications by suppression list
+type Suppressions struct {
+	repo   SuppressionRepo
+	reject bool
+}
+
+func NewSuppressions(repo SuppressionRepo, c *conf.Biz_Suppression) *Suppressions {
+	return &Suppressions{
+		repo:   repo,
+		reject: c.GetReject(),
+	}
+}
+
+// Find returns active suppression of recipient by sender of notification or nil if recipient is not suppressed
+func (s *Suppressions) Find(ctx context.Context, notification *ent.Notification, at time.Time) (
+	*ent.Suppression,
+	error,
+) {
+	if s.repo == nil {
+		return nil, nil
+	}
+	address := schema.NormalizeAddress(notification.Type, notification.Payload.Recipient(notification.Type))
+	if address == "" {
+		return nil, nil
+	}
+	suppression, err := s.repo.FindByAddress(
+		ctx,
+		notification.SenderID,
+		notification.Tenant,
+		notification.Type,
+		address,
+	)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf(`failed to check suppression of recipient: %w`, err)
+	}
+	if !IsSuppressionActive(suppression, at) {
+		return nil, nil
+	}
+	return suppression, nil
+}
+
+// suppressRecipient rejects notification or saves it as suppressed if its recipient is on suppression list.
+// Result is nil if recipient is not suppressed
+func (uc *NotificationUsecase) suppressRecipient(ctx context.Context, dto *NotificationInDTO) (
+	*NotificationOutDTO,
+	error,
+) {
+	now := time.Now()
+	model := transformNotificationInDTOToModel(dto)
+	suppression, err := uc.suppressions.Find(ctx, model, now)
+	if err != nil || suppression == nil {
+		return nil, err
+	}
+	uc.metric.Increment(metricNotificationRecipientSuppressed)
+	if uc.suppressions.reject {
+		return nil, fmt.Errorf(`%w: suppression with id %d`, ErrRecipientSuppressed, suppression.ID)
+	}
+
+	model.Status = schema.StatusSuppressed
+	model.PlannedAt = now
+	suppressed, err := uc.repo.Create(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	uc.logs.WithContext(ctx).Infof(
+		`notification with id %d is suppressed by suppression with id %d`,
+		suppressed.ID,
+		suppression.ID,
+	)
+	return &NotificationOutDTO{
+		ID:         int64(suppressed.ID),
+		Suppressed: true,
+	}, nil
+}
@@ -0,0 +1,116 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/conf"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/require"
+)
+
+type suppressionRepoStub struct {
+	SuppressionRepo
+	suppressions []*ent.Suppression
+}
+
+func (r *suppressionRepoStub) FindByAddress(
+	_ context.Context,
+	senderID int,
+	tenant string,
+	notificationType schema.NotificationType,
+	address string,
+) (*ent.Suppression, error) {
+	for _, suppression := range r.suppressions {
+		if suppression.SenderID == senderID && suppression.Tenant == tenant &&
+			suppression.Type == notificationType && suppression.Address == address {
+			return suppression, nil
+		}
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+func TestSuppressions_Find(t *testing.T) {
+	now := time.Now()
+	repo := &suppressionRepoStub{
+		suppressions: []*ent.Suppression{
+			{ID: 1, Type: schema.TypeSMS, Address: "79009009090"},
+			{ID: 2, Type: schema.TypeEmail, Address: "somebody@example.com"},
+			{ID: 3, Type: schema.TypeEmail, Address: "expired@example.com", ExpiresAt: pointer.ToTime(now.Add(-time.Hour))},
+			{ID: 4, Type: schema.TypeEmail, Address: "temporary@example.com", ExpiresAt: pointer.ToTime(now.Add(time.Hour))},
+			{ID: 5, SenderID: 42, Tenant: "acme", Type: schema.TypeEmail, Address: "acme@example.com"},
+		},
+	}
+	suppressions := NewSuppressions(repo, &conf.Biz_Suppression{})
+
+	testCases := []struct {
+		name         string
+		notification *ent.Notification
+		expected     int
+	}{
+		{
+			name:         "phone",
+			notification: &ent.Notification{Type: schema.TypeSMS, Payload: schema.Payload{`phone`: `+7 (900) 900-90-90`}},
+			expected:     1,
+		},
+		{
+			name:         "email",
+			notification: &ent.Notification{Type: schema.TypeEmail, Payload: schema.Payload{`to`: ` SomeBody@Example.com`}},
+			expected:     2,
+		},
+		{
+			name:         "other-channel",
+			notification: &ent.Notification{Type: schema.TypeTelegram, Payload: schema.Payload{`chat_id`: `79009009090`}},
+		},
+		{
+			name:         "expired",
+			notification: &ent.Notification{Type: schema.TypeEmail, Payload: schema.Payload{`to`: `expired@example.com`}},
+		},
+		{
+			name:         "temporary",
+			notification: &ent.Notification{Type: schema.TypeEmail, Payload: schema.Payload{`to`: `temporary@example.com`}},
+			expected:     4,
+		},
+		{
+			name: "sender-of-suppression",
+			notification: &ent.Notification{
+				SenderID: 42,
+				Tenant:   "acme",
+				Type:     schema.TypeEmail,
+				Payload:  schema.Payload{`to`: `acme@example.com`},
+			},
+			expected: 5,
+		},
+		{
+			name:         "other-sender",
+			notification: &ent.Notification{Type: schema.TypeEmail, Payload: schema.Payload{`to`: `acme@example.com`}},
+		},
+		{
+			name:         "no-recipient",
+			notification: &ent.Notification{Type: schema.TypePlain, Payload: schema.Payload{`message`: `test`}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				suppression, err := suppressions.Find(context.Background(), testCase.notification, now)
+				require.NoError(t, err)
+				if testCase.expected == 0 {
+					require.Nil(t, suppression)
+					return
+				}
+				require.NotNil(t, suppression)
+				require.Equal(t, testCase.expected, suppression.ID)
+			},
+		)
+	}
+
+	suppression, err := NewSuppressions(nil, nil).Find(context.Background(), testCases[0].notification, now)
+	require.NoError(t, err)
+	require.Nil(t, suppression)
+}
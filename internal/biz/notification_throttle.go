@@ -0,0 +1,75 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/conf"
+	"notifications/internal/pkg/ratelimit"
+)
+
+// Throttle limits outbound rate of notifications by channel and by recipient of channel
+type Throttle struct {
+	limiter    ratelimit.Limiter
+	channels   map[schema.NotificationType]ratelimit.Limit
+	recipients map[schema.NotificationType]ratelimit.Limit
+}
+
+func NewThrottle(limiter ratelimit.Limiter, c *conf.Biz_RateLimit) *Throttle {
+	return &Throttle{
+		limiter:    limiter,
+		channels:   limitsFromConf(c.GetChannels()),
+		recipients: limitsFromConf(c.GetRecipients()),
+	}
+}
+
+// Delay returns zero if notification may be sent now or time to wait for budget of channel or recipient.
+// Token of recipient is returned if channel has no token, so notification waiting for channel does not spend
+// budget of its recipient
+func (t *Throttle) Delay(ctx context.Context, notification *ent.Notification) (time.Duration, error) {
+	if t.limiter == nil {
+		return 0, nil
+	}
+	recipientKey := ``
+	recipientLimit, ok := t.recipients[notification.Type]
+	if recipient := notification.Payload.Recipient(notification.Type); ok && recipient != "" {
+		recipientKey = fmt.Sprintf(`%s:%s`, notification.Type, recipient)
+		delay, err := t.limiter.Reserve(ctx, recipientKey, recipientLimit)
+		if err != nil || delay > 0 {
+			return delay, err
+		}
+	}
+	channelLimit, ok := t.channels[notification.Type]
+	if !ok {
+		return 0, nil
+	}
+	delay, err := t.limiter.Reserve(ctx, channelKey(notification), channelLimit)
+	if (err != nil || delay > 0) && recipientKey != `` {
+		if releaseErr := t.limiter.Release(ctx, recipientKey, recipientLimit); releaseErr != nil && err == nil {
+			err = releaseErr
+		}
+	}
+	return delay, err
+}
+
+// channelKey returns key of bucket of channel, tenants are sent by their own credentials, so they have own buckets
+func channelKey(notification *ent.Notification) string {
+	if notification.Tenant == "" {
+		return notification.Type.String()
+	}
+	return fmt.Sprintf(`tenant:%s:%s`, notification.Tenant, notification.Type)
+}
+
+func limitsFromConf(c map[string]*conf.Biz_RateLimit_Limit) map[schema.NotificationType]ratelimit.Limit {
+	limits := map[schema.NotificationType]ratelimit.Limit{}
+	for notificationType, limit := range c {
+		limits[schema.NotificationType(notificationType)] = ratelimit.Limit{
+			Rate:  limit.GetRate(),
+			Burst: int(limit.GetBurst()),
+		}
+	}
+	return limits
+}
@@ -0,0 +1,122 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/conf"
+	"notifications/internal/pkg/metrics"
+	"notifications/internal/pkg/ratelimit"
+	"notifications/internal/senders"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/require"
+)
+
+// failingLimiter fails to reserve token of key, other keys are reserved by memory limiter
+type failingLimiter struct {
+	*ratelimit.Memory
+	key string
+}
+
+func (l *failingLimiter) Reserve(ctx context.Context, key string, limit ratelimit.Limit) (time.Duration, error) {
+	if key == l.key {
+		return 0, errors.New(`connection refused`)
+	}
+	return l.Memory.Reserve(ctx, key, limit)
+}
+
+type plainSenderStub struct {
+	sent int
+}
+
+func (s *plainSenderStub) Send(_ context.Context, _ string) error {
+	s.sent++
+	return nil
+}
+
+func throttleConf() *conf.Biz_RateLimit {
+	return &conf.Biz_RateLimit{
+		Channels: map[string]*conf.Biz_RateLimit_Limit{
+			`telegram`: {Rate: 1, Burst: 1},
+			`plain`:    {Rate: 1, Burst: 1},
+		},
+		Recipients: map[string]*conf.Biz_RateLimit_Limit{
+			`telegram`: {Rate: 1, Burst: 1},
+		},
+	}
+}
+
+func TestThrottle_Delay(t *testing.T) {
+	ctx := context.Background()
+	limit := ratelimit.Limit{Rate: 1, Burst: 1}
+	notificationTo := func(chatID string) *ent.Notification {
+		return &ent.Notification{Type: schema.TypeTelegram, Payload: schema.Payload{`chat_id`: chatID}}
+	}
+
+	limiter := ratelimit.NewMemory()
+	throttle := NewThrottle(limiter, throttleConf())
+
+	delay, err := throttle.Delay(ctx, notificationTo(`1`))
+	require.NoError(t, err)
+	require.Zero(t, delay)
+
+	delay, err = throttle.Delay(ctx, notificationTo(`2`))
+	require.NoError(t, err)
+	require.Positive(t, delay, `channel has no token`)
+
+	delay, err = limiter.Reserve(ctx, `telegram:2`, limit)
+	require.NoError(t, err)
+	require.Zero(t, delay, `token of recipient is returned while channel has no token`)
+
+	ofTenant := notificationTo(`5`)
+	ofTenant.Tenant = `acme`
+	delay, err = throttle.Delay(ctx, ofTenant)
+	require.NoError(t, err)
+	require.Zero(t, delay, `tenant has own bucket of channel`)
+
+	limiter = ratelimit.NewMemory()
+	throttle = NewThrottle(&failingLimiter{Memory: limiter, key: `telegram`}, throttleConf())
+	_, err = throttle.Delay(ctx, notificationTo(`3`))
+	require.Error(t, err)
+
+	delay, err = limiter.Reserve(ctx, `telegram:3`, limit)
+	require.NoError(t, err)
+	require.Zero(t, delay, `token of recipient is returned on failure of channel`)
+
+	delay, err = NewThrottle(nil, throttleConf()).Delay(ctx, notificationTo(`4`))
+	require.NoError(t, err)
+	require.Zero(t, delay, `notification is not throttled without limiter`)
+}
+
+func TestNotificationUsecase_processClaimedNotificationThrottleFailure(t *testing.T) {
+	metric, err := metrics.New(``, `test`, true)
+	require.NoError(t, err)
+	plainSender := &plainSenderStub{}
+
+	uc := NewNotificationUsecase(
+		nil,
+		nil,
+		nil,
+		&failingLimiter{Memory: ratelimit.NewMemory(), key: `plain`},
+		&senders.Senders{PlainSender: plainSender},
+		&conf.Biz{RateLimit: throttleConf()},
+		metric,
+		log.NewFilter(log.DefaultLogger, log.FilterLevel(log.LevelFatal)),
+	)
+
+	notification := &ent.Notification{
+		ID:      1,
+		Type:    schema.TypePlain,
+		Status:  schema.StatusProcessing,
+		Payload: schema.Payload{`message`: `test`},
+	}
+	err = uc.processClaimedNotification(context.Background(), notification)
+	require.Error(t, err, `result is not recorded, so notification is reclaimed after its lease`)
+	require.Zero(t, plainSender.sent, `notification is not sent if rate limit is not checked`)
+	require.Equal(t, schema.StatusProcessing, notification.Status)
+}
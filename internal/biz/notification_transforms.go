@@ -8,11 +8,17 @@ import (
 
 func transformNotificationModelToInDTO(notification *ent.Notification) *NotificationInDTO {
 	return &NotificationInDTO{
-		SendType:  v1.Type(v1.Type_value[notification.Type.String()]),
-		SenderID:  int64(notification.SenderID),
-		Payload:   &notification.Payload,
-		TTL:       notification.TTL,
-		PlannedAt: &notification.PlannedAt,
+		SendType:    v1.Type(v1.Type_value[notification.Type.String()]),
+		SenderID:    int64(notification.SenderID),
+		Tenant:      notification.Tenant,
+		Payload:     &notification.Payload,
+		TTL:         notification.TTL,
+		PlannedAt:   &notification.PlannedAt,
+		RetryPolicy: notification.RetryPolicy,
+		Priority:    notification.Priority,
+		Category:    notification.Category,
+		Window:      notification.DeliveryWindow,
+		Locale:      notification.Locale,
 	}
 }
 
@@ -21,14 +27,24 @@ func transformNotificationInDTOToModel(
 	withFields ...func(*ent.Notification),
 ) *ent.Notification {
 	notification := &ent.Notification{
-		SenderID: int(dto.SenderID),
-		Type:     schema.NotificationType(dto.SendType.String()),
-		Payload:  *dto.Payload,
-		TTL:      dto.TTL,
+		SenderID:       int(dto.SenderID),
+		Tenant:         dto.Tenant,
+		Type:           schema.NotificationType(dto.SendType.String()),
+		Payload:        *dto.Payload,
+		TTL:            dto.TTL,
+		RetryPolicy:    dto.RetryPolicy,
+		Priority:       dto.Priority,
+		Category:       dto.Category,
+		DeliveryWindow: dto.Window,
+		DigestKey:      dto.DigestKey,
+		Locale:         dto.Locale,
+		ScheduleID:     dto.ScheduleID,
+		OccurrenceAt:   dto.OccurrenceAt,
 	}
 	if dto.PlannedAt != nil {
 		notification.PlannedAt = *dto.PlannedAt
 	}
+	notification.DedupKey = DedupKey(notification)
 	for _, withField := range withFields {
 		withField(notification)
 	}
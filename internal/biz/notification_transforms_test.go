@@ -40,6 +40,7 @@ func Test_transformNotificationInDTOToModel(t *testing.T) {
 				Payload:   payload,
 				PlannedAt: now,
 				SentAt:    pointer.ToTime(now.Add(1 * time.Minute)),
+				DedupKey:  `51f57f231316e3e4589de527edacd9b26d340d356edfbce37590b3143a82eaed`,
 			},
 		},
 	}
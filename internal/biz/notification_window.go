@@ -0,0 +1,80 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/conf"
+)
+
+// DeliveryWindows resolves delivery window of notification: request override, then window of category,
+// then window of sender, then common window
+type DeliveryWindows struct {
+	common     schema.DeliveryWindow
+	categories map[string]schema.DeliveryWindow
+	senders    map[int64]schema.DeliveryWindow
+}
+
+func NewDeliveryWindows(c *conf.Biz_Delivery) *DeliveryWindows {
+	windows := &DeliveryWindows{
+		categories: map[string]schema.DeliveryWindow{},
+		senders:    map[int64]schema.DeliveryWindow{},
+	}
+	if c == nil {
+		return windows
+	}
+	if c.Common != nil {
+		windows.common = deliveryWindowFromConf(c.Common)
+	}
+	for category, window := range c.Categories {
+		windows.categories[category] = deliveryWindowFromConf(window)
+	}
+	for senderID, window := range c.Senders {
+		windows.senders[senderID] = deliveryWindowFromConf(window)
+	}
+	return windows
+}
+
+// For returns delivery window of notification
+func (w *DeliveryWindows) For(notification *ent.Notification) schema.DeliveryWindow {
+	window := w.common
+	if sender, ok := w.senders[int64(notification.SenderID)]; ok {
+		window = sender.Merge(window)
+	}
+	if category, ok := w.categories[notification.Category]; ok && notification.Category != `` {
+		window = category.Merge(window)
+	}
+	if notification.DeliveryWindow != nil {
+		window = notification.DeliveryWindow.Merge(window)
+	}
+	return window
+}
+
+// Next returns at if notification may be sent at this time, otherwise start of the next delivery window
+func (w *DeliveryWindows) Next(notification *ent.Notification, at time.Time) (time.Time, error) {
+	return w.For(notification).Next(at)
+}
+
+func deliveryWindowFromConf(c *conf.Biz_Delivery_Window) schema.DeliveryWindow {
+	return schema.DeliveryWindow{
+		From:     c.GetFrom(),
+		To:       c.GetTo(),
+		Timezone: c.GetTimezone(),
+	}
+}
+
+// deferredTo returns start of the next delivery window if notification may not be sent at time at
+func (uc *NotificationUsecase) deferredTo(ctx context.Context, notification *ent.Notification, at time.Time) *time.Time {
+	next, err := uc.windows.Next(notification, at)
+	if err != nil {
+		// Misconfigured window must not stop delivery
+		uc.logs.WithContext(ctx).Warnf(`failed to resolve delivery window of notification: %v`, err)
+		return nil
+	}
+	if !next.After(at) {
+		return nil
+	}
+	return &next
+}
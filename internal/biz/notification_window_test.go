@@ -0,0 +1,102 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeliveryWindows_For(t *testing.T) {
+	windows := NewDeliveryWindows(
+		&conf.Biz_Delivery{
+			Common: &conf.Biz_Delivery_Window{Timezone: "Europe/Moscow"},
+			Categories: map[string]*conf.Biz_Delivery_Window{
+				"marketing": {From: "09:00", To: "21:00"},
+			},
+			Senders: map[int64]*conf.Biz_Delivery_Window{
+				7: {From: "08:00", To: "23:00", Timezone: "Asia/Yekaterinburg"},
+			},
+		},
+	)
+
+	testCases := []struct {
+		name         string
+		notification *ent.Notification
+		expected     schema.DeliveryWindow
+	}{
+		{
+			name:         "common",
+			notification: &ent.Notification{},
+			expected:     schema.DeliveryWindow{Timezone: "Europe/Moscow"},
+		},
+		{
+			name:         "sender",
+			notification: &ent.Notification{SenderID: 7},
+			expected:     schema.DeliveryWindow{From: "08:00", To: "23:00", Timezone: "Asia/Yekaterinburg"},
+		},
+		{
+			name:         "category",
+			notification: &ent.Notification{SenderID: 7, Category: "marketing"},
+			expected:     schema.DeliveryWindow{From: "09:00", To: "21:00", Timezone: "Asia/Yekaterinburg"},
+		},
+		{
+			name: "recipient-timezone",
+			notification: &ent.Notification{
+				Category:       "marketing",
+				DeliveryWindow: &schema.DeliveryWindow{Timezone: "Asia/Vladivostok"},
+			},
+			expected: schema.DeliveryWindow{From: "09:00", To: "21:00", Timezone: "Asia/Vladivostok"},
+		},
+		{
+			name: "overridden",
+			notification: &ent.Notification{
+				Category:       "marketing",
+				DeliveryWindow: &schema.DeliveryWindow{From: "10:00", To: "18:00"},
+			},
+			expected: schema.DeliveryWindow{From: "10:00", To: "18:00", Timezone: "Europe/Moscow"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				require.Equal(t, testCase.expected, windows.For(testCase.notification))
+			},
+		)
+	}
+}
+
+func TestNotificationUsecase_deferredTo(t *testing.T) {
+	uc := &NotificationUsecase{
+		windows: NewDeliveryWindows(
+			&conf.Biz_Delivery{
+				Categories: map[string]*conf.Biz_Delivery_Window{
+					"marketing": {From: "09:00", To: "21:00", Timezone: "Europe/Moscow"},
+					"broken":    {From: "09:00", To: "21:00", Timezone: "Mars/Olympus"},
+				},
+			},
+		),
+		logs: log.NewHelper(log.NewFilter(log.DefaultLogger, log.FilterLevel(log.LevelFatal))),
+	}
+	ctx := context.Background()
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+
+	night := time.Date(2022, 9, 1, 23, 0, 0, 0, moscow)
+	day := time.Date(2022, 9, 1, 12, 0, 0, 0, moscow)
+
+	next := uc.deferredTo(ctx, &ent.Notification{Category: "marketing"}, night)
+	require.NotNil(t, next)
+	require.True(t, time.Date(2022, 9, 2, 9, 0, 0, 0, moscow).Equal(*next))
+
+	require.Nil(t, uc.deferredTo(ctx, &ent.Notification{Category: "marketing"}, day))
+	require.Nil(t, uc.deferredTo(ctx, &ent.Notification{}, night))
+	require.Nil(t, uc.deferredTo(ctx, &ent.Notification{Category: "broken"}, night))
+}
@@ -0,0 +1,228 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "notifications/api/notification/v1"
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/pkg/logger"
+	"notifications/internal/pkg/metrics"
+
+	"github.com/AlekSi/pointer"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	metricScheduleSaveSuccess = `biz.schedule.save.success`
+	metricScheduleSaveFailure = `biz.schedule.save.failure`
+	metricScheduleSaveTimings = `biz.schedule.save.timings`
+
+	metricMaterializeSuccess = `biz.schedule.materialize.success`
+	metricMaterializeFailure = `biz.schedule.materialize.failure`
+	metricMaterializeTimings = `biz.schedule.materialize.timings`
+
+	metricMaterializeNotifications = `biz.schedule.materialize.notifications`
+	metricMaterializeSkipped       = `biz.schedule.materialize.skipped`
+)
+
+var (
+	ErrScheduleNotFound = errors.New(`schedule not found`)
+)
+
+type ScheduleRepo interface {
+	Create(ctx context.Context, s *ent.Schedule) (*ent.Schedule, error)
+
+	Update(ctx context.Context, s *ent.Schedule) (*ent.Schedule, error)
+
+	FindByID(ctx context.Context, id int) (*ent.Schedule, error)
+
+	DeleteByID(ctx context.Context, id int) error
+
+	ListBySenderID(ctx context.Context, senderID int, tenant string) ([]*ent.Schedule, error)
+
+	// ListDueWithLock returns schedules with occurrence before now locked until end of transaction
+	ListDueWithLock(ctx context.Context, now time.Time, limit int) ([]*ent.Schedule, error)
+}
+
+// ScheduleUsecase manages recurring notifications and materializes their occurrences into notifications
+type ScheduleUsecase struct {
+	repo          ScheduleRepo
+	transactor    Transactor
+	notifications *NotificationUsecase
+	metric        metrics.Metrics
+	logs          logger.Logger
+	now           func() time.Time
+}
+
+func NewScheduleUsecase(
+	repo ScheduleRepo,
+	transactor Transactor,
+	notifications *NotificationUsecase,
+	metric metrics.Metrics,
+	logs log.Logger,
+) *ScheduleUsecase {
+	return &ScheduleUsecase{
+		repo:          repo,
+		transactor:    transactor,
+		notifications: notifications,
+		metric:        metric,
+		logs:          logger.NewHelper(logs, "ts", log.DefaultTimestamp, "scope", "biz-schedule"),
+		now:           time.Now,
+	}
+}
+
+// Create saves schedule with its first occurrence after now
+func (uc *ScheduleUsecase) Create(ctx context.Context, s *ent.Schedule) (*ent.Schedule, error) {
+	return uc.save(ctx, s, uc.repo.Create)
+}
+
+// Update replaces schedule of sender, next occurrence is calculated again from now.
+// Schedule of other sender or tenant is not found
+func (uc *ScheduleUsecase) Update(ctx context.Context, s *ent.Schedule) (*ent.Schedule, error) {
+	if _, err := uc.Get(ctx, s.ID, s.SenderID, s.Tenant); err != nil {
+		return nil, err
+	}
+	return uc.save(ctx, s, uc.repo.Update)
+}
+
+// Get returns schedule of sender, schedule of other sender or tenant is not found
+func (uc *ScheduleUsecase) Get(ctx context.Context, id int, senderID int, tenant string) (*ent.Schedule, error) {
+	s, err := uc.repo.FindByID(ctx, id)
+	if ent.IsNotFound(err) || (err == nil && (s.SenderID != senderID || s.Tenant != tenant)) {
+		return nil, ErrScheduleNotFound
+	}
+	return s, err
+}
+
+// Delete deletes schedule of sender, schedule of other sender or tenant is not found
+func (uc *ScheduleUsecase) Delete(ctx context.Context, id int, senderID int, tenant string) error {
+	if _, err := uc.Get(ctx, id, senderID, tenant); err != nil {
+		return err
+	}
+	err := uc.repo.DeleteByID(ctx, id)
+	if ent.IsNotFound(err) {
+		return ErrScheduleNotFound
+	}
+	return err
+}
+
+// List returns schedules of sender and tenant
+func (uc *ScheduleUsecase) List(ctx context.Context, senderID int, tenant string) ([]*ent.Schedule, error) {
+	return uc.repo.ListBySenderID(ctx, senderID, tenant)
+}
+
+func (uc *ScheduleUsecase) save(
+	ctx context.Context,
+	s *ent.Schedule,
+	save func(context.Context, *ent.Schedule) (*ent.Schedule, error),
+) (*ent.Schedule, error) {
+	defer uc.metric.NewTiming().Send(metricScheduleSaveTimings)
+	next, err := NextOccurrence(s, uc.now())
+	if err == nil {
+		s.NextRunAt = next
+		s, err = save(ctx, s)
+	}
+	if ent.IsNotFound(err) {
+		err = ErrScheduleNotFound
+	}
+	if err != nil {
+		uc.metric.Increment(metricScheduleSaveFailure)
+		uc.logs.WithContext(ctx).Errorf("failed to save schedule: %v", err)
+		return nil, err
+	}
+	uc.metric.Increment(metricScheduleSaveSuccess)
+	return s, nil
+}
+
+// Materialize enqueues notifications of due occurrences of schedules and moves schedules to their next occurrences.
+// Schedules are locked in the same transaction, so every occurrence is materialized once by any of workers.
+// Occurrences missed while workers were down are collapsed into one notification.
+// Occurrences are enqueued as requests of sender: suppressions, dedup window, delivery window and quotas apply,
+// occurrence rejected by quota or suppression is skipped
+func (uc *ScheduleUsecase) Materialize(ctx context.Context, limit int) (int, error) {
+	defer uc.metric.NewTiming().Send(metricMaterializeTimings)
+	materialized := 0
+	err := uc.transactor.Transaction(
+		ctx, nil, func(ctx context.Context) error {
+			now := uc.now()
+			due, err := uc.repo.ListDueWithLock(ctx, now, limit)
+			if err != nil {
+				return err
+			}
+			for _, s := range due {
+				occurrence := *s.NextRunAt
+				if s.EndsAt == nil || !occurrence.After(*s.EndsAt) {
+					_, err = uc.notifications.EnqueueNotification(ctx, notificationOfSchedule(s, occurrence))
+					switch {
+					case errors.Is(err, ErrQuotaExceeded) || errors.Is(err, ErrRecipientSuppressed):
+						uc.metric.Increment(metricMaterializeSkipped)
+						uc.logs.WithContext(ctx).Warnf(`occurrence of schedule %d is skipped: %v`, s.ID, err)
+					case err != nil:
+						return fmt.Errorf(`failed to enqueue notification of schedule %d: %w`, s.ID, err)
+					default:
+						materialized++
+					}
+				}
+				after := occurrence
+				if now.After(after) {
+					after = now
+				}
+				if s.NextRunAt, err = NextOccurrence(s, after); err != nil {
+					// Schedule is saved with valid expression, so it is only finished on error
+					uc.logs.WithContext(ctx).Errorf(`failed to get next occurrence of schedule %d: %v`, s.ID, err)
+				}
+				s.LastRunAt = pointer.ToTime(occurrence)
+				if _, err = uc.repo.Update(ctx, s); err != nil {
+					return fmt.Errorf(`failed to move schedule %d to next occurrence: %w`, s.ID, err)
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		uc.metric.Increment(metricMaterializeFailure)
+		uc.logs.WithContext(ctx).Errorf("failed to materialize schedules: %v", err)
+		return 0, err
+	}
+	uc.metric.Increment(metricMaterializeSuccess)
+	uc.metric.Count(metricMaterializeNotifications, materialized)
+	return materialized, nil
+}
+
+// NextOccurrence returns first occurrence of schedule after time or nil if schedule is finished
+func NextOccurrence(s *ent.Schedule, after time.Time) (*time.Time, error) {
+	expression, err := schema.CronParser.Parse(s.Cron)
+	if err != nil {
+		return nil, err
+	}
+	location, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	next := expression.Next(after.In(location))
+	if next.IsZero() || (s.EndsAt != nil && next.After(*s.EndsAt)) {
+		return nil, nil
+	}
+	return &next, nil
+}
+
+// notificationOfSchedule returns request of sender to enqueue notification of occurrence
+func notificationOfSchedule(s *ent.Schedule, occurrence time.Time) *NotificationInDTO {
+	payload := s.Payload
+	return &NotificationInDTO{
+		SendType:     v1.Type(v1.Type_value[s.Type.String()]),
+		SenderID:     int64(s.SenderID),
+		Tenant:       s.Tenant,
+		Payload:      &payload,
+		TTL:          s.TTL,
+		PlannedAt:    pointer.ToTime(occurrence),
+		Priority:     s.Priority,
+		Category:     s.Category,
+		ScheduleID:   pointer.ToInt(s.ID),
+		OccurrenceAt: pointer.ToTime(occurrence),
+	}
+}
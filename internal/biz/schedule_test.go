@@ -0,0 +1,292 @@
+package biz
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/conf"
+	"notifications/internal/pkg/metrics"
+
+	"github.com/AlekSi/pointer"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/require"
+)
+
+type scheduleRepoStub struct {
+	ScheduleRepo
+	schedules []*ent.Schedule
+	updated   []*ent.Schedule
+}
+
+func (r *scheduleRepoStub) ListDueWithLock(_ context.Context, now time.Time, limit int) ([]*ent.Schedule, error) {
+	due := []*ent.Schedule{}
+	for _, s := range r.schedules {
+		if s.NextRunAt != nil && !s.NextRunAt.After(now) && len(due) < limit {
+			due = append(due, s)
+		}
+	}
+	return due, nil
+}
+
+func (r *scheduleRepoStub) Update(_ context.Context, s *ent.Schedule) (*ent.Schedule, error) {
+	r.updated = append(r.updated, s)
+	return s, nil
+}
+
+func (r *scheduleRepoStub) FindByID(_ context.Context, id int) (*ent.Schedule, error) {
+	for _, s := range r.schedules {
+		if s.ID == id {
+			return s, nil
+		}
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+func (r *scheduleRepoStub) DeleteByID(_ context.Context, id int) error {
+	for i, s := range r.schedules {
+		if s.ID == id {
+			r.schedules = append(r.schedules[:i], r.schedules[i+1:]...)
+			return nil
+		}
+	}
+	return &ent.NotFoundError{}
+}
+
+type scheduledNotificationRepoStub struct {
+	NotificationRepo
+	created []*ent.Notification
+}
+
+func (r *scheduledNotificationRepoStub) Create(_ context.Context, n *ent.Notification) (*ent.Notification, error) {
+	r.created = append(r.created, n)
+	return n, nil
+}
+
+func (r *scheduledNotificationRepoStub) Transaction(
+	ctx context.Context,
+	_ *sql.TxOptions,
+	actions ...func(repoCtx context.Context) error,
+) error {
+	for _, action := range actions {
+		if err := action(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newScheduleUsecase(
+	t *testing.T,
+	schedules ScheduleRepo,
+	notifications NotificationRepo,
+	quotas QuotaRepo,
+) *ScheduleUsecase {
+	metric, err := metrics.New(``, `test`, true)
+	require.NoError(t, err)
+	logs := log.NewFilter(log.DefaultLogger, log.FilterLevel(log.LevelFatal))
+	return NewScheduleUsecase(
+		schedules,
+		notifications,
+		NewNotificationUsecase(
+			notifications,
+			quotas,
+			nil,
+			nil,
+			nil,
+			&conf.Biz{
+				Delivery: &conf.Biz_Delivery{
+					Common: &conf.Biz_Delivery_Window{Timezone: "UTC"},
+					Categories: map[string]*conf.Biz_Delivery_Window{
+						`evening`: {From: "13:00", To: "21:00"},
+					},
+				},
+			},
+			metric,
+			logs,
+		),
+		metric,
+		logs,
+	)
+}
+
+func TestNextOccurrence(t *testing.T) {
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+	after := time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC) // thursday, 15:00 in Moscow
+
+	testCases := []struct {
+		name     string
+		schedule *ent.Schedule
+		expected *time.Time
+		err      bool
+	}{
+		{
+			name:     "utc",
+			schedule: &ent.Schedule{Cron: "0 10 * * *"},
+			expected: pointer.ToTime(time.Date(2022, 9, 2, 10, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:     "timezone",
+			schedule: &ent.Schedule{Cron: "0 10 * * 1", Timezone: "Europe/Moscow"},
+			expected: pointer.ToTime(time.Date(2022, 9, 5, 10, 0, 0, 0, moscow)),
+		},
+		{
+			name:     "descriptor",
+			schedule: &ent.Schedule{Cron: "@hourly"},
+			expected: pointer.ToTime(time.Date(2022, 9, 1, 13, 0, 0, 0, time.UTC)),
+		},
+		{
+			name: "ended",
+			schedule: &ent.Schedule{
+				Cron:   "0 10 * * *",
+				EndsAt: pointer.ToTime(time.Date(2022, 9, 2, 9, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:     "invalid cron",
+			schedule: &ent.Schedule{Cron: "every day"},
+			err:      true,
+		},
+		{
+			name:     "invalid timezone",
+			schedule: &ent.Schedule{Cron: "@daily", Timezone: "Mars/Olympus"},
+			err:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				next, err := NextOccurrence(testCase.schedule, after)
+				if testCase.err {
+					require.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+				if testCase.expected == nil {
+					require.Nil(t, next)
+					return
+				}
+				require.NotNil(t, next)
+				require.True(t, testCase.expected.Equal(*next), "expected %s, got %s", testCase.expected, next)
+			},
+		)
+	}
+}
+
+func TestScheduleUsecase_Materialize(t *testing.T) {
+	now := time.Date(2022, 9, 1, 12, 30, 0, 0, time.UTC)
+	schedules := &scheduleRepoStub{
+		schedules: []*ent.Schedule{
+			{
+				ID:        1,
+				Type:      schema.TypeEmail,
+				Cron:      "0 * * * *",
+				NextRunAt: pointer.ToTime(time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)),
+			},
+			{
+				ID:        2,
+				Type:      schema.TypeSMS,
+				Cron:      "0 * * * *",
+				NextRunAt: pointer.ToTime(time.Date(2022, 9, 1, 9, 0, 0, 0, time.UTC)), // missed occurrences
+			},
+			{
+				ID:        3,
+				Type:      schema.TypeSMS,
+				Cron:      "0 * * * *",
+				NextRunAt: pointer.ToTime(time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)),
+				EndsAt:    pointer.ToTime(time.Date(2022, 9, 1, 11, 0, 0, 0, time.UTC)),
+			},
+			{
+				ID:        4,
+				Type:      schema.TypeSMS,
+				Cron:      "0 * * * *",
+				NextRunAt: pointer.ToTime(time.Date(2022, 9, 1, 13, 0, 0, 0, time.UTC)),
+			},
+			{
+				ID:        5,
+				SenderID:  42,
+				Type:      schema.TypeSMS,
+				Cron:      "0 * * * *",
+				NextRunAt: pointer.ToTime(time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)), // quota is exceeded
+			},
+			{
+				ID:        6,
+				Type:      schema.TypeSMS,
+				Category:  `evening`,
+				Cron:      "0 * * * *",
+				NextRunAt: pointer.ToTime(time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)), // outside of delivery window
+			},
+		},
+	}
+	notifications := &scheduledNotificationRepoStub{}
+	quotas := &quotaRepoStub{
+		quotas: []*ent.Quota{{SenderID: 42, Type: schema.TypeSMS, PerDay: 1}},
+		counts: map[time.Duration]int{quotaDayWindow: 1},
+		now:    now,
+	}
+
+	uc := newScheduleUsecase(t, schedules, notifications, quotas)
+	uc.now = func() time.Time { return now }
+	uc.notifications.quotas.now = uc.now
+
+	count, err := uc.Materialize(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+
+	require.Len(t, notifications.created, 3)
+	require.Equal(t, 1, *notifications.created[0].ScheduleID)
+	require.Equal(t, schema.StatusPending, notifications.created[0].Status)
+	require.True(t, time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC).Equal(notifications.created[0].PlannedAt))
+	require.Equal(t, 2, *notifications.created[1].ScheduleID)
+	require.True(t, time.Date(2022, 9, 1, 9, 0, 0, 0, time.UTC).Equal(notifications.created[1].PlannedAt))
+	require.Equal(t, 6, *notifications.created[2].ScheduleID)
+	require.True(t, time.Date(2022, 9, 1, 13, 0, 0, 0, time.UTC).Equal(notifications.created[2].PlannedAt))
+	require.True(
+		t,
+		time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC).Equal(*notifications.created[2].OccurrenceAt),
+		`notification is unique for occurrence, not for deferred planned time`,
+	)
+
+	require.Len(t, schedules.updated, 5)
+	require.True(t, time.Date(2022, 9, 1, 13, 0, 0, 0, time.UTC).Equal(*schedules.updated[0].NextRunAt))
+	require.True(t, time.Date(2022, 9, 1, 13, 0, 0, 0, time.UTC).Equal(*schedules.updated[1].NextRunAt))
+	require.Nil(t, schedules.updated[2].NextRunAt)
+	require.True(
+		t,
+		time.Date(2022, 9, 1, 13, 0, 0, 0, time.UTC).Equal(*schedules.updated[3].NextRunAt),
+		`schedule moves to next occurrence if occurrence is skipped by quota`,
+	)
+}
+
+func TestScheduleUsecase_OfSender(t *testing.T) {
+	ctx := context.Background()
+	schedules := &scheduleRepoStub{
+		schedules: []*ent.Schedule{
+			{ID: 1, SenderID: 42, Tenant: `acme`, Type: schema.TypeEmail, Cron: "0 * * * *"},
+		},
+	}
+	uc := newScheduleUsecase(t, schedules, &scheduledNotificationRepoStub{}, nil)
+
+	_, err := uc.Get(ctx, 1, 43, `acme`)
+	require.ErrorIs(t, err, ErrScheduleNotFound, `schedule of other sender is not found`)
+	_, err = uc.Get(ctx, 1, 42, `globex`)
+	require.ErrorIs(t, err, ErrScheduleNotFound, `schedule of other tenant is not found`)
+
+	_, err = uc.Update(ctx, &ent.Schedule{ID: 1, SenderID: 43, Tenant: `acme`, Cron: "0 * * * *"})
+	require.ErrorIs(t, err, ErrScheduleNotFound, `schedule of other sender is not taken over`)
+	require.Empty(t, schedules.updated)
+
+	require.ErrorIs(t, uc.Delete(ctx, 1, 43, `acme`), ErrScheduleNotFound)
+	require.Len(t, schedules.schedules, 1)
+
+	schedule, err := uc.Get(ctx, 1, 42, `acme`)
+	require.NoError(t, err)
+	require.Equal(t, 1, schedule.ID)
+	require.NoError(t, uc.Delete(ctx, 1, 42, `acme`))
+	require.Empty(t, schedules.schedules)
+}
@@ -0,0 +1,151 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/pkg/logger"
+	"notifications/internal/pkg/metrics"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	metricSuppressionSaveSuccess = `biz.suppression.save.success`
+	metricSuppressionSaveFailure = `biz.suppression.save.failure`
+	metricSuppressionSaveTimings = `biz.suppression.save.timings`
+)
+
+var (
+	ErrSuppressionNotFound = errors.New(`suppression not found`)
+)
+
+type SuppressionRepo interface {
+	Create(ctx context.Context, s *ent.Suppression) (*ent.Suppression, error)
+
+	Update(ctx context.Context, s *ent.Suppression) (*ent.Suppression, error)
+
+	FindByID(ctx context.Context, id int) (*ent.Suppression, error)
+
+	// FindByAddress returns suppression of sender of normalized address for notification type, expired suppression too
+	FindByAddress(
+		ctx context.Context,
+		senderID int,
+		tenant string,
+		notificationType schema.NotificationType,
+		address string,
+	) (*ent.Suppression, error)
+
+	DeleteByID(ctx context.Context, id int) error
+
+	// List returns suppressions of sender filtered by type and address if they are not empty
+	List(
+		ctx context.Context,
+		senderID int,
+		tenant string,
+		notificationType schema.NotificationType,
+		address string,
+	) ([]*ent.Suppression, error)
+}
+
+// SuppressionUsecase manages suppression list of recipients opted out of notification channels
+type SuppressionUsecase struct {
+	repo   SuppressionRepo
+	metric metrics.Metrics
+	logs   logger.Logger
+}
+
+func NewSuppressionUsecase(repo SuppressionRepo, metric metrics.Metrics, logs log.Logger) *SuppressionUsecase {
+	return &SuppressionUsecase{
+		repo:   repo,
+		metric: metric,
+		logs:   logger.NewHelper(logs, "ts", log.DefaultTimestamp, "scope", "biz-suppression"),
+	}
+}
+
+// Create adds recipient to suppression list of sender, existing suppression of the same address is replaced
+func (uc *SuppressionUsecase) Create(ctx context.Context, s *ent.Suppression) (*ent.Suppression, error) {
+	return uc.save(
+		ctx, s, func(ctx context.Context, s *ent.Suppression) (*ent.Suppression, error) {
+			existing, err := uc.repo.FindByAddress(ctx, s.SenderID, s.Tenant, s.Type, s.Address)
+			if ent.IsNotFound(err) {
+				return uc.repo.Create(ctx, s)
+			}
+			if err != nil {
+				return nil, err
+			}
+			s.ID = existing.ID
+			return uc.repo.Update(ctx, s)
+		},
+	)
+}
+
+// Update replaces suppression of sender by id, suppression of other sender or tenant is not found
+func (uc *SuppressionUsecase) Update(ctx context.Context, s *ent.Suppression) (*ent.Suppression, error) {
+	if _, err := uc.Get(ctx, s.ID, s.SenderID, s.Tenant); err != nil {
+		return nil, err
+	}
+	return uc.save(ctx, s, uc.repo.Update)
+}
+
+// Get returns suppression of sender, suppression of other sender or tenant is not found
+func (uc *SuppressionUsecase) Get(ctx context.Context, id int, senderID int, tenant string) (*ent.Suppression, error) {
+	s, err := uc.repo.FindByID(ctx, id)
+	if ent.IsNotFound(err) || (err == nil && (s.SenderID != senderID || s.Tenant != tenant)) {
+		return nil, ErrSuppressionNotFound
+	}
+	return s, err
+}
+
+// Delete deletes suppression of sender, suppression of other sender or tenant is not found
+func (uc *SuppressionUsecase) Delete(ctx context.Context, id int, senderID int, tenant string) error {
+	if _, err := uc.Get(ctx, id, senderID, tenant); err != nil {
+		return err
+	}
+	err := uc.repo.DeleteByID(ctx, id)
+	if ent.IsNotFound(err) {
+		return ErrSuppressionNotFound
+	}
+	return err
+}
+
+func (uc *SuppressionUsecase) List(
+	ctx context.Context,
+	senderID int,
+	tenant string,
+	notificationType schema.NotificationType,
+	address string,
+) ([]*ent.Suppression, error) {
+	if address != "" {
+		address = schema.NormalizeAddress(notificationType, address)
+	}
+	return uc.repo.List(ctx, senderID, tenant, notificationType, address)
+}
+
+func (uc *SuppressionUsecase) save(
+	ctx context.Context,
+	s *ent.Suppression,
+	save func(context.Context, *ent.Suppression) (*ent.Suppression, error),
+) (*ent.Suppression, error) {
+	defer uc.metric.NewTiming().Send(metricSuppressionSaveTimings)
+	s.Address = schema.NormalizeAddress(s.Type, s.Address)
+	s, err := save(ctx, s)
+	if ent.IsNotFound(err) {
+		err = ErrSuppressionNotFound
+	}
+	if err != nil {
+		uc.metric.Increment(metricSuppressionSaveFailure)
+		uc.logs.WithContext(ctx).Errorf("failed to save suppression: %v", err)
+		return nil, err
+	}
+	uc.metric.Increment(metricSuppressionSaveSuccess)
+	return s, nil
+}
+
+// IsSuppressionActive reports whether suppression is not expired at time
+func IsSuppressionActive(s *ent.Suppression, at time.Time) bool {
+	return s.ExpiresAt == nil || s.ExpiresAt.After(at)
+}
@@ -8,10 +8,12 @@ import (
 	"net/http"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/trace"
 
 	"notifications/internal/pkg/logger"
 	"notifications/internal/pkg/metrics"
 	"notifications/internal/pkg/template"
+	"notifications/internal/pkg/tracing"
 	"notifications/internal/pkg/transport"
 )
 
@@ -27,6 +29,8 @@ const (
 	metricSendSuccess = `clients.sms-aero.send.success`
 	metricSendFailure = `clients.sms-aero.send.failure`
 	metricSendTimings = `clients.sms-aero.send.timings`
+
+	spanSend = `clients.sms-aero.send`
 )
 
 type Client interface {
@@ -53,8 +57,10 @@ func New(authEmail, authAPIKey string, client transport.HTTPClient, metric metri
 
 func (c *SMSAero) Send(ctx context.Context, phone, text string) error {
 	defer c.metric.NewTiming().Send(metricSendTimings)
+	ctx, span := tracing.Start(ctx, spanSend, trace.SpanKindInternal)
 	var err error
 	defer func() {
+		tracing.End(span, err)
 		if err != nil {
 			c.metric.Increment(metricSendFailure)
 			c.logs.Errorf(`failed to send: %v`, err)
@@ -10,9 +10,11 @@ import (
 
 	"notifications/internal/pkg/logger"
 	"notifications/internal/pkg/metrics"
+	"notifications/internal/pkg/tracing"
 	"notifications/internal/pkg/transport"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -21,6 +23,8 @@ const (
 	metricSendMessageSuccess = `clients.telegram.sendMessage.success`
 	metricSendMessageFailure = `clients.telegram.sendMessage.failure`
 	metricSendMessageTimings = `clients.telegram.sendMessage.timings`
+
+	spanSendMessage = `clients.telegram.sendMessage`
 )
 
 type Client interface {
@@ -61,8 +65,10 @@ func New(botToken string, client transport.HTTPClient, metric metrics.Metrics, l
 
 func (t *Telegram) SendMessage(ctx context.Context, request SendMessageRequest) (*SendMessageResponse, error) {
 	defer t.metric.NewTiming().Send(metricSendMessageTimings)
+	ctx, span := tracing.Start(ctx, spanSendMessage, trace.SpanKindInternal)
 	var err error
 	defer func() {
+		tracing.End(span, err)
 		if err != nil {
 			t.metric.Increment(metricSendMessageFailure)
 			t.logs.Errorf(`failed to sendMessage: %v`, err)
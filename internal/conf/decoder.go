@@ -10,7 +10,8 @@ import (
 )
 
 const (
-	envKeywordRegex = `\${((.+?)(:.+?)*?)}`
+	// envKeywordRegex matches ${KEY} and ${KEY:default}, default may be empty and contain colons
+	envKeywordRegex = `\$\{([^:}]+)(:([^}]*))?\}`
 )
 
 func EnvDecoder(kv *config.KeyValue, v map[string]any) error {
@@ -20,9 +21,13 @@ func EnvDecoder(kv *config.KeyValue, v map[string]any) error {
 
 func replaceEnv(configData []byte) []byte {
 	for _, match := range regexp.MustCompile(envKeywordRegex).FindAllSubmatch(configData, -1) {
-		key := string(match[2])
-		value := os.Getenv(key)
-		if value != "" {
+		value, isSet := os.LookupEnv(string(match[1]))
+		if !isSet && match[2] != nil {
+			// Default is set before unmarshalling, so it has type of yaml value as variable has
+			value, isSet = string(match[3]), true
+		}
+		// Variable set to empty string overrides default, e.g. to disable server by empty address
+		if isSet {
 			configData = bytes.Replace(configData, match[0], []byte(value), 1)
 		}
 	}
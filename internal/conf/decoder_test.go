@@ -0,0 +1,62 @@
+package conf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceEnv(t *testing.T) {
+	t.Setenv("CONF_TEST_SET", "value")
+	t.Setenv("CONF_TEST_EMPTY", "")
+
+	testCases := []struct {
+		name     string
+		data     string
+		expected string
+	}{
+		{
+			name:     "set",
+			data:     `key: ${CONF_TEST_SET:default}`,
+			expected: `key: value`,
+		},
+		{
+			name:     "default",
+			data:     `listen: ${CONF_TEST_UNSET:true}`,
+			expected: `listen: true`,
+		},
+		{
+			name:     "empty-overrides-default",
+			data:     `addr: ${CONF_TEST_EMPTY:0.0.0.0:8001}`,
+			expected: `addr: `,
+		},
+		{
+			name:     "empty-default",
+			data:     `endpoint: ${CONF_TEST_UNSET:}`,
+			expected: `endpoint: `,
+		},
+		{
+			name:     "set-with-empty-default",
+			data:     `endpoint: ${CONF_TEST_SET:}`,
+			expected: `endpoint: value`,
+		},
+		{
+			name:     "default-with-colons",
+			data:     `addr: ${CONF_TEST_UNSET:0.0.0.0:8001}`,
+			expected: `addr: 0.0.0.0:8001`,
+		},
+		{
+			name:     "without-default",
+			data:     `secret: ${CONF_TEST_UNSET}`,
+			expected: `secret: ${CONF_TEST_UNSET}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				require.Equal(t, testCase.expected, string(replaceEnv([]byte(testCase.data))))
+			},
+		)
+	}
+}
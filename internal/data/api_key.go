@@ -0,0 +1,95 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/apikey"
+	"notifications/internal/biz"
+	"notifications/internal/pkg/metrics"
+)
+
+const (
+	metricAPIKeyCreateTimings        = `data.apiKey.create.timings`
+	metricAPIKeyFindByIDTimings      = `data.apiKey.findById.timings`
+	metricAPIKeyFindByHashTimings    = `data.apiKey.findByHash.timings`
+	metricAPIKeyRevokeTimings        = `data.apiKey.revoke.timings`
+	metricAPIKeyTouchLastUsedTimings = `data.apiKey.touchLastUsed.timings`
+	metricAPIKeyListBySenderTimings  = `data.apiKey.listBySender.timings`
+)
+
+type apiKeyRepo struct {
+	data   Database
+	metric metrics.Metrics
+}
+
+// NewAPIKeyRepo .
+func NewAPIKeyRepo(data Database, metric metrics.Metrics) biz.APIKeyRepo {
+	return &apiKeyRepo{
+		data:   data,
+		metric: metric,
+	}
+}
+
+func (r *apiKeyRepo) Create(ctx context.Context, k *ent.APIKey) (*ent.APIKey, error) {
+	defer r.metric.NewTiming().Send(metricAPIKeyCreateTimings)
+	if k == nil {
+		return nil, errors.New("api key is empty")
+	}
+	return r.client(ctx).APIKey.Create().
+		SetSenderID(k.SenderID).
+		SetTenant(k.Tenant).
+		SetName(k.Name).
+		SetPrefix(k.Prefix).
+		SetHash(k.Hash).
+		SetScopes(k.Scopes).
+		SetChannels(k.Channels).
+		SetNillableExpiresAt(k.ExpiresAt).
+		Save(ctx)
+}
+
+func (r *apiKeyRepo) FindByID(ctx context.Context, id int) (*ent.APIKey, error) {
+	defer r.metric.NewTiming().Send(metricAPIKeyFindByIDTimings)
+	return r.client(ctx).APIKey.Get(ctx, id)
+}
+
+func (r *apiKeyRepo) FindByHash(ctx context.Context, hash string) (*ent.APIKey, error) {
+	defer r.metric.NewTiming().Send(metricAPIKeyFindByHashTimings)
+	return r.client(ctx).APIKey.Query().
+		Where(apikey.Hash(hash)).
+		Only(ctx)
+}
+
+func (r *apiKeyRepo) Revoke(ctx context.Context, id int, at time.Time) (*ent.APIKey, error) {
+	defer r.metric.NewTiming().Send(metricAPIKeyRevokeTimings)
+	return r.client(ctx).APIKey.UpdateOneID(id).
+		SetRevokedAt(at).
+		Save(ctx)
+}
+
+func (r *apiKeyRepo) TouchLastUsed(ctx context.Context, id int, at time.Time) error {
+	defer r.metric.NewTiming().Send(metricAPIKeyTouchLastUsedTimings)
+	return r.client(ctx).APIKey.UpdateOneID(id).
+		SetLastUsedAt(at).
+		Exec(ctx)
+}
+
+func (r *apiKeyRepo) ListBySender(ctx context.Context, senderID int, tenant string) ([]*ent.APIKey, error) {
+	defer r.metric.NewTiming().Send(metricAPIKeyListBySenderTimings)
+	return r.client(ctx).APIKey.Query().
+		Where(
+			apikey.SenderID(senderID),
+			apikey.Tenant(tenant),
+		).
+		Order(ent.Asc(apikey.FieldID)).
+		All(ctx)
+}
+
+func (r *apiKeyRepo) client(ctx context.Context) *ent.Client {
+	if client := ent.FromContext(ctx); client != nil {
+		return client
+	}
+	return r.data.Ent()
+}
@@ -0,0 +1,104 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"notifications/ent"
+	"notifications/ent/channelcredential"
+	"notifications/ent/schema"
+	"notifications/internal/biz"
+	"notifications/internal/pkg/metrics"
+)
+
+const (
+	metricChannelCredentialCreateTimings              = `data.channelCredential.create.timings`
+	metricChannelCredentialUpdateTimings              = `data.channelCredential.update.timings`
+	metricChannelCredentialFindByTenantAndTypeTimings = `data.channelCredential.findByTenantAndType.timings`
+	metricChannelCredentialListByTenantTimings        = `data.channelCredential.listByTenant.timings`
+	metricChannelCredentialDeleteTimings              = `data.channelCredential.delete.timings`
+)
+
+type channelCredentialRepo struct {
+	data   Database
+	metric metrics.Metrics
+}
+
+// NewChannelCredentialRepo .
+func NewChannelCredentialRepo(data Database, metric metrics.Metrics) biz.ChannelCredentialRepo {
+	return &channelCredentialRepo{
+		data:   data,
+		metric: metric,
+	}
+}
+
+func (r *channelCredentialRepo) Create(
+	ctx context.Context,
+	c *ent.ChannelCredential,
+) (*ent.ChannelCredential, error) {
+	defer r.metric.NewTiming().Send(metricChannelCredentialCreateTimings)
+	if c == nil {
+		return nil, errors.New("channel credential is empty")
+	}
+	return r.client(ctx).ChannelCredential.Create().
+		SetTenant(c.Tenant).
+		SetType(c.Type).
+		SetCredentials(c.Credentials).
+		Save(ctx)
+}
+
+func (r *channelCredentialRepo) Update(
+	ctx context.Context,
+	c *ent.ChannelCredential,
+) (*ent.ChannelCredential, error) {
+	defer r.metric.NewTiming().Send(metricChannelCredentialUpdateTimings)
+	if c == nil {
+		return nil, errors.New("channel credential is empty")
+	}
+	return r.client(ctx).ChannelCredential.UpdateOneID(c.ID).
+		SetCredentials(c.Credentials).
+		Save(ctx)
+}
+
+func (r *channelCredentialRepo) FindByTenantAndType(
+	ctx context.Context,
+	tenant string,
+	notificationType schema.NotificationType,
+) (*ent.ChannelCredential, error) {
+	defer r.metric.NewTiming().Send(metricChannelCredentialFindByTenantAndTypeTimings)
+	return r.client(ctx).ChannelCredential.Query().
+		Where(
+			channelcredential.Tenant(tenant),
+			channelcredential.TypeEQ(notificationType),
+		).
+		Only(ctx)
+}
+
+func (r *channelCredentialRepo) ListByTenant(ctx context.Context, tenant string) ([]*ent.ChannelCredential, error) {
+	defer r.metric.NewTiming().Send(metricChannelCredentialListByTenantTimings)
+	return r.client(ctx).ChannelCredential.Query().
+		Where(channelcredential.Tenant(tenant)).
+		Order(ent.Asc(channelcredential.FieldType)).
+		All(ctx)
+}
+
+func (r *channelCredentialRepo) Delete(
+	ctx context.Context,
+	tenant string,
+	notificationType schema.NotificationType,
+) (int, error) {
+	defer r.metric.NewTiming().Send(metricChannelCredentialDeleteTimings)
+	return r.client(ctx).ChannelCredential.Delete().
+		Where(
+			channelcredential.Tenant(tenant),
+			channelcredential.TypeEQ(notificationType),
+		).
+		Exec(ctx)
+}
+
+func (r *channelCredentialRepo) client(ctx context.Context) *ent.Client {
+	if client := ent.FromContext(ctx); client != nil {
+		return client
+	}
+	return r.data.Ent()
+}
@@ -24,10 +24,21 @@ const (
 )
 
 // ProviderRepoSet is data providers.
-var ProviderRepoSet = wire.NewSet(NewNotificationRepo)
+var ProviderRepoSet = wire.NewSet(
+	NewNotificationRepo,
+	NewTransactor,
+	NewQuotaRepo,
+	NewScheduleRepo,
+	NewSuppressionRepo,
+	NewAPIKeyRepo,
+	NewChannelCredentialRepo,
+	NewRateLimiter,
+)
 
 var ProviderDataSet = wire.NewSet(NewData)
 
+var ProviderListenerSet = wire.NewSet(NewNotificationListener)
+
 // Data .
 type Data struct {
 	db     *sql.DB
@@ -98,7 +109,7 @@ func (d *Data) Ent() *ent.Client {
 }
 
 func (d *Data) Check(ctx context.Context) error {
-	_, err := d.db.QueryContext(ctx, `select now()`)
+	_, err := d.db.ExecContext(ctx, `select now()`)
 	return err
 }
 
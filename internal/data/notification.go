@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"notifications/ent"
+	"notifications/ent/notification"
 	"notifications/ent/schema"
 	"notifications/internal/biz"
 	"notifications/internal/pkg/logger"
@@ -24,6 +25,12 @@ const (
 	metricCountWaitingNotificationsTimings        = `data.notification.countWaitingNotifications.timings`
 	metricListWaitingNotificationsWithLockTimings = `data.notification.listWaitingNotificationsWithLock.timings`
 	metricTransactionTimings                      = `data.notification.transaction.timings`
+	metricNotifyWaitingTimings                    = `data.notification.notifyWaiting.timings`
+	metricQueueDepthTimings                       = `data.notification.queueDepth.timings`
+	metricFindDuplicateTimings                    = `data.notification.findDuplicate.timings`
+	metricListHeldTimings                         = `data.notification.listHeld.timings`
+	metricListDueHeldWithLockTimings              = `data.notification.listDueHeldWithLock.timings`
+	metricListDueHeldOfDigestWithLockTimings      = `data.notification.listDueHeldOfDigestWithLock.timings`
 )
 
 type notificationRepo struct {
@@ -41,23 +48,56 @@ func NewNotificationRepo(data Database, logs log.Logger, metric metrics.Metrics)
 	}
 }
 
+// NewTransactor returns transaction of notification repo, other repos use transaction of context too
+func NewTransactor(notifications biz.NotificationRepo) biz.Transactor {
+	return notifications
+}
+
 func (r *notificationRepo) Create(ctx context.Context, n *ent.Notification) (*ent.Notification, error) {
 	defer r.metric.NewTiming().Send(metricSaveTimings)
 	if n == nil {
 		return nil, errors.New("notification is empty")
 	}
 
-	return r.client(ctx).Notification.Create().
+	created := r.client(ctx).Notification.Create().
 		SetSenderID(n.SenderID).
+		SetTenant(n.Tenant).
 		SetType(n.Type).
 		SetPayload(n.Payload).
 		SetTTL(n.TTL).
 		SetStatus(n.Status).
 		SetPlannedAt(n.PlannedAt).
 		SetRetries(n.Retries).
+		SetPriority(n.Priority).
+		SetCategory(n.Category).
+		SetDedupKey(n.DedupKey).
+		SetDigestKey(n.DigestKey).
+		SetLocale(n.Locale).
 		SetNillableSentAt(n.SentAt).
 		SetNillableRetryAt(n.RetryAt).
-		Save(ctx)
+		SetNillableOriginalID(n.OriginalID).
+		SetNillableDigestID(n.DigestID).
+		SetNillableScheduleID(n.ScheduleID).
+		SetNillableOccurrenceAt(n.OccurrenceAt)
+
+	if n.RetryPolicy != nil {
+		created.SetRetryPolicy(n.RetryPolicy)
+	}
+
+	if n.DeliveryWindow != nil {
+		created.SetDeliveryWindow(n.DeliveryWindow)
+	}
+
+	if len(n.TraceContext) > 0 {
+		created.SetTraceContext(n.TraceContext)
+	}
+
+	saved, err := created.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r.notifyWaiting(ctx, saved)
+	return saved, nil
 }
 
 // Update all fields of notification record. CAUTION: if field in 'n' not set — it will be cleared
@@ -69,12 +109,18 @@ func (r *notificationRepo) Update(ctx context.Context, n *ent.Notification) (*en
 
 	updated := r.client(ctx).Notification.UpdateOne(n).
 		SetSenderID(n.SenderID).
+		SetTenant(n.Tenant).
 		SetType(n.Type).
 		SetPayload(n.Payload).
 		SetTTL(n.TTL).
 		SetStatus(n.Status).
 		SetPlannedAt(n.PlannedAt).
-		SetRetries(n.Retries)
+		SetRetries(n.Retries).
+		SetPriority(n.Priority).
+		SetCategory(n.Category).
+		SetDedupKey(n.DedupKey).
+		SetDigestKey(n.DigestKey).
+		SetLocale(n.Locale)
 
 	if n.SentAt != nil {
 		updated.SetSentAt(*n.SentAt)
@@ -88,7 +134,66 @@ func (r *notificationRepo) Update(ctx context.Context, n *ent.Notification) (*en
 		updated.ClearRetryAt()
 	}
 
-	return updated.Save(ctx)
+	if n.RetryPolicy != nil {
+		updated.SetRetryPolicy(n.RetryPolicy)
+	} else {
+		updated.ClearRetryPolicy()
+	}
+
+	if n.DeliveryWindow != nil {
+		updated.SetDeliveryWindow(n.DeliveryWindow)
+	} else {
+		updated.ClearDeliveryWindow()
+	}
+
+	if n.LeaseUntil != nil {
+		updated.SetLeaseUntil(*n.LeaseUntil)
+	} else {
+		updated.ClearLeaseUntil()
+	}
+
+	if n.WorkerID != nil {
+		updated.SetWorkerID(*n.WorkerID)
+	} else {
+		updated.ClearWorkerID()
+	}
+
+	if n.OriginalID != nil {
+		updated.SetOriginalID(*n.OriginalID)
+	} else {
+		updated.ClearOriginalID()
+	}
+
+	if n.DigestID != nil {
+		updated.SetDigestID(*n.DigestID)
+	} else {
+		updated.ClearDigestID()
+	}
+
+	if n.ScheduleID != nil {
+		updated.SetScheduleID(*n.ScheduleID)
+	} else {
+		updated.ClearScheduleID()
+	}
+
+	if n.OccurrenceAt != nil {
+		updated.SetOccurrenceAt(*n.OccurrenceAt)
+	} else {
+		updated.ClearOccurrenceAt()
+	}
+
+	if len(n.TraceContext) > 0 {
+		updated.SetTraceContext(n.TraceContext)
+	} else {
+		updated.ClearTraceContext()
+	}
+
+	saved, err := updated.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r.notifyWaiting(ctx, saved)
+	return saved, nil
 }
 
 func (r *notificationRepo) FindByID(ctx context.Context, id int) (*ent.Notification, error) {
@@ -102,30 +207,149 @@ func (r *notificationRepo) DeleteByID(ctx context.Context, id int) error {
 	return err
 }
 
-func (r *notificationRepo) CountWaitingNotifications(ctx context.Context) (int, error) {
+func (r *notificationRepo) CountWaitingNotifications(ctx context.Context, types []schema.NotificationType) (
+	int,
+	error,
+) {
 	defer r.metric.NewTiming().Send(metricCountWaitingNotificationsTimings)
 	return r.client(ctx).Notification.Query().
 		Where(
-			FilterByStatus(schema.StatusPending, schema.StatusRetry),
-			FilterByType(schema.Types...),
-			FilterByPlannedAtOrRetryAt(time.Now()),
+			FilterWaiting(time.Now()),
+			FilterByType(typesOrAll(types)...),
 		).
 		Count(ctx)
 }
 
-func (r *notificationRepo) ListWaitingNotificationsWithLock(ctx context.Context, limit int) (
+// QueueDepth aggregates notifications of statuses by status and type
+func (r *notificationRepo) QueueDepth(ctx context.Context, statuses []schema.NotificationStatus) (
+	[]*biz.QueueDepth,
+	error,
+) {
+	defer r.metric.NewTiming().Send(metricQueueDepthTimings)
+	var rows []struct {
+		Status          schema.NotificationStatus `json:"status"`
+		Type            schema.NotificationType   `json:"type"`
+		Count           int                       `json:"count"`
+		OldestPlannedAt time.Time                 `json:"oldest_planned_at"`
+	}
+	err := r.client(ctx).Notification.Query().
+		Where(FilterByStatus(statuses...)).
+		GroupBy(notification.FieldStatus, notification.FieldType).
+		Aggregate(
+			ent.As(ent.Count(), `count`),
+			ent.As(ent.Min(notification.FieldPlannedAt), `oldest_planned_at`),
+		).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	depths := make([]*biz.QueueDepth, 0, len(rows))
+	for _, row := range rows {
+		depths = append(
+			depths, &biz.QueueDepth{
+				Status:          row.Status,
+				Type:            row.Type,
+				Count:           row.Count,
+				OldestPlannedAt: row.OldestPlannedAt,
+			},
+		)
+	}
+	return depths, nil
+}
+
+func (r *notificationRepo) FindDuplicate(
+	ctx context.Context,
+	senderID int,
+	tenant string,
+	dedupKey string,
+	since time.Time,
+) (*ent.Notification, error) {
+	defer r.metric.NewTiming().Send(metricFindDuplicateTimings)
+	return r.client(ctx).Notification.Query().
+		Where(
+			notification.SenderID(senderID),
+			notification.Tenant(tenant),
+			notification.DedupKey(dedupKey),
+			notification.CreatedAtGTE(since),
+			notification.StatusNEQ(schema.StatusSuppressed),
+		).
+		Order(ent.Asc(notification.FieldCreatedAt)).
+		First(ctx)
+}
+
+func (r *notificationRepo) ListHeld(ctx context.Context, senderID int, digestKey string) (
+	[]*ent.Notification,
+	error,
+) {
+	defer r.metric.NewTiming().Send(metricListHeldTimings)
+	return r.client(ctx).Notification.Query().
+		Where(
+			notification.SenderID(senderID),
+			notification.DigestKey(digestKey),
+			notification.StatusEQ(schema.StatusHeld),
+		).
+		Order(ent.Asc(notification.FieldID)).
+		All(ctx)
+}
+
+func (r *notificationRepo) ListDueHeldWithLock(ctx context.Context, now time.Time, limit int) (
+	[]*ent.Notification,
+	error,
+) {
+	defer r.metric.NewTiming().Send(metricListDueHeldWithLockTimings)
+	return r.client(ctx).Notification.Query().
+		Where(
+			notification.StatusEQ(schema.StatusHeld),
+			notification.PlannedAtLTE(now),
+			FilterForUpdateWithSkipLocked(),
+		).
+		Order(ent.Asc(notification.FieldPlannedAt), ent.Asc(notification.FieldID)).
+		Limit(limit).
+		Unique(false). // Cause: FOR UPDATE is not allowed with DISTINCT clause
+		All(ctx)
+}
+
+func (r *notificationRepo) ListDueHeldOfDigestWithLock(
+	ctx context.Context,
+	senderID int,
+	digestKey string,
+	now time.Time,
+) (
+	[]*ent.Notification,
+	error,
+) {
+	defer r.metric.NewTiming().Send(metricListDueHeldOfDigestWithLockTimings)
+	return r.client(ctx).Notification.Query().
+		Where(
+			notification.SenderID(senderID),
+			notification.DigestKey(digestKey),
+			notification.StatusEQ(schema.StatusHeld),
+			notification.PlannedAtLTE(now),
+			FilterForUpdateWithSkipLocked(),
+		).
+		Order(ent.Asc(notification.FieldID)).
+		Unique(false). // Cause: FOR UPDATE is not allowed with DISTINCT clause
+		All(ctx)
+}
+
+func (r *notificationRepo) ListWaitingNotificationsWithLock(
+	ctx context.Context,
+	limit int,
+	types []schema.NotificationType,
+	priorities biz.PriorityRange,
+) (
 	[]*ent.Notification,
 	error,
 ) {
 	defer r.metric.NewTiming().Send(metricListWaitingNotificationsWithLockTimings)
 	return r.client(ctx).Notification.Query().
 		Where(
-			FilterByStatus(schema.StatusPending, schema.StatusRetry),
-			FilterByType(schema.Types...),
-			FilterByPlannedAtOrRetryAt(time.Now()),
+			FilterWaiting(time.Now()),
+			FilterByType(typesOrAll(types)...),
+			FilterByPriority(priorities.Min, priorities.Max),
 			FilterForUpdateWithSkipLocked(),
 		).
-		Order(OrderByCreatedAt()).
+		Order(OrderByPriority(), OrderByCreatedAt()).
 		Limit(limit).
 		Unique(false). // Cause: FOR UPDATE is not allowed with DISTINCT clause
 		All(ctx)
@@ -170,6 +394,28 @@ func (r *notificationRepo) Transaction(
 	return nil
 }
 
+// notifyWaiting wakes listening workers if notification may be sent right now.
+// Notifications planned to the future are found by workers with fallback polling
+func (r *notificationRepo) notifyWaiting(ctx context.Context, n *ent.Notification) {
+	if !isReadyToSend(n, time.Now()) {
+		return
+	}
+	defer r.metric.NewTiming().Send(metricNotifyWaitingTimings)
+	// Notification of transaction is delivered on commit, so workers do not wake before it is visible
+	_, err := r.client(ctx).ExecContext(ctx, `select pg_notify($1, $2)`, NotifyChannel, n.Type.String())
+	if err != nil {
+		r.logs.WithContext(ctx).Warnf(`failed to notify about waiting notification with id %d: %v`, n.ID, err)
+	}
+}
+
+// typesOrAll returns all known types if types are empty
+func typesOrAll(types []schema.NotificationType) []schema.NotificationType {
+	if len(types) == 0 {
+		return schema.Types
+	}
+	return types
+}
+
 // client return client by tx in context if it exists or default ent client
 func (r *notificationRepo) client(ctx context.Context) *ent.Client {
 	if client := ent.FromContext(ctx); client != nil {
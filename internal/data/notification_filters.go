@@ -49,6 +49,42 @@ func FilterByPlannedAtOrRetryAt(now time.Time) predicate.Notification {
 	}
 }
 
+func FilterByPriority(min, max int) predicate.Notification {
+	return func(selector *entSql.Selector) {
+		selector.Where(
+			entSql.And(
+				entSql.GTE(`priority`, min),
+				entSql.LTE(`priority`, max),
+			),
+		)
+	}
+}
+
+// FilterWaiting matches notifications ready to send: pending or retry by schedule and processing with expired lease
+func FilterWaiting(now time.Time) predicate.Notification {
+	return func(selector *entSql.Selector) {
+		selector.
+			Where(
+				entSql.Or(
+					entSql.And(
+						entSql.In(`status`, schema.StatusPending, schema.StatusRetry),
+						entSql.Or(
+							entSql.And(
+								entSql.IsNull(`retry_at`),
+								entSql.LTE(`planned_at`, now),
+							),
+							entSql.LTE(`retry_at`, now),
+						),
+					),
+					entSql.And(
+						entSql.EQ(`status`, schema.StatusProcessing),
+						entSql.LTE(`lease_until`, now),
+					),
+				),
+			)
+	}
+}
+
 func FilterForUpdateWithSkipLocked() predicate.Notification {
 	return func(selector *entSql.Selector) {
 		selector.ForUpdate(entSql.WithLockAction(entSql.SkipLocked))
@@ -0,0 +1,98 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/biz"
+	"notifications/internal/conf"
+	"notifications/internal/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/lib/pq"
+)
+
+const (
+	// NotifyChannel is postgres channel with types of notifications that became ready to send
+	NotifyChannel = `notifications_waiting`
+
+	listenerMinReconnectInterval = time.Second
+	listenerMaxReconnectInterval = time.Minute
+	listenerPingInterval         = 90 * time.Second
+)
+
+type notificationListener struct {
+	source string
+	logs   *log.Helper
+}
+
+// NewNotificationListener .
+func NewNotificationListener(c *conf.Data, logs log.Logger) biz.NotificationListener {
+	return &notificationListener{
+		source: c.GetDatabase().GetSource(),
+		logs:   logger.NewHelper(logs, "ts", log.DefaultTimestamp, "scope", "data-notification-listener"),
+	}
+}
+
+// Listen delivers types of notifications from NotifyChannel until context is done.
+// Empty type is delivered after reconnect, because notifications may be lost while connection was broken
+func (l *notificationListener) Listen(ctx context.Context) (<-chan schema.NotificationType, error) {
+	listener := pq.NewListener(
+		l.source,
+		listenerMinReconnectInterval,
+		listenerMaxReconnectInterval,
+		func(event pq.ListenerEventType, err error) {
+			if err != nil {
+				l.logs.Warnf(`listener event %d: %v`, event, err)
+			}
+		},
+	)
+	if err := listener.Listen(NotifyChannel); err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+
+	types := make(chan schema.NotificationType)
+	go func() {
+		defer close(types)
+		defer func() {
+			if err := listener.Close(); err != nil {
+				l.logs.Errorf(`failed to close listener: %v`, err)
+			}
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case notification := <-listener.Notify:
+				notificationType := schema.NotificationType(``)
+				if notification != nil {
+					notificationType = schema.NotificationType(notification.Extra)
+				}
+				select {
+				case types <- notificationType:
+				case <-ctx.Done():
+					return
+				}
+			case <-time.After(listenerPingInterval):
+				if err := listener.Ping(); err != nil {
+					l.logs.Warnf(`failed to ping listener connection: %v`, err)
+				}
+			}
+		}
+	}()
+	return types, nil
+}
+
+// isReadyToSend returns true if notification is waiting and may be sent right now
+func isReadyToSend(n *ent.Notification, now time.Time) bool {
+	if n.Status != schema.StatusPending && n.Status != schema.StatusRetry {
+		return false
+	}
+	if n.RetryAt != nil {
+		return !n.RetryAt.After(now)
+	}
+	return !n.PlannedAt.After(now)
+}
@@ -2,6 +2,10 @@ package data
 
 import "notifications/ent"
 
+func OrderByPriority() ent.OrderFunc {
+	return ent.Desc(`priority`)
+}
+
 func OrderByCreatedAt() ent.OrderFunc {
 	return ent.Asc(`created_at`)
 }
@@ -0,0 +1,74 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/notification"
+	"notifications/ent/quota"
+	"notifications/ent/schema"
+	"notifications/internal/biz"
+	"notifications/internal/pkg/metrics"
+)
+
+const (
+	metricQuotaListBySenderIDTimings          = `data.quota.listBySenderId.timings`
+	metricQuotaFindBySenderIDAndTypeTimings   = `data.quota.findBySenderIdAndType.timings`
+	metricQuotaCountNotificationsSinceTimings = `data.quota.countNotificationsSince.timings`
+)
+
+type quotaRepo struct {
+	data   Database
+	metric metrics.Metrics
+}
+
+// NewQuotaRepo .
+func NewQuotaRepo(data Database, metric metrics.Metrics) biz.QuotaRepo {
+	return &quotaRepo{
+		data:   data,
+		metric: metric,
+	}
+}
+
+func (r *quotaRepo) ListBySenderID(ctx context.Context, senderID int) ([]*ent.Quota, error) {
+	defer r.metric.NewTiming().Send(metricQuotaListBySenderIDTimings)
+	return r.data.Ent().Quota.Query().
+		Where(quota.SenderID(senderID)).
+		Order(ent.Asc(quota.FieldType)).
+		All(ctx)
+}
+
+func (r *quotaRepo) FindBySenderIDAndType(
+	ctx context.Context,
+	senderID int,
+	notificationType schema.NotificationType,
+) (*ent.Quota, error) {
+	defer r.metric.NewTiming().Send(metricQuotaFindBySenderIDAndTypeTimings)
+	return r.data.Ent().Quota.Query().
+		Where(
+			quota.SenderID(senderID),
+			quota.TypeEQ(notificationType),
+		).
+		Only(ctx)
+}
+
+func (r *quotaRepo) CountNotificationsSince(
+	ctx context.Context,
+	senderID int,
+	tenant string,
+	notificationType schema.NotificationType,
+	since time.Time,
+) (int, error) {
+	defer r.metric.NewTiming().Send(metricQuotaCountNotificationsSinceTimings)
+	return r.data.Ent().Notification.Query().
+		Where(
+			notification.SenderID(senderID),
+			notification.Tenant(tenant),
+			notification.TypeEQ(notificationType),
+			notification.CreatedAtGTE(since),
+			// suppressed notifications are not sent, held and digested ones are sent as one digest counted itself
+			notification.StatusNotIn(schema.StatusSuppressed, schema.StatusHeld, schema.StatusDigested),
+		).
+		Count(ctx)
+}
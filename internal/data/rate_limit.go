@@ -0,0 +1,93 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"notifications/internal/conf"
+	"notifications/internal/pkg/metrics"
+	"notifications/internal/pkg/ratelimit"
+)
+
+const (
+	metricRateLimitReserveTimings = `data.rateLimit.reserve.timings`
+	metricRateLimitReleaseTimings = `data.rateLimit.release.timings`
+)
+
+// rateLimitRepo is token bucket limiter shared between worker instances through rate_limits table
+type rateLimitRepo struct {
+	data   Database
+	metric metrics.Metrics
+}
+
+// NewRateLimiter returns limiter by configured storage
+func NewRateLimiter(data Database, c *conf.Biz, metric metrics.Metrics) ratelimit.Limiter {
+	if c.GetRateLimit().GetStorage() == conf.Biz_RateLimit_postgres {
+		return &rateLimitRepo{
+			data:   data,
+			metric: metric,
+		}
+	}
+	return ratelimit.NewMemory()
+}
+
+//goland:noinspection SqlDialectInspection
+func (r *rateLimitRepo) Reserve(ctx context.Context, key string, limit ratelimit.Limit) (time.Duration, error) {
+	defer r.metric.NewTiming().Send(metricRateLimitReserveTimings)
+	if limit.Rate <= 0 {
+		return 0, nil
+	}
+	capacity := ratelimit.Capacity(limit)
+
+	_, err := r.data.DB().ExecContext(
+		ctx,
+		`insert into rate_limits (key, tokens, updated_at) values ($1, $2, now()) on conflict (key) do nothing`,
+		key,
+		capacity,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	// Refill and take token in one statement, row lock serializes concurrent workers
+	row := r.data.DB().QueryRowContext(
+		ctx,
+		`update rate_limits as bucket
+		set tokens = case when current.available >= 1 then current.available - 1 else current.available end,
+			updated_at = now()
+		from (
+			select key, least(
+				$2::float8,
+				tokens + greatest(extract(epoch from now() - updated_at)::float8, 0) * $3::float8
+			) as available
+			from rate_limits
+			where key = $1
+			for update
+		) as current
+		where bucket.key = current.key
+		returning current.available`,
+		key,
+		capacity,
+		limit.Rate,
+	)
+	var available float64
+	if err = row.Scan(&available); err != nil {
+		return 0, err
+	}
+	return ratelimit.Delay(available, limit), nil
+}
+
+//goland:noinspection SqlDialectInspection
+func (r *rateLimitRepo) Release(ctx context.Context, key string, limit ratelimit.Limit) error {
+	defer r.metric.NewTiming().Send(metricRateLimitReleaseTimings)
+	if limit.Rate <= 0 {
+		return nil
+	}
+	_, err := r.data.DB().ExecContext(
+		ctx,
+		`update rate_limits set tokens = least($2::float8, tokens + 1) where key = $1`,
+		key,
+		ratelimit.Capacity(limit),
+	)
+	return err
+}
@@ -0,0 +1,138 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/schedule"
+	"notifications/internal/biz"
+	"notifications/internal/pkg/metrics"
+
+	entSql "entgo.io/ent/dialect/sql"
+)
+
+const (
+	metricScheduleCreateTimings          = `data.schedule.create.timings`
+	metricScheduleUpdateTimings          = `data.schedule.update.timings`
+	metricScheduleFindByIDTimings        = `data.schedule.findById.timings`
+	metricScheduleDeleteByIDTimings      = `data.schedule.deleteById.timings`
+	metricScheduleListBySenderIDTimings  = `data.schedule.listBySenderId.timings`
+	metricScheduleListDueWithLockTimings = `data.schedule.listDueWithLock.timings`
+)
+
+type scheduleRepo struct {
+	data   Database
+	metric metrics.Metrics
+}
+
+// NewScheduleRepo .
+func NewScheduleRepo(data Database, metric metrics.Metrics) biz.ScheduleRepo {
+	return &scheduleRepo{
+		data:   data,
+		metric: metric,
+	}
+}
+
+func (r *scheduleRepo) Create(ctx context.Context, s *ent.Schedule) (*ent.Schedule, error) {
+	defer r.metric.NewTiming().Send(metricScheduleCreateTimings)
+	if s == nil {
+		return nil, errors.New("schedule is empty")
+	}
+	return r.client(ctx).Schedule.Create().
+		SetSenderID(s.SenderID).
+		SetTenant(s.Tenant).
+		SetType(s.Type).
+		SetPayload(s.Payload).
+		SetTTL(s.TTL).
+		SetPriority(s.Priority).
+		SetCategory(s.Category).
+		SetCron(s.Cron).
+		SetTimezone(s.Timezone).
+		SetNillableEndsAt(s.EndsAt).
+		SetNillableNextRunAt(s.NextRunAt).
+		SetNillableLastRunAt(s.LastRunAt).
+		Save(ctx)
+}
+
+// Update all fields of schedule record. CAUTION: if field in 's' not set — it will be cleared
+func (r *scheduleRepo) Update(ctx context.Context, s *ent.Schedule) (*ent.Schedule, error) {
+	defer r.metric.NewTiming().Send(metricScheduleUpdateTimings)
+	if s == nil {
+		return nil, errors.New("schedule is empty")
+	}
+	updated := r.client(ctx).Schedule.UpdateOneID(s.ID).
+		SetSenderID(s.SenderID).
+		SetTenant(s.Tenant).
+		SetType(s.Type).
+		SetPayload(s.Payload).
+		SetTTL(s.TTL).
+		SetPriority(s.Priority).
+		SetCategory(s.Category).
+		SetCron(s.Cron).
+		SetTimezone(s.Timezone)
+
+	if s.EndsAt != nil {
+		updated.SetEndsAt(*s.EndsAt)
+	} else {
+		updated.ClearEndsAt()
+	}
+
+	if s.NextRunAt != nil {
+		updated.SetNextRunAt(*s.NextRunAt)
+	} else {
+		updated.ClearNextRunAt()
+	}
+
+	if s.LastRunAt != nil {
+		updated.SetLastRunAt(*s.LastRunAt)
+	} else {
+		updated.ClearLastRunAt()
+	}
+
+	return updated.Save(ctx)
+}
+
+func (r *scheduleRepo) FindByID(ctx context.Context, id int) (*ent.Schedule, error) {
+	defer r.metric.NewTiming().Send(metricScheduleFindByIDTimings)
+	return r.client(ctx).Schedule.Get(ctx, id)
+}
+
+func (r *scheduleRepo) DeleteByID(ctx context.Context, id int) error {
+	defer r.metric.NewTiming().Send(metricScheduleDeleteByIDTimings)
+	return r.client(ctx).Schedule.DeleteOneID(id).Exec(ctx)
+}
+
+func (r *scheduleRepo) ListBySenderID(ctx context.Context, senderID int, tenant string) ([]*ent.Schedule, error) {
+	defer r.metric.NewTiming().Send(metricScheduleListBySenderIDTimings)
+	return r.client(ctx).Schedule.Query().
+		Where(
+			schedule.SenderID(senderID),
+			schedule.Tenant(tenant),
+		).
+		Order(ent.Asc(schedule.FieldID)).
+		All(ctx)
+}
+
+func (r *scheduleRepo) ListDueWithLock(ctx context.Context, now time.Time, limit int) ([]*ent.Schedule, error) {
+	defer r.metric.NewTiming().Send(metricScheduleListDueWithLockTimings)
+	return r.client(ctx).Schedule.Query().
+		Where(
+			schedule.NextRunAtLTE(now),
+			func(selector *entSql.Selector) {
+				selector.ForUpdate(entSql.WithLockAction(entSql.SkipLocked))
+			},
+		).
+		Order(ent.Asc(schedule.FieldNextRunAt)).
+		Limit(limit).
+		Unique(false). // Cause: FOR UPDATE is not allowed with DISTINCT clause
+		All(ctx)
+}
+
+func (r *scheduleRepo) client(ctx context.Context) *ent.Client {
+	if client := ent.FromContext(ctx); client != nil {
+		return client
+	}
+	return r.data.Ent()
+}
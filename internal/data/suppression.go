@@ -0,0 +1,128 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/ent/suppression"
+	"notifications/internal/biz"
+	"notifications/internal/pkg/metrics"
+)
+
+const (
+	metricSuppressionCreateTimings        = `data.suppression.create.timings`
+	metricSuppressionUpdateTimings        = `data.suppression.update.timings`
+	metricSuppressionFindByIDTimings      = `data.suppression.findById.timings`
+	metricSuppressionFindByAddressTimings = `data.suppression.findByAddress.timings`
+	metricSuppressionDeleteByIDTimings    = `data.suppression.deleteById.timings`
+	metricSuppressionListTimings          = `data.suppression.list.timings`
+)
+
+type suppressionRepo struct {
+	data   Database
+	metric metrics.Metrics
+}
+
+// NewSuppressionRepo .
+func NewSuppressionRepo(data Database, metric metrics.Metrics) biz.SuppressionRepo {
+	return &suppressionRepo{
+		data:   data,
+		metric: metric,
+	}
+}
+
+func (r *suppressionRepo) Create(ctx context.Context, s *ent.Suppression) (*ent.Suppression, error) {
+	defer r.metric.NewTiming().Send(metricSuppressionCreateTimings)
+	if s == nil {
+		return nil, errors.New("suppression is empty")
+	}
+	return r.client(ctx).Suppression.Create().
+		SetSenderID(s.SenderID).
+		SetTenant(s.Tenant).
+		SetType(s.Type).
+		SetAddress(s.Address).
+		SetReason(s.Reason).
+		SetNillableExpiresAt(s.ExpiresAt).
+		Save(ctx)
+}
+
+// Update all fields of suppression record. CAUTION: if field in 's' not set — it will be cleared
+func (r *suppressionRepo) Update(ctx context.Context, s *ent.Suppression) (*ent.Suppression, error) {
+	defer r.metric.NewTiming().Send(metricSuppressionUpdateTimings)
+	if s == nil {
+		return nil, errors.New("suppression is empty")
+	}
+	updated := r.client(ctx).Suppression.UpdateOneID(s.ID).
+		SetType(s.Type).
+		SetAddress(s.Address).
+		SetReason(s.Reason)
+
+	if s.ExpiresAt != nil {
+		updated.SetExpiresAt(*s.ExpiresAt)
+	} else {
+		updated.ClearExpiresAt()
+	}
+
+	return updated.Save(ctx)
+}
+
+func (r *suppressionRepo) FindByID(ctx context.Context, id int) (*ent.Suppression, error) {
+	defer r.metric.NewTiming().Send(metricSuppressionFindByIDTimings)
+	return r.client(ctx).Suppression.Get(ctx, id)
+}
+
+func (r *suppressionRepo) FindByAddress(
+	ctx context.Context,
+	senderID int,
+	tenant string,
+	notificationType schema.NotificationType,
+	address string,
+) (*ent.Suppression, error) {
+	defer r.metric.NewTiming().Send(metricSuppressionFindByAddressTimings)
+	return r.client(ctx).Suppression.Query().
+		Where(
+			suppression.SenderID(senderID),
+			suppression.Tenant(tenant),
+			suppression.TypeEQ(notificationType),
+			suppression.Address(address),
+		).
+		Only(ctx)
+}
+
+func (r *suppressionRepo) DeleteByID(ctx context.Context, id int) error {
+	defer r.metric.NewTiming().Send(metricSuppressionDeleteByIDTimings)
+	return r.client(ctx).Suppression.DeleteOneID(id).Exec(ctx)
+}
+
+func (r *suppressionRepo) List(
+	ctx context.Context,
+	senderID int,
+	tenant string,
+	notificationType schema.NotificationType,
+	address string,
+) ([]*ent.Suppression, error) {
+	defer r.metric.NewTiming().Send(metricSuppressionListTimings)
+	query := r.client(ctx).Suppression.Query().
+		Where(
+			suppression.SenderID(senderID),
+			suppression.Tenant(tenant),
+		)
+	if notificationType != "" {
+		query.Where(suppression.TypeEQ(notificationType))
+	}
+	if address != "" {
+		query.Where(suppression.Address(address))
+	}
+	return query.
+		Order(ent.Asc(suppression.FieldID)).
+		All(ctx)
+}
+
+func (r *suppressionRepo) client(ctx context.Context) *ent.Client {
+	if client := ent.FromContext(ctx); client != nil {
+		return client
+	}
+	return r.data.Ent()
+}
@@ -0,0 +1,39 @@
+package health
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// GRPCCheckOperation is operation of standard grpc.health.v1 service registered by kratos gRPC server
+	GRPCCheckOperation = `/grpc.health.v1.Health/Check`
+)
+
+// GRPCServer returns middleware, which reports NOT_SERVING by standard grpc.health.v1 service
+// if any required checker of health fails
+func GRPCServer(h *Health) middleware.Middleware {
+	return selector.Server(
+		func(handler middleware.Handler) middleware.Handler {
+			return func(ctx context.Context, req interface{}) (interface{}, error) {
+				reply, err := handler(ctx, req)
+				if err != nil {
+					return reply, err
+				}
+				response, ok := reply.(*grpc_health_v1.HealthCheckResponse)
+				if !ok || response.GetStatus() != grpc_health_v1.HealthCheckResponse_SERVING {
+					return reply, err
+				}
+				if h.Check(ctx).Status != StatusOK {
+					return &grpc_health_v1.HealthCheckResponse{
+						Status: grpc_health_v1.HealthCheckResponse_NOT_SERVING,
+					}, nil
+				}
+				return reply, nil
+			}
+		},
+	).Path(GRPCCheckOperation).Build()
+}
@@ -0,0 +1,68 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestGRPCServer(t *testing.T) {
+	testCases := []struct {
+		name     string
+		checker  Checker
+		expected grpc_health_v1.HealthCheckResponse_ServingStatus
+	}{
+		{
+			name:     "serving",
+			checker:  func(context.Context) error { return nil },
+			expected: grpc_health_v1.HealthCheckResponse_SERVING,
+		},
+		{
+			name:     "not serving",
+			checker:  func(context.Context) error { return errors.New("connection refused") },
+			expected: grpc_health_v1.HealthCheckResponse_NOT_SERVING,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+
+				srv := grpc.NewServer(
+					grpc.Address("127.0.0.1:0"),
+					grpc.Middleware(GRPCServer(New().Register("database", testCase.checker))),
+				)
+				go func() {
+					_ = srv.Start(ctx)
+				}()
+				defer func() {
+					_ = srv.Stop(ctx)
+				}()
+
+				endpoint, err := srv.Endpoint()
+				require.NoError(t, err)
+
+				conn, err := grpc.DialInsecure(ctx, grpc.WithEndpoint(endpoint.Host))
+				require.NoError(t, err)
+				defer func() {
+					_ = conn.Close()
+				}()
+
+				client := grpc_health_v1.NewHealthClient(conn)
+				require.Eventually(
+					t, func() bool {
+						response, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+						return err == nil && response.GetStatus() == testCase.expected
+					}, 3*time.Second, 50*time.Millisecond,
+				)
+			},
+		)
+	}
+}
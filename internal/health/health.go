@@ -0,0 +1,110 @@
+package health
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	kratosHTTP "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+const (
+	StatusOK   = `ok`
+	StatusFail = `fail`
+
+	LivenessPath  = `/healthz`
+	ReadinessPath = `/readyz`
+
+	checkTimeout = 5 * time.Second
+)
+
+// Checker returns error if dependency of service is not ready
+type Checker func(ctx context.Context) error
+
+// Health is named set of readiness checkers
+type Health struct {
+	mu       sync.RWMutex
+	names    []string
+	checkers map[string]Checker
+	optional map[string]bool
+}
+
+// Report is result of checks, Checks contains error text or StatusOK by checker name
+type Report struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+func New() *Health {
+	return &Health{
+		checkers: map[string]Checker{},
+		optional: map[string]bool{},
+	}
+}
+
+// Register adds or replaces checker by name
+func (h *Health) Register(name string, checker Checker) *Health {
+	return h.register(name, checker, false)
+}
+
+// RegisterOptional adds or replaces checker, which failure is reported, but does not fail readiness
+func (h *Health) RegisterOptional(name string, checker Checker) *Health {
+	return h.register(name, checker, true)
+}
+
+func (h *Health) register(name string, checker Checker, optional bool) *Health {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.checkers[name]; !ok {
+		h.names = append(h.names, name)
+	}
+	h.checkers[name] = checker
+	h.optional[name] = optional
+	return h
+}
+
+// Check runs all checkers, report status is StatusFail if any of them failed
+func (h *Health) Check(ctx context.Context) *Report {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	report := &Report{
+		Status: StatusOK,
+		Checks: map[string]string{},
+	}
+	for _, name := range h.names {
+		if err := h.checkers[name](ctx); err != nil {
+			if !h.optional[name] {
+				report.Status = StatusFail
+			}
+			report.Checks[name] = err.Error()
+			continue
+		}
+		report.Checks[name] = StatusOK
+	}
+	return report
+}
+
+// RegisterHandlers adds liveness handler, which is ok while process serves requests,
+// and readiness handler, which is ok only if all checkers of health are passed.
+// Handlers do not run middlewares of server, so they are available without authorization
+func RegisterHandlers(s *kratosHTTP.Server, h *Health) {
+	r := s.Route("/")
+
+	r.GET(LivenessPath, func(ctx kratosHTTP.Context) error {
+		return ctx.JSON(http.StatusOK, &Report{Status: StatusOK})
+	})
+
+	r.GET(ReadinessPath, func(ctx kratosHTTP.Context) error {
+		report := h.Check(ctx)
+		code := http.StatusOK
+		if report.Status != StatusOK {
+			code = http.StatusServiceUnavailable
+		}
+		return ctx.JSON(code, report)
+	})
+}
@@ -0,0 +1,66 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealth_Check(t *testing.T) {
+	ok := func(context.Context) error { return nil }
+	failed := func(context.Context) error { return errors.New("connection refused") }
+
+	testCases := []struct {
+		name     string
+		health   *Health
+		expected *Report
+	}{
+		{
+			name:     "empty",
+			health:   New(),
+			expected: &Report{Status: StatusOK, Checks: map[string]string{}},
+		},
+		{
+			name:   "ok",
+			health: New().Register("database", ok).Register("loop", ok),
+			expected: &Report{
+				Status: StatusOK,
+				Checks: map[string]string{"database": StatusOK, "loop": StatusOK},
+			},
+		},
+		{
+			name:   "failed",
+			health: New().Register("database", failed).Register("loop", ok),
+			expected: &Report{
+				Status: StatusFail,
+				Checks: map[string]string{"database": "connection refused", "loop": StatusOK},
+			},
+		},
+		{
+			name:   "optional",
+			health: New().Register("database", ok).RegisterOptional("sender.sms", failed),
+			expected: &Report{
+				Status: StatusOK,
+				Checks: map[string]string{"database": StatusOK, "sender.sms": "connection refused"},
+			},
+		},
+		{
+			name:   "replaced",
+			health: New().Register("database", failed).Register("database", ok),
+			expected: &Report{
+				Status: StatusOK,
+				Checks: map[string]string{"database": StatusOK},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				require.Equal(t, testCase.expected, testCase.health.Check(context.Background()))
+			},
+		)
+	}
+}
@@ -0,0 +1,68 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	v1 "notifications/api/notification/v1"
+	"notifications/ent"
+	"notifications/internal/auth"
+	"notifications/internal/biz"
+	"notifications/internal/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport"
+	jwtv4 "github.com/golang-jwt/jwt/v4"
+)
+
+type APIKeyAuthenticator interface {
+	Authenticate(ctx context.Context, key string) (*ent.APIKey, error)
+}
+
+// APIKey authenticates request with header X-API-Key and passes claims of key to context as claims of token,
+// so operation is authorized the same way. Requests without key are authenticated by next, e.g. by JWT
+func APIKey(keys APIKeyAuthenticator, next middleware.Middleware, logs log.Logger) middleware.Middleware {
+	lg := logger.NewHelper(logs, "ts", log.DefaultTimestamp, "scope", "middlewares-apikey")
+	return func(handler middleware.Handler) middleware.Handler {
+		otherwise := next(handler)
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return otherwise(ctx, req)
+			}
+			key := tr.RequestHeader().Get(auth.APIKeyHeader)
+			if key == "" {
+				return otherwise(ctx, req)
+			}
+			apiKey, err := keys.Authenticate(ctx, key)
+			if errors.Is(err, biz.ErrAPIKeyInvalid) {
+				return nil, v1.ErrorUnauthorized(`api key is invalid, expired or revoked`)
+			}
+			if err != nil {
+				lg.WithContext(ctx).Errorf("failed to authenticate api key: %v", err)
+				return nil, v1.ErrorInternalError(`api key authentication failed`)
+			}
+			return handler(jwt.NewContext(ctx, ClaimsOfAPIKey(apiKey)), req)
+		}
+	}
+}
+
+// ClaimsOfAPIKey returns claims of token equal to key: sender and tenant of key, its scopes, channels and expiration
+func ClaimsOfAPIKey(apiKey *ent.APIKey) *auth.Claims {
+	claims := &auth.Claims{
+		RegisteredClaims: jwtv4.RegisteredClaims{
+			ID:      `apikey-` + strconv.Itoa(apiKey.ID),
+			Subject: strconv.Itoa(apiKey.SenderID),
+		},
+		Scopes:   apiKey.Scopes,
+		Channels: apiKey.Channels,
+		Tenant:   apiKey.Tenant,
+	}
+	if apiKey.ExpiresAt != nil {
+		claims.ExpiresAt = jwtv4.NewNumericDate(*apiKey.ExpiresAt)
+	}
+	return claims
+}
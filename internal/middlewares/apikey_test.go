@@ -0,0 +1,70 @@
+package middlewares
+
+import (
+	"context"
+	"testing"
+
+	v1 "notifications/api/notification/v1"
+	"notifications/ent"
+	"notifications/internal/auth"
+	"notifications/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/require"
+)
+
+type apiKeyAuthenticatorStub map[string]*ent.APIKey
+
+func (s apiKeyAuthenticatorStub) Authenticate(_ context.Context, key string) (*ent.APIKey, error) {
+	apiKey, ok := s[key]
+	if !ok {
+		return nil, biz.ErrAPIKeyInvalid
+	}
+	return apiKey, nil
+}
+
+func TestAPIKey(t *testing.T) {
+	keys := apiKeyAuthenticatorStub{
+		`ntf_valid`: {ID: 1, SenderID: 42, Scopes: []string{auth.ScopeSend}, Channels: []string{`sms`}},
+	}
+	next := func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			return `next`, nil
+		}
+	}
+	authenticate := APIKey(keys, next, log.NewFilter(log.DefaultLogger, log.FilterLevel(log.LevelFatal)))
+	handler := authenticate(
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			claims, ok := jwt.FromContext(ctx)
+			require.True(t, ok)
+			return claims, nil
+		},
+	)
+	call := func(header headerStub) (interface{}, error) {
+		ctx := transport.NewServerContext(
+			context.Background(),
+			transportStub{operation: v1.OperationNotificationSend, header: header},
+		)
+		return handler(ctx, &v1.SendRequest{})
+	}
+
+	reply, err := call(headerStub{auth.APIKeyHeader: `ntf_valid`})
+	require.NoError(t, err)
+	claims, ok := reply.(*auth.Claims)
+	require.True(t, ok)
+	senderID, err := claims.SenderID()
+	require.NoError(t, err)
+	require.Equal(t, int64(42), senderID)
+	require.True(t, claims.HasScope(auth.ScopeSend))
+	require.False(t, claims.AllowsChannel(`email`))
+
+	_, err = call(headerStub{auth.APIKeyHeader: `ntf_invalid`})
+	require.True(t, v1.IsUnauthorized(err))
+
+	reply, err = call(headerStub{})
+	require.NoError(t, err)
+	require.Equal(t, `next`, reply)
+}
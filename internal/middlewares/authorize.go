@@ -0,0 +1,191 @@
+package middlewares
+
+import (
+	"context"
+
+	v1 "notifications/api/notification/v1"
+	"notifications/internal/auth"
+	"notifications/internal/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	OperationScopes = map[string]string{
+		v1.OperationNotificationEnqueue:           auth.ScopeSend,
+		v1.OperationNotificationSend:              auth.ScopeSend,
+		v1.OperationNotificationCheck:             auth.ScopeRead,
+		v1.OperationNotificationUsage:             auth.ScopeRead,
+		v1.OperationNotificationGetSchedule:       auth.ScopeRead,
+		v1.OperationNotificationListSchedules:     auth.ScopeRead,
+		v1.OperationNotificationGetSuppression:    auth.ScopeRead,
+		v1.OperationNotificationListSuppressions:  auth.ScopeRead,
+		v1.OperationNotificationCreateSchedule:    auth.ScopeSchedule,
+		v1.OperationNotificationUpdateSchedule:    auth.ScopeSchedule,
+		v1.OperationNotificationDeleteSchedule:    auth.ScopeSchedule,
+		v1.OperationNotificationCreateSuppression: auth.ScopeSuppress,
+		v1.OperationNotificationUpdateSuppression: auth.ScopeSuppress,
+		v1.OperationNotificationDeleteSuppression: auth.ScopeSuppress,
+		v1.OperationNotificationIssueAPIKey:       auth.ScopeKeys,
+		v1.OperationNotificationListAPIKeys:       auth.ScopeKeys,
+		v1.OperationNotificationRevokeAPIKey:      auth.ScopeKeys,
+		v1.OperationNotificationSetCredentials:    auth.ScopeCredentials,
+		v1.OperationNotificationListCredentials:   auth.ScopeCredentials,
+		v1.OperationNotificationDeleteCredentials: auth.ScopeCredentials,
+	}
+)
+
+// Authorize checks scope of operation and channel of notification by claims of token or API key in context
+// and replaces sender id and tenant of request with sender id and tenant of token
+func Authorize(logs log.Logger) middleware.Middleware {
+	lg := logger.NewHelper(logs, "ts", log.DefaultTimestamp, "scope", "middlewares-authorize")
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, v1.ErrorForbidden(`operation is unknown`)
+			}
+			scope, ok := OperationScopes[tr.Operation()]
+			if !ok {
+				// Operation without scope is rejected, so new operation is not public until its scope is set
+				return nil, v1.ErrorForbidden(`operation %s has no scope`, tr.Operation())
+			}
+			tokenClaims, _ := jwt.FromContext(ctx)
+			claims, ok := tokenClaims.(*auth.Claims)
+			if !ok {
+				return nil, v1.ErrorForbidden(`claims of token are unknown`)
+			}
+			if !claims.HasScope(scope) {
+				lg.WithContext(ctx).Warnf("token of sender %s has no scope %s", claims.Subject, scope)
+				return nil, v1.ErrorForbidden(`token has no scope %s`, scope)
+			}
+			senderID, err := claims.SenderID()
+			if err != nil {
+				return nil, v1.ErrorForbidden(`%v`, err)
+			}
+			if err = authorizeRequest(req, claims, senderID); err != nil {
+				lg.WithContext(ctx).Warnf("request of sender %s is forbidden: %v", claims.Subject, err)
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// authorizeRequest checks channel of notification and sets sender id and tenant of token to request,
+// request with sender id or tenant other than sender id or tenant of token is forbidden
+func authorizeRequest(req interface{}, claims *auth.Claims, senderID int64) error {
+	var requestSenderID *int64
+	var requestTenant *string
+	var channel *v1.Type
+	switch r := req.(type) {
+	case *v1.SendRequest:
+		requestSenderID = &r.SenderId
+		requestTenant = &r.Tenant
+		channel = &r.Type
+	case *v1.CheckRequest:
+		requestSenderID = &r.SenderId
+		requestTenant = &r.Tenant
+	case *v1.UsageRequest:
+		requestSenderID = &r.SenderId
+		requestTenant = &r.Tenant
+	case *v1.ListSchedulesRequest:
+		requestSenderID = &r.SenderId
+		requestTenant = &r.Tenant
+	case *v1.ScheduleRequest:
+		if r.Schedule != nil {
+			requestSenderID = &r.Schedule.SenderId
+			requestTenant = &r.Schedule.Tenant
+			channel = &r.Schedule.Type
+		}
+	case *v1.GetScheduleRequest:
+		requestSenderID = &r.SenderId
+		requestTenant = &r.Tenant
+	case *v1.DeleteScheduleRequest:
+		requestSenderID = &r.SenderId
+		requestTenant = &r.Tenant
+	case *v1.SuppressionRequest:
+		if r.Suppression != nil {
+			requestSenderID = &r.Suppression.SenderId
+			requestTenant = &r.Suppression.Tenant
+		}
+	case *v1.GetSuppressionRequest:
+		requestSenderID = &r.SenderId
+		requestTenant = &r.Tenant
+	case *v1.DeleteSuppressionRequest:
+		requestSenderID = &r.SenderId
+		requestTenant = &r.Tenant
+	case *v1.ListSuppressionsRequest:
+		requestSenderID = &r.SenderId
+		requestTenant = &r.Tenant
+	case *v1.IssueAPIKeyRequest:
+		if r.ApiKey != nil {
+			requestSenderID = &r.ApiKey.SenderId
+			requestTenant = &r.ApiKey.Tenant
+			if err := authorizeAPIKey(r.ApiKey, claims); err != nil {
+				return err
+			}
+		}
+	case *v1.ListAPIKeysRequest:
+		requestSenderID = &r.SenderId
+		requestTenant = &r.Tenant
+	case *v1.RevokeAPIKeyRequest:
+		requestSenderID = &r.SenderId
+		requestTenant = &r.Tenant
+	case *v1.SetCredentialsRequest:
+		if r.Credentials != nil {
+			requestTenant = &r.Credentials.Tenant
+		}
+	case *v1.ListCredentialsRequest:
+		requestTenant = &r.Tenant
+	case *v1.DeleteCredentialsRequest:
+		requestTenant = &r.Tenant
+	}
+	if channel != nil && !claims.AllowsChannel(channel.String()) {
+		return v1.ErrorForbidden(`token does not allow channel %s`, channel.String())
+	}
+	if requestSenderID != nil {
+		if *requestSenderID != 0 && *requestSenderID != senderID {
+			return v1.ErrorForbidden(`sender %d differs from sender of token`, *requestSenderID)
+		}
+		*requestSenderID = senderID
+	}
+	if requestTenant != nil {
+		if *requestTenant != "" && *requestTenant != claims.Tenant {
+			return v1.ErrorForbidden(`tenant %s differs from tenant of token`, *requestTenant)
+		}
+		*requestTenant = claims.Tenant
+	}
+	return nil
+}
+
+// authorizeAPIKey checks that issued key allows no more than token issuing it
+func authorizeAPIKey(apiKey *v1.APIKey, claims *auth.Claims) error {
+	for _, scope := range apiKey.Scopes {
+		if !claims.HasScope(scope) {
+			return v1.ErrorForbidden(`token has no scope %s of api key`, scope)
+		}
+	}
+	if len(claims.Channels) > 0 && len(apiKey.Channels) == 0 {
+		return v1.ErrorForbidden(`api key must be limited to channels of token`)
+	}
+	for _, channel := range apiKey.Channels {
+		if !claims.AllowsChannel(channel.String()) {
+			return v1.ErrorForbidden(`token does not allow channel %s of api key`, channel.String())
+		}
+	}
+	// Key must not outlive token or key issuing it
+	if claims.ExpiresAt != nil {
+		if apiKey.ExpiresAt == nil {
+			return v1.ErrorForbidden(`api key must expire not later than token`)
+		}
+		if apiKey.ExpiresAt.AsTime().After(claims.ExpiresAt.Time) {
+			apiKey.ExpiresAt = timestamppb.New(claims.ExpiresAt.Time)
+		}
+	}
+	return nil
+}
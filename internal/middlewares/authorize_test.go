@@ -0,0 +1,301 @@
+package middlewares
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "notifications/api/notification/v1"
+	"notifications/internal/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport"
+	jwtv4 "github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type transportStub struct {
+	transport.Transporter
+	operation string
+	header    headerStub
+}
+
+func (t transportStub) Operation() string {
+	return t.operation
+}
+
+func (t transportStub) RequestHeader() transport.Header {
+	return t.header
+}
+
+type headerStub map[string]string
+
+func (h headerStub) Get(key string) string {
+	return h[key]
+}
+
+func (h headerStub) Set(key string, value string) {
+	h[key] = value
+}
+
+func (h headerStub) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for key := range h {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func TestAuthorize(t *testing.T) {
+	testCases := []struct {
+		name      string
+		operation string
+		claims    jwtv4.Claims
+		req       interface{}
+		expected  interface{}
+		forbidden bool
+	}{
+		{
+			name:      "sender-of-token",
+			operation: v1.OperationNotificationSend,
+			claims: &auth.Claims{
+				RegisteredClaims: jwtv4.RegisteredClaims{Subject: `42`},
+				Scopes:           []string{auth.ScopeSend},
+			},
+			req:      &v1.SendRequest{Type: v1.Type_email},
+			expected: &v1.SendRequest{Type: v1.Type_email, SenderId: 42},
+		},
+		{
+			name:      "other-sender",
+			operation: v1.OperationNotificationSend,
+			claims: &auth.Claims{
+				RegisteredClaims: jwtv4.RegisteredClaims{Subject: `42`},
+				Scopes:           []string{auth.ScopeSend},
+			},
+			req:       &v1.SendRequest{Type: v1.Type_email, SenderId: 43},
+			forbidden: true,
+		},
+		{
+			name:      "without-scope",
+			operation: v1.OperationNotificationSend,
+			claims:    &auth.Claims{Scopes: []string{auth.ScopeRead}},
+			req:       &v1.SendRequest{Type: v1.Type_email},
+			forbidden: true,
+		},
+		{
+			name:      "allowed-channel",
+			operation: v1.OperationNotificationCreateSchedule,
+			claims:    &auth.Claims{Scopes: []string{auth.ScopeSchedule}, Channels: []string{`sms`}},
+			req:       &v1.ScheduleRequest{Schedule: &v1.Schedule{Type: v1.Type_sms}},
+			expected:  &v1.ScheduleRequest{Schedule: &v1.Schedule{Type: v1.Type_sms}},
+		},
+		{
+			name:      "not-allowed-channel",
+			operation: v1.OperationNotificationEnqueue,
+			claims:    &auth.Claims{Scopes: []string{auth.ScopeSend}, Channels: []string{`sms`}},
+			req:       &v1.SendRequest{Type: v1.Type_telegram},
+			forbidden: true,
+		},
+		{
+			name:      "subject-is-not-sender",
+			operation: v1.OperationNotificationUsage,
+			claims: &auth.Claims{
+				RegisteredClaims: jwtv4.RegisteredClaims{Subject: `someone`},
+				Scopes:           []string{auth.ScopeRead},
+			},
+			req:       &v1.UsageRequest{},
+			forbidden: true,
+		},
+		{
+			name:      "api-key-of-token-scopes",
+			operation: v1.OperationNotificationIssueAPIKey,
+			claims: &auth.Claims{
+				RegisteredClaims: jwtv4.RegisteredClaims{Subject: `42`},
+				Scopes:           []string{auth.ScopeKeys, auth.ScopeSend},
+			},
+			req:      &v1.IssueAPIKeyRequest{ApiKey: &v1.APIKey{Scopes: []string{auth.ScopeSend}}},
+			expected: &v1.IssueAPIKeyRequest{ApiKey: &v1.APIKey{SenderId: 42, Scopes: []string{auth.ScopeSend}}},
+		},
+		{
+			name:      "api-key-exceeds-token-scopes",
+			operation: v1.OperationNotificationIssueAPIKey,
+			claims:    &auth.Claims{Scopes: []string{auth.ScopeKeys}},
+			req:       &v1.IssueAPIKeyRequest{ApiKey: &v1.APIKey{Scopes: []string{auth.ScopeSend}}},
+			forbidden: true,
+		},
+		{
+			name:      "api-key-exceeds-token-channels",
+			operation: v1.OperationNotificationIssueAPIKey,
+			claims:    &auth.Claims{Scopes: []string{auth.ScopeKeys, auth.ScopeSend}, Channels: []string{`sms`}},
+			req:       &v1.IssueAPIKeyRequest{ApiKey: &v1.APIKey{Scopes: []string{auth.ScopeSend}}},
+			forbidden: true,
+		},
+		{
+			name:      "api-key-of-expiring-token",
+			operation: v1.OperationNotificationIssueAPIKey,
+			claims: &auth.Claims{
+				RegisteredClaims: jwtv4.RegisteredClaims{
+					Subject:   `42`,
+					ExpiresAt: jwtv4.NewNumericDate(time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)),
+				},
+				Scopes: []string{auth.ScopeKeys, auth.ScopeSend},
+			},
+			req: &v1.IssueAPIKeyRequest{
+				ApiKey: &v1.APIKey{
+					Scopes:    []string{auth.ScopeSend},
+					ExpiresAt: timestamppb.New(time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC)),
+				},
+			},
+			expected: &v1.IssueAPIKeyRequest{
+				ApiKey: &v1.APIKey{
+					SenderId:  42,
+					Scopes:    []string{auth.ScopeSend},
+					ExpiresAt: timestamppb.New(time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			name:      "api-key-without-expiration-of-expiring-token",
+			operation: v1.OperationNotificationIssueAPIKey,
+			claims: &auth.Claims{
+				RegisteredClaims: jwtv4.RegisteredClaims{
+					Subject:   `42`,
+					ExpiresAt: jwtv4.NewNumericDate(time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)),
+				},
+				Scopes: []string{auth.ScopeKeys, auth.ScopeSend},
+			},
+			req:       &v1.IssueAPIKeyRequest{ApiKey: &v1.APIKey{Scopes: []string{auth.ScopeSend}}},
+			forbidden: true,
+		},
+		{
+			name:      "tenant-of-token",
+			operation: v1.OperationNotificationSend,
+			claims:    &auth.Claims{Scopes: []string{auth.ScopeSend}, Tenant: `acme`},
+			req:       &v1.SendRequest{Type: v1.Type_email},
+			expected:  &v1.SendRequest{Type: v1.Type_email, Tenant: `acme`},
+		},
+		{
+			name:      "other-tenant",
+			operation: v1.OperationNotificationSend,
+			claims:    &auth.Claims{Scopes: []string{auth.ScopeSend}, Tenant: `acme`},
+			req:       &v1.SendRequest{Type: v1.Type_email, Tenant: `globex`},
+			forbidden: true,
+		},
+		{
+			name:      "tenant-without-token-tenant",
+			operation: v1.OperationNotificationCreateSchedule,
+			claims:    &auth.Claims{Scopes: []string{auth.ScopeSchedule}},
+			req:       &v1.ScheduleRequest{Schedule: &v1.Schedule{Type: v1.Type_sms, Tenant: `acme`}},
+			forbidden: true,
+		},
+		{
+			name:      "credentials-of-tenant",
+			operation: v1.OperationNotificationSetCredentials,
+			claims:    &auth.Claims{Scopes: []string{auth.ScopeCredentials}, Tenant: `acme`},
+			req:       &v1.SetCredentialsRequest{Credentials: &v1.Credentials{}},
+			expected:  &v1.SetCredentialsRequest{Credentials: &v1.Credentials{Tenant: `acme`}},
+		},
+		{
+			name:      "credentials-without-scope",
+			operation: v1.OperationNotificationListCredentials,
+			claims:    &auth.Claims{Scopes: []string{auth.ScopeKeys}, Tenant: `acme`},
+			req:       &v1.ListCredentialsRequest{},
+			forbidden: true,
+		},
+		{
+			name:      "check-of-sender",
+			operation: v1.OperationNotificationCheck,
+			claims: &auth.Claims{
+				RegisteredClaims: jwtv4.RegisteredClaims{Subject: `42`},
+				Scopes:           []string{auth.ScopeRead},
+				Tenant:           `acme`,
+			},
+			req:      &v1.CheckRequest{Id: 1},
+			expected: &v1.CheckRequest{Id: 1, SenderId: 42, Tenant: `acme`},
+		},
+		{
+			name:      "schedule-of-other-sender",
+			operation: v1.OperationNotificationDeleteSchedule,
+			claims: &auth.Claims{
+				RegisteredClaims: jwtv4.RegisteredClaims{Subject: `42`},
+				Scopes:           []string{auth.ScopeSchedule},
+			},
+			req:       &v1.DeleteScheduleRequest{Id: 1, SenderId: 43},
+			forbidden: true,
+		},
+		{
+			name:      "suppression-of-sender",
+			operation: v1.OperationNotificationUpdateSuppression,
+			claims: &auth.Claims{
+				RegisteredClaims: jwtv4.RegisteredClaims{Subject: `42`},
+				Scopes:           []string{auth.ScopeSuppress},
+				Tenant:           `acme`,
+			},
+			req:      &v1.SuppressionRequest{Suppression: &v1.Suppression{Id: 1}},
+			expected: &v1.SuppressionRequest{Suppression: &v1.Suppression{Id: 1, SenderId: 42, Tenant: `acme`}},
+		},
+		{
+			name:      "schedules-of-tenant",
+			operation: v1.OperationNotificationListSchedules,
+			claims: &auth.Claims{
+				RegisteredClaims: jwtv4.RegisteredClaims{Subject: `42`},
+				Scopes:           []string{auth.ScopeRead},
+				Tenant:           `acme`,
+			},
+			req:      &v1.ListSchedulesRequest{},
+			expected: &v1.ListSchedulesRequest{SenderId: 42, Tenant: `acme`},
+		},
+		{
+			name:      "usage-of-other-tenant",
+			operation: v1.OperationNotificationUsage,
+			claims: &auth.Claims{
+				RegisteredClaims: jwtv4.RegisteredClaims{Subject: `42`},
+				Scopes:           []string{auth.ScopeRead},
+				Tenant:           `acme`,
+			},
+			req:       &v1.UsageRequest{Tenant: `globex`},
+			forbidden: true,
+		},
+		{
+			name:      "operation-without-scope",
+			operation: `/unknown.v1.Unknown/Unknown`,
+			claims:    &auth.Claims{Scopes: auth.Scopes},
+			req:       &v1.CheckRequest{Id: 1},
+			forbidden: true,
+		},
+		{
+			name:      "unknown-claims",
+			operation: v1.OperationNotificationCheck,
+			claims:    &jwtv4.RegisteredClaims{},
+			req:       &v1.CheckRequest{Id: 1},
+			forbidden: true,
+		},
+	}
+
+	authorize := Authorize(log.NewFilter(log.DefaultLogger, log.FilterLevel(log.LevelFatal)))
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				ctx := transport.NewServerContext(context.Background(), transportStub{operation: testCase.operation})
+				ctx = jwt.NewContext(ctx, testCase.claims)
+				handled := false
+				_, err := authorize(
+					func(ctx context.Context, req interface{}) (interface{}, error) {
+						handled = true
+						return nil, nil
+					},
+				)(ctx, testCase.req)
+				if testCase.forbidden {
+					require.True(t, v1.IsForbidden(err))
+					require.False(t, handled)
+					return
+				}
+				require.NoError(t, err)
+				require.True(t, handled)
+				require.Equal(t, testCase.expected, testCase.req)
+			},
+		)
+	}
+}
@@ -0,0 +1,48 @@
+package middlewares
+
+import (
+	"context"
+	"strings"
+
+	v1 "notifications/api/notification/v1"
+	"notifications/internal/auth"
+	"notifications/internal/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+const (
+	authorizationHeader = `Authorization`
+	bearer              = `Bearer`
+)
+
+type TokenVerifier interface {
+	Verify(ctx context.Context, token string) (*auth.Claims, error)
+}
+
+// JWT authenticates request with bearer token of header Authorization and passes claims of token to context.
+// Unlike jwt.Server of kratos token may be signed by any key of verifier, HS256 secret or public keys
+func JWT(verifier TokenVerifier, logs log.Logger) middleware.Middleware {
+	lg := logger.NewHelper(logs, "ts", log.DefaultTimestamp, "scope", "middlewares-jwt")
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, v1.ErrorUnauthorized(`token is missing`)
+			}
+			parts := strings.SplitN(tr.RequestHeader().Get(authorizationHeader), ` `, 2)
+			if len(parts) != 2 || !strings.EqualFold(parts[0], bearer) || parts[1] == "" {
+				return nil, v1.ErrorUnauthorized(`token is missing`)
+			}
+			claims, err := verifier.Verify(ctx, parts[1])
+			if err != nil {
+				lg.WithContext(ctx).Warnf("token is rejected: %v", err)
+				return nil, v1.ErrorUnauthorized(`token is invalid`)
+			}
+			return handler(jwt.NewContext(ctx, claims), req)
+		}
+	}
+}
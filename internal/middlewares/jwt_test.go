@@ -0,0 +1,60 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v1 "notifications/api/notification/v1"
+	"notifications/internal/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport"
+	jwtv4 "github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+)
+
+type tokenVerifierStub map[string]*auth.Claims
+
+func (s tokenVerifierStub) Verify(_ context.Context, token string) (*auth.Claims, error) {
+	claims, ok := s[token]
+	if !ok {
+		return nil, errors.New(`token is invalid`)
+	}
+	return claims, nil
+}
+
+func TestJWT(t *testing.T) {
+	verifier := tokenVerifierStub{
+		`valid`: {RegisteredClaims: jwtv4.RegisteredClaims{Subject: `42`}, Scopes: []string{auth.ScopeSend}},
+	}
+	authenticate := JWT(verifier, log.NewFilter(log.DefaultLogger, log.FilterLevel(log.LevelFatal)))
+	handler := authenticate(
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			claims, ok := jwt.FromContext(ctx)
+			require.True(t, ok)
+			return claims, nil
+		},
+	)
+	call := func(header headerStub) (interface{}, error) {
+		ctx := transport.NewServerContext(
+			context.Background(),
+			transportStub{operation: v1.OperationNotificationSend, header: header},
+		)
+		return handler(ctx, &v1.SendRequest{})
+	}
+
+	reply, err := call(headerStub{`Authorization`: `Bearer valid`})
+	require.NoError(t, err)
+	require.Equal(t, verifier[`valid`], reply)
+
+	_, err = call(headerStub{`Authorization`: `Bearer invalid`})
+	require.True(t, v1.IsUnauthorized(err))
+
+	_, err = call(headerStub{`Authorization`: `valid`})
+	require.True(t, v1.IsUnauthorized(err))
+
+	_, err = call(headerStub{})
+	require.True(t, v1.IsUnauthorized(err))
+}
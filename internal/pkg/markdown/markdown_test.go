@@ -0,0 +1,149 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const message = "# Order #15\n\n" +
+	"Hello, **John**! Your order is *shipped*, see [details](https://example.com/orders?id=15&a=(b)).\n" +
+	"Price: 1 < 2 & `a_b*c`\n\n" +
+	"- first_item\n" +
+	"- ~~second~~ item\n\n" +
+	"1. one\n" +
+	"2. two\n\n" +
+	"```go\nfmt.Println(`hi`)\n```"
+
+func TestHTML(t *testing.T) {
+	require.Equal(
+		t,
+		"<h1>Order #15</h1>\n"+
+			"<p>Hello, <strong>John</strong>! Your order is <em>shipped</em>, "+
+			"see <a href=\"https://example.com/orders?id=15&amp;a=(b)\">details</a>.<br>\n"+
+			"Price: 1 &lt; 2 &amp; <code>a_b*c</code></p>\n"+
+			"<ul>\n<li>first_item</li>\n<li><s>second</s> item</li>\n</ul>\n"+
+			"<ol>\n<li>one</li>\n<li>two</li>\n</ol>\n"+
+			"<pre><code>fmt.Println(`hi`)</code></pre>",
+		HTML(message),
+	)
+}
+
+func TestTelegramHTML(t *testing.T) {
+	require.Equal(
+		t,
+		"<b>Order #15</b>\n\n"+
+			"Hello, <b>John</b>! Your order is <i>shipped</i>, "+
+			"see <a href=\"https://example.com/orders?id=15&amp;a=(b)\">details</a>.\n"+
+			"Price: 1 &lt; 2 &amp; <code>a_b*c</code>\n\n"+
+			"• first_item\n• <s>second</s> item\n\n"+
+			"1. one\n2. two\n\n"+
+			"<pre><code class=\"language-go\">fmt.Println(`hi`)</code></pre>",
+		TelegramHTML(message),
+	)
+}
+
+func TestTelegramMarkdownV2(t *testing.T) {
+	require.Equal(
+		t,
+		"*Order \\#15*\n\n"+
+			"Hello, *John*\\! Your order is _shipped_, "+
+			"see [details](https://example.com/orders?id=15&a=(b\\))\\.\n"+
+			"Price: 1 < 2 & `a_b*c`\n\n"+
+			"• first\\_item\n• ~second~ item\n\n"+
+			"1\\. one\n2\\. two\n\n"+
+			"```go\nfmt.Println(\\`hi\\`)\n```",
+		TelegramMarkdownV2(message),
+	)
+}
+
+func TestText(t *testing.T) {
+	require.Equal(
+		t,
+		"Order #15\n\n"+
+			"Hello, John! Your order is shipped, see details (https://example.com/orders?id=15&a=(b)).\n"+
+			"Price: 1 < 2 & a_b*c\n\n"+
+			"- first_item\n- second item\n\n"+
+			"1. one\n2. two\n\n"+
+			"fmt.Println(`hi`)",
+		Text(message),
+	)
+}
+
+func TestParseInline(t *testing.T) {
+	testCases := []struct {
+		name     string
+		source   string
+		expected string
+	}{
+		{name: "snake-case", source: `snake_case_name`, expected: `snake_case_name`},
+		{name: "escaped", source: `\*not italic\*`, expected: `*not italic*`},
+		{name: "unclosed", source: `2 * 3 = 6, **open`, expected: `2 * 3 = 6, **open`},
+		{name: "nested", source: `**bold _italic_**`, expected: `<b>bold <i>italic</i></b>`},
+		{name: "italic-with-bold", source: `*italic **bold***`, expected: `<i>italic <b>bold</b></i>`},
+		{name: "link-with-emphasis", source: `[**go**](https://go.dev)`, expected: `<a href="https://go.dev"><b>go</b></a>`},
+		{name: "not-link", source: `[text] (url)`, expected: `[text] (url)`},
+	}
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				require.Equal(t, testCase.expected, TelegramHTML(testCase.source))
+			},
+		)
+	}
+}
+
+func TestLinkSchemes(t *testing.T) {
+	testCases := []struct {
+		name     string
+		source   string
+		html     string
+		text     string
+		markdown string
+	}{
+		{
+			name:     "http",
+			source:   `[site](http://example.com)`,
+			html:     `<p><a href="http://example.com">site</a></p>`,
+			text:     `site (http://example.com)`,
+			markdown: `[site](http://example.com)`,
+		},
+		{
+			name:     "mailto",
+			source:   `[mail](MAILTO:john@example.com)`,
+			html:     `<p><a href="MAILTO:john@example.com">mail</a></p>`,
+			text:     `mail (MAILTO:john@example.com)`,
+			markdown: `[mail](MAILTO:john@example.com)`,
+		},
+		{
+			name:     "javascript",
+			source:   `[**click**](javascript:alert(1))`,
+			html:     `<p><strong>click</strong></p>`,
+			text:     `click`,
+			markdown: `*click*`,
+		},
+		{
+			name:     "data",
+			source:   `[img](data:text/html;base64,PHNjcmlwdD4=)`,
+			html:     `<p>img</p>`,
+			text:     `img`,
+			markdown: `img`,
+		},
+		{
+			name:     "relative",
+			source:   `[orders](/orders)`,
+			html:     `<p>orders</p>`,
+			text:     `orders`,
+			markdown: `orders`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				require.Equal(t, testCase.html, HTML(testCase.source))
+				require.Equal(t, testCase.text, Text(testCase.source))
+				require.Equal(t, testCase.markdown, TelegramMarkdownV2(testCase.source))
+			},
+		)
+	}
+}
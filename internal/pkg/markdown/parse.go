@@ -0,0 +1,299 @@
+package markdown
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Subset of Markdown supported by renderers:
+//
+//	**bold**, *italic* or _italic_, ~~strike~~, `code`, [link](https://example.com), \* escaped symbol,
+//	# heading, - bulleted and 1. numbered list items, ``` code block ```
+//
+// Paragraphs are separated by blank line, line breaks inside paragraph are kept,
+// links with schemes other than http, https and mailto are rendered as their text
+
+type InlineKind int
+
+const (
+	InlineText InlineKind = iota
+	InlineBold
+	InlineItalic
+	InlineStrike
+	InlineCode
+	InlineLink
+)
+
+type BlockKind int
+
+const (
+	BlockParagraph BlockKind = iota
+	BlockHeading
+	BlockList
+	BlockCode
+)
+
+// Inline is span of text, Text is set for text and code, Children for other kinds
+type Inline struct {
+	Kind     InlineKind
+	Text     string
+	URL      string
+	Children []Inline
+}
+
+// Block is paragraph, heading, list or code block of message
+type Block struct {
+	Kind     BlockKind
+	Level    int        // level of heading
+	Ordered  bool       // list is numbered
+	Start    int        // number of the first item of numbered list
+	Inlines  []Inline   // content of paragraph and heading
+	Items    [][]Inline // items of list
+	Language string     // language of code block
+	Text     string     // raw text of code block
+}
+
+var (
+	headingPattern = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+	bulletPattern  = regexp.MustCompile(`^\s*[-*+]\s+(.*)$`)
+	numberPattern  = regexp.MustCompile(`^\s*(\d{1,9})[.)]\s+(.*)$`)
+	fencePattern   = regexp.MustCompile("^\\s*```\\s*([\\w+-]*)\\s*$")
+)
+
+// Parse returns blocks of message in Markdown subset
+func Parse(source string) []Block {
+	lines := strings.Split(strings.ReplaceAll(source, "\r\n", "\n"), "\n")
+	blocks := []Block{}
+	paragraph := []string{}
+	flush := func() {
+		if len(paragraph) > 0 {
+			blocks = append(blocks, Block{Kind: BlockParagraph, Inlines: ParseInline(strings.Join(paragraph, "\n"))})
+			paragraph = paragraph[:0]
+		}
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		if match := fencePattern.FindStringSubmatch(line); match != nil {
+			flush()
+			code := []string{}
+			for i++; i < len(lines) && !fencePattern.MatchString(lines[i]); i++ {
+				code = append(code, lines[i])
+			}
+			blocks = append(blocks, Block{Kind: BlockCode, Language: match[1], Text: strings.Join(code, "\n")})
+			continue
+		}
+		if strings.TrimSpace(line) == "" {
+			flush()
+			continue
+		}
+		if match := headingPattern.FindStringSubmatch(line); match != nil {
+			flush()
+			blocks = append(
+				blocks,
+				Block{Kind: BlockHeading, Level: len(match[1]), Inlines: ParseInline(strings.TrimSpace(match[2]))},
+			)
+			continue
+		}
+		bullet := bulletPattern.FindStringSubmatch(line)
+		number := numberPattern.FindStringSubmatch(line)
+		if bullet == nil && number == nil {
+			paragraph = append(paragraph, line)
+			continue
+		}
+		flush()
+		ordered := number != nil
+		text := ""
+		if ordered {
+			text = number[2]
+		} else {
+			text = bullet[1]
+		}
+		last := len(blocks) - 1
+		if last < 0 || blocks[last].Kind != BlockList || blocks[last].Ordered != ordered {
+			start := 1
+			if ordered {
+				start, _ = strconv.Atoi(number[1])
+			}
+			blocks = append(blocks, Block{Kind: BlockList, Ordered: ordered, Start: start})
+			last = len(blocks) - 1
+		}
+		blocks[last].Items = append(blocks[last].Items, ParseInline(text))
+	}
+	flush()
+	return blocks
+}
+
+// ParseInline returns spans of text with emphasis, code and links
+func ParseInline(source string) []Inline {
+	runes := []rune(source)
+	inlines := []Inline{}
+	text := strings.Builder{}
+	flushText := func() {
+		if text.Len() > 0 {
+			inlines = append(inlines, Inline{Kind: InlineText, Text: text.String()})
+			text.Reset()
+		}
+	}
+	emphases := []struct {
+		delimiter string
+		kind      InlineKind
+	}{
+		{`**`, InlineBold},
+		{`~~`, InlineStrike},
+		{`*`, InlineItalic},
+		{`_`, InlineItalic},
+	}
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if r == '\\' && i+1 < len(runes) && isPunct(runes[i+1]) {
+			text.WriteRune(runes[i+1])
+			i++
+			continue
+		}
+		if r == '`' {
+			if end := indexOfCode(runes, i+1); end > i+1 {
+				flushText()
+				inlines = append(inlines, Inline{Kind: InlineCode, Text: string(runes[i+1 : end])})
+				i = end
+				continue
+			}
+		}
+		if r == '[' {
+			if textEnd := indexOf(runes, i+1, "]("); textEnd > i+1 {
+				if urlEnd := indexOfURLEnd(runes, textEnd+2); urlEnd > textEnd+2 {
+					flushText()
+					inlines = append(
+						inlines,
+						Inline{
+							Kind:     InlineLink,
+							URL:      strings.TrimSpace(string(runes[textEnd+2 : urlEnd])),
+							Children: ParseInline(string(runes[i+1 : textEnd])),
+						},
+					)
+					i = urlEnd
+					continue
+				}
+			}
+		}
+		matched := false
+		for _, emphasis := range emphases {
+			delimiter := []rune(emphasis.delimiter)
+			if !hasPrefix(runes, i, emphasis.delimiter) || !opens(runes, i+len(delimiter)) {
+				continue
+			}
+			if emphasis.delimiter == `_` && i > 0 && isWord(runes[i-1]) {
+				// Underscores inside words as snake_case are not emphasis
+				continue
+			}
+			end := closing(runes, i+len(delimiter), emphasis.delimiter)
+			if end < 0 {
+				continue
+			}
+			flushText()
+			inlines = append(
+				inlines,
+				Inline{Kind: emphasis.kind, Children: ParseInline(string(runes[i+len(delimiter) : end]))},
+			)
+			i = end + len(delimiter) - 1
+			matched = true
+			break
+		}
+		if !matched {
+			text.WriteRune(r)
+		}
+	}
+	flushText()
+	return inlines
+}
+
+// opens reports if emphasis may start before position: it is not followed by space
+func opens(runes []rune, i int) bool {
+	return i < len(runes) && runes[i] != ' ' && runes[i] != '\n'
+}
+
+// closing returns position of closing delimiter not preceded by space, skipping escaped symbols and code
+func closing(runes []rune, from int, delimiter string) int {
+	for i := from; i < len(runes); i++ {
+		switch {
+		case runes[i] == '\\':
+			i++
+		case runes[i] == '`':
+			if end := indexOfCode(runes, i+1); end > 0 {
+				i = end
+			}
+		case delimiter == `*` && hasPrefix(runes, i, `**`) && opens(runes, i+2) && closing(runes, i+2, `**`) > 0:
+			// Bold inside italic
+			i = closing(runes, i+2, `**`) + 1
+		case i > from && hasPrefix(runes, i, delimiter) && runes[i-1] != ' ' && runes[i-1] != '\n':
+			if delimiter == `_` && i+1 < len(runes) && isWord(runes[i+1]) {
+				continue
+			}
+			return i
+		}
+	}
+	return -1
+}
+
+func indexOf(runes []rune, from int, substring string) int {
+	for i := from; i < len(runes); i++ {
+		if runes[i] == '\\' {
+			i++
+			continue
+		}
+		if hasPrefix(runes, i, substring) {
+			return i
+		}
+	}
+	return -1
+}
+
+// indexOfURLEnd returns position of closing parenthesis of link, parentheses inside url are balanced
+func indexOfURLEnd(runes []rune, from int) int {
+	depth := 0
+	for i := from; i < len(runes); i++ {
+		switch runes[i] {
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 {
+				return i
+			}
+			depth--
+		case ' ', '\n':
+			return -1
+		}
+	}
+	return -1
+}
+
+// indexOfCode returns position of closing backtick of code, backslashes are not escapes inside code
+func indexOfCode(runes []rune, from int) int {
+	for i := from; i < len(runes); i++ {
+		if runes[i] == '`' {
+			return i
+		}
+	}
+	return -1
+}
+
+func hasPrefix(runes []rune, i int, prefix string) bool {
+	for _, r := range prefix {
+		if i >= len(runes) || runes[i] != r {
+			return false
+		}
+		i++
+	}
+	return true
+}
+
+func isWord(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func isPunct(r rune) bool {
+	return strings.ContainsRune("\\`*_{}[]()#+-.!~|>", r)
+}
@@ -0,0 +1,280 @@
+package markdown
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// style renders parsed message for channel, text of spans is already rendered except code
+type style struct {
+	escape    func(text string) string
+	bold      func(text string) string
+	italic    func(text string) string
+	strike    func(text string) string
+	code      func(raw string) string
+	link      func(text, url string) string
+	heading   func(level int, text string) string
+	list      func(ordered bool, start int, items []string) string
+	codeBlock func(language, raw string) string
+	paragraph func(text string) string
+	lineBreak string
+	separator string
+}
+
+var (
+	htmlStyle = style{
+		escape: html.EscapeString,
+		bold:   wrap(`<strong>`, `</strong>`),
+		italic: wrap(`<em>`, `</em>`),
+		strike: wrap(`<s>`, `</s>`),
+		code: func(raw string) string {
+			return `<code>` + html.EscapeString(raw) + `</code>`
+		},
+		link: func(text, url string) string {
+			return `<a href="` + html.EscapeString(url) + `">` + text + `</a>`
+		},
+		heading: func(level int, text string) string {
+			return fmt.Sprintf(`<h%d>%s</h%d>`, level, text, level)
+		},
+		list: func(ordered bool, start int, items []string) string {
+			tag := `ul`
+			open := `<ul>`
+			if ordered {
+				tag = `ol`
+				open = `<ol>`
+				if start != 1 {
+					open = fmt.Sprintf(`<ol start="%d">`, start)
+				}
+			}
+			return open + "\n<li>" + strings.Join(items, "</li>\n<li>") + "</li>\n</" + tag + ">"
+		},
+		codeBlock: func(_, raw string) string {
+			return `<pre><code>` + html.EscapeString(raw) + `</code></pre>`
+		},
+		paragraph: wrap(`<p>`, `</p>`),
+		lineBreak: "<br>\n",
+		separator: "\n",
+	}
+
+	// see https://core.telegram.org/bots/api#html-style
+	telegramHTMLStyle = style{
+		escape: escapeTelegramHTML,
+		bold:   wrap(`<b>`, `</b>`),
+		italic: wrap(`<i>`, `</i>`),
+		strike: wrap(`<s>`, `</s>`),
+		code: func(raw string) string {
+			return `<code>` + escapeTelegramHTML(raw) + `</code>`
+		},
+		link: func(text, url string) string {
+			return `<a href="` + strings.ReplaceAll(escapeTelegramHTML(url), `"`, `&quot;`) + `">` + text + `</a>`
+		},
+		heading: func(_ int, text string) string {
+			return `<b>` + text + `</b>`
+		},
+		list: textList(`• `),
+		codeBlock: func(language, raw string) string {
+			if language != "" {
+				return `<pre><code class="language-` + escapeTelegramHTML(language) + `">` +
+					escapeTelegramHTML(raw) + `</code></pre>`
+			}
+			return `<pre>` + escapeTelegramHTML(raw) + `</pre>`
+		},
+		paragraph: identity,
+		lineBreak: "\n",
+		separator: "\n\n",
+	}
+
+	// see https://core.telegram.org/bots/api#markdownv2-style
+	telegramMarkdownV2Style = style{
+		escape: escapeTelegramMarkdownV2,
+		bold:   wrap(`*`, `*`),
+		italic: wrap(`_`, `_`),
+		strike: wrap(`~`, `~`),
+		code: func(raw string) string {
+			return "`" + escapeTelegramMarkdownV2Code(raw) + "`"
+		},
+		link: func(text, url string) string {
+			url = strings.NewReplacer(`\`, `\\`, `)`, `\)`).Replace(url)
+			return `[` + text + `](` + url + `)`
+		},
+		heading: func(_ int, text string) string {
+			return `*` + text + `*`
+		},
+		list: func(ordered bool, start int, items []string) string {
+			lines := make([]string, 0, len(items))
+			for i, item := range items {
+				if ordered {
+					lines = append(lines, strconv.Itoa(start+i)+`\. `+item)
+				} else {
+					lines = append(lines, `• `+item)
+				}
+			}
+			return strings.Join(lines, "\n")
+		},
+		codeBlock: func(language, raw string) string {
+			return "```" + language + "\n" + escapeTelegramMarkdownV2Code(raw) + "\n```"
+		},
+		paragraph: identity,
+		lineBreak: "\n",
+		separator: "\n\n",
+	}
+
+	textStyle = style{
+		escape: identity,
+		bold:   identity,
+		italic: identity,
+		strike: identity,
+		code:   identity,
+		link: func(text, url string) string {
+			if text == url || text == "" {
+				return url
+			}
+			return text + ` (` + url + `)`
+		},
+		heading: func(_ int, text string) string {
+			return text
+		},
+		list: textList(`- `),
+		codeBlock: func(_, raw string) string {
+			return raw
+		},
+		paragraph: identity,
+		lineBreak: "\n",
+		separator: "\n\n",
+	}
+)
+
+// HTML renders message as HTML for email
+func HTML(source string) string {
+	return render(Parse(source), htmlStyle)
+}
+
+// TelegramHTML renders message as text of Telegram message with parse mode HTML
+func TelegramHTML(source string) string {
+	return render(Parse(source), telegramHTMLStyle)
+}
+
+// TelegramMarkdownV2 renders message as text of Telegram message with parse mode MarkdownV2
+func TelegramMarkdownV2(source string) string {
+	return render(Parse(source), telegramMarkdownV2Style)
+}
+
+// Text renders message as plain text for text alternative of email and SMS: links are written as text (url)
+func Text(source string) string {
+	return render(Parse(source), textStyle)
+}
+
+func render(blocks []Block, s style) string {
+	rendered := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		switch block.Kind {
+		case BlockHeading:
+			rendered = append(rendered, s.heading(block.Level, renderInline(block.Inlines, s)))
+		case BlockList:
+			items := make([]string, 0, len(block.Items))
+			for _, item := range block.Items {
+				items = append(items, renderInline(item, s))
+			}
+			rendered = append(rendered, s.list(block.Ordered, block.Start, items))
+		case BlockCode:
+			rendered = append(rendered, s.codeBlock(block.Language, block.Text))
+		default:
+			rendered = append(rendered, s.paragraph(renderInline(block.Inlines, s)))
+		}
+	}
+	return strings.Join(rendered, s.separator)
+}
+
+func renderInline(inlines []Inline, s style) string {
+	rendered := strings.Builder{}
+	for _, inline := range inlines {
+		switch inline.Kind {
+		case InlineBold:
+			rendered.WriteString(s.bold(renderInline(inline.Children, s)))
+		case InlineItalic:
+			rendered.WriteString(s.italic(renderInline(inline.Children, s)))
+		case InlineStrike:
+			rendered.WriteString(s.strike(renderInline(inline.Children, s)))
+		case InlineCode:
+			rendered.WriteString(s.code(inline.Text))
+		case InlineLink:
+			if !allowedLink(inline.URL) {
+				rendered.WriteString(renderInline(inline.Children, s))
+				continue
+			}
+			rendered.WriteString(s.link(renderInline(inline.Children, s), inline.URL))
+		default:
+			lines := strings.Split(inline.Text, "\n")
+			for i, line := range lines {
+				if i > 0 {
+					rendered.WriteString(s.lineBreak)
+				}
+				rendered.WriteString(s.escape(line))
+			}
+		}
+	}
+	return rendered.String()
+}
+
+// allowedLink reports whether url of link has allowed scheme, links with other schemes (javascript:, data:, relative)
+// are rendered as plain text
+func allowedLink(link string) bool {
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(parsed.Scheme) {
+	case `http`, `https`, `mailto`:
+		return true
+	default:
+		return false
+	}
+}
+
+func escapeTelegramHTML(text string) string {
+	return strings.NewReplacer(`&`, `&amp;`, `<`, `&lt;`, `>`, `&gt;`).Replace(text)
+}
+
+// escapeTelegramMarkdownV2 escapes all reserved symbols of MarkdownV2 outside of entities
+func escapeTelegramMarkdownV2(text string) string {
+	escaped := strings.Builder{}
+	for _, r := range text {
+		if strings.ContainsRune("_*[]()~`>#+-=|{}.!\\", r) {
+			escaped.WriteRune('\\')
+		}
+		escaped.WriteRune(r)
+	}
+	return escaped.String()
+}
+
+// escapeTelegramMarkdownV2Code escapes backticks and backslashes inside code entities of MarkdownV2
+func escapeTelegramMarkdownV2Code(text string) string {
+	return strings.NewReplacer("`", "\\`", `\`, `\\`).Replace(text)
+}
+
+func textList(bullet string) func(ordered bool, start int, items []string) string {
+	return func(ordered bool, start int, items []string) string {
+		lines := make([]string, 0, len(items))
+		for i, item := range items {
+			if ordered {
+				lines = append(lines, strconv.Itoa(start+i)+`. `+item)
+			} else {
+				lines = append(lines, bullet+item)
+			}
+		}
+		return strings.Join(lines, "\n")
+	}
+}
+
+func wrap(open, close string) func(string) string {
+	return func(text string) string {
+		return open + text + close
+	}
+}
+
+func identity(text string) string {
+	return text
+}
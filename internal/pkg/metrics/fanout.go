@@ -0,0 +1,83 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+)
+
+// fanout sends metrics to all backends
+type fanout []Metrics
+
+// fanoutTiming reports one measured duration to all backends
+type fanoutTiming struct {
+	start   time.Time
+	metrics fanout
+}
+
+// Fanout returns Metrics sending to all of ms, returns single backend as is
+func Fanout(ms ...Metrics) Metrics {
+	if len(ms) == 1 {
+		return ms[0]
+	}
+	return fanout(ms)
+}
+
+func (f fanout) Count(bucket string, n interface{}) {
+	for _, m := range f {
+		m.Count(bucket, n)
+	}
+}
+
+func (f fanout) Increment(bucket string) {
+	for _, m := range f {
+		m.Increment(bucket)
+	}
+}
+
+func (f fanout) Gauge(bucket string, value interface{}) {
+	for _, m := range f {
+		m.Gauge(bucket, value)
+	}
+}
+
+func (f fanout) Timing(bucket string, value interface{}) {
+	for _, m := range f {
+		m.Timing(bucket, value)
+	}
+}
+
+func (f fanout) Histogram(bucket string, value interface{}) {
+	for _, m := range f {
+		m.Histogram(bucket, value)
+	}
+}
+
+func (f fanout) NewTiming() Timing {
+	return &fanoutTiming{
+		start:   time.Now(),
+		metrics: f,
+	}
+}
+
+func (f fanout) Close() {
+	for _, m := range f {
+		m.Close()
+	}
+}
+
+func (f fanout) Handler() http.Handler {
+	for _, m := range f {
+		if handler := Handler(m); handler != nil {
+			return handler
+		}
+	}
+	return nil
+}
+
+func (t *fanoutTiming) Send(bucket string) {
+	t.metrics.Timing(bucket, float64(t.Duration())/float64(time.Millisecond))
+}
+
+func (t *fanoutTiming) Duration() time.Duration {
+	return time.Since(t.start)
+}
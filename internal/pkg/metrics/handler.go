@@ -0,0 +1,29 @@
+package metrics
+
+import (
+	"net/http"
+
+	kratosHTTP "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+const HandlerPath = `/metrics`
+
+// Handler returns handler exposing metrics for scraping or nil if metrics are only pushed
+func Handler(m Metrics) http.Handler {
+	if exposer, ok := m.(interface{ Handler() http.Handler }); ok {
+		return exposer.Handler()
+	}
+	return nil
+}
+
+// RegisterHandler adds handler of metrics to server if metrics are exposed for scraping
+func RegisterHandler(s *kratosHTTP.Server, m Metrics) {
+	handler := Handler(m)
+	if handler == nil {
+		return
+	}
+	s.Route("/").GET(HandlerPath, func(ctx kratosHTTP.Context) error {
+		handler.ServeHTTP(ctx.Response(), ctx.Request())
+		return nil
+	})
+}
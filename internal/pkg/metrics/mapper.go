@@ -0,0 +1,176 @@
+package metrics
+
+import (
+	"strings"
+	"unicode"
+)
+
+type Kind int
+
+const (
+	KindCounter Kind = iota
+	KindGauge
+	KindHistogram
+	KindTiming
+)
+
+const (
+	labelResult = `result`
+
+	suffixTimings = `timings`
+)
+
+var (
+	// DefaultTemplates maps buckets with variable segments to metrics with labels
+	DefaultTemplates = []Template{
+		{Pattern: `http.{service}.{method}`, Name: `http.server.requests`},
+		{Pattern: `grpc.{service}.{method}`, Name: `grpc.server.requests`},
+		{Pattern: `worker.pool.{pool}.busy`},
+		{Pattern: `worker.pool.{pool}.saturation`},
+		{Pattern: `worker.pool.{pool}.blocked.timings`},
+		{Pattern: `worker.queue.{status}.{type}.depth`},
+		{Pattern: `worker.queue.{status}.{type}.oldestAge`, Name: `worker.queue.oldestAgeSeconds`},
+		{
+			Pattern: `biz.notification.delivery.{type}.latency.timings`,
+			Buckets: []float64{0.1, 0.5, 1, 5, 10, 30, 60, 300, 900, 1800, 3600, 7200, 21600, 86400},
+		},
+		{
+			Pattern: `biz.notification.delivery.{type}.{status}.retries`,
+			Buckets: []float64{0, 1, 2, 3, 5, 8, 13, 21},
+		},
+	}
+
+	results = map[string]bool{
+		`success`: true,
+		`failure`: true,
+	}
+)
+
+// Template of bucket: segments in braces become labels of metric, Name replaces rest of segments if set,
+// Buckets replace default buckets of histogram
+type Template struct {
+	Pattern string
+	Name    string
+	Buckets []float64
+}
+
+// Metric is name and labels of prometheus metric
+type Metric struct {
+	Name    string
+	Labels  map[string]string
+	Buckets []float64
+}
+
+// Mapper converts StatsD buckets to prometheus metrics, so names of buckets are kept in one place:
+// 'biz.notification.sendNotification.success' is counter 'biz_notification_send_notification_total{result="success"}',
+// 'data.notification.save.timings' is histogram 'data_notification_save_duration_seconds'
+type Mapper struct {
+	templates []Template
+}
+
+func NewMapper(templates ...Template) *Mapper {
+	return &Mapper{
+		templates: templates,
+	}
+}
+
+func (m *Mapper) Map(bucket string, kind Kind) Metric {
+	segments := strings.Split(bucket, ".")
+	labels := map[string]string{}
+	var buckets []float64
+	for _, template := range m.templates {
+		if matched, ok := matchTemplate(template, segments); ok {
+			segments = matched
+			buckets = template.Buckets
+			for name, value := range extractLabels(template, bucket) {
+				labels[name] = value
+			}
+			break
+		}
+	}
+
+	last := segments[len(segments)-1]
+	if len(segments) > 1 && results[last] {
+		labels[labelResult] = last
+		segments = segments[:len(segments)-1]
+	}
+	if kind == KindTiming && len(segments) > 1 && segments[len(segments)-1] == suffixTimings {
+		segments = segments[:len(segments)-1]
+	}
+
+	name := sanitize(segments)
+	switch kind {
+	case KindCounter:
+		name += `_total`
+	case KindTiming:
+		name += `_duration_seconds`
+	}
+	return Metric{
+		Name:    name,
+		Labels:  labels,
+		Buckets: buckets,
+	}
+}
+
+// matchTemplate returns segments of metric name if bucket matches template
+func matchTemplate(template Template, segments []string) ([]string, bool) {
+	patterns := strings.Split(template.Pattern, ".")
+	if len(patterns) != len(segments) {
+		return nil, false
+	}
+	literals := []string{}
+	for i, pattern := range patterns {
+		if isPlaceholder(pattern) {
+			continue
+		}
+		if pattern != segments[i] {
+			return nil, false
+		}
+		literals = append(literals, pattern)
+	}
+	if template.Name != `` {
+		literals = strings.Split(template.Name, ".")
+	}
+	return literals, true
+}
+
+func extractLabels(template Template, bucket string) map[string]string {
+	labels := map[string]string{}
+	segments := strings.Split(bucket, ".")
+	for i, pattern := range strings.Split(template.Pattern, ".") {
+		if isPlaceholder(pattern) {
+			labels[strings.Trim(pattern, `{}`)] = segments[i]
+		}
+	}
+	return labels
+}
+
+func isPlaceholder(segment string) bool {
+	return strings.HasPrefix(segment, `{`) && strings.HasSuffix(segment, `}`)
+}
+
+// sanitize joins segments in snake case name valid for prometheus
+func sanitize(segments []string) string {
+	builder := strings.Builder{}
+	for i, segment := range segments {
+		if i > 0 {
+			builder.WriteRune('_')
+		}
+		previous := rune(0)
+		for _, r := range segment {
+			switch {
+			case unicode.IsUpper(r):
+				if previous != 0 && previous != '_' && !unicode.IsUpper(previous) {
+					builder.WriteRune('_')
+				}
+				builder.WriteRune(unicode.ToLower(r))
+			case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == ':':
+				builder.WriteRune(r)
+			default:
+				builder.WriteRune('_')
+			}
+			previous = r
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,112 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapper_Map(t *testing.T) {
+	mapper := NewMapper(DefaultTemplates...)
+
+	testCases := []struct {
+		name     string
+		bucket   string
+		kind     Kind
+		expected Metric
+	}{
+		{
+			name:   "result",
+			bucket: "biz.notification.sendNotification.success",
+			kind:   KindCounter,
+			expected: Metric{
+				Name:   "biz_notification_send_notification_total",
+				Labels: map[string]string{"result": "success"},
+			},
+		},
+		{
+			name:   "counter",
+			bucket: "starts.count",
+			kind:   KindCounter,
+			expected: Metric{
+				Name:   "starts_count_total",
+				Labels: map[string]string{},
+			},
+		},
+		{
+			name:   "timings",
+			bucket: "data.notification.save.timings",
+			kind:   KindTiming,
+			expected: Metric{
+				Name:   "data_notification_save_duration_seconds",
+				Labels: map[string]string{},
+			},
+		},
+		{
+			name:   "gauge",
+			bucket: "postgres.connections.max_idle_closed",
+			kind:   KindGauge,
+			expected: Metric{
+				Name:   "postgres_connections_max_idle_closed",
+				Labels: map[string]string{},
+			},
+		},
+		{
+			name:   "http",
+			bucket: "http.api_notification_v1_notification.send",
+			kind:   KindTiming,
+			expected: Metric{
+				Name: "http_server_requests_duration_seconds",
+				Labels: map[string]string{
+					"service": "api_notification_v1_notification",
+					"method":  "send",
+				},
+			},
+		},
+		{
+			name:   "pool",
+			bucket: "worker.pool.email.blocked.timings",
+			kind:   KindTiming,
+			expected: Metric{
+				Name:   "worker_pool_blocked_duration_seconds",
+				Labels: map[string]string{"pool": "email"},
+			},
+		},
+		{
+			name:   "pool-gauge",
+			bucket: "worker.pool.common.saturation",
+			kind:   KindGauge,
+			expected: Metric{
+				Name:   "worker_pool_saturation",
+				Labels: map[string]string{"pool": "common"},
+			},
+		},
+		{
+			name:   "queue-age",
+			bucket: "worker.queue.pending.email.oldestAge",
+			kind:   KindGauge,
+			expected: Metric{
+				Name:   "worker_queue_oldest_age_seconds",
+				Labels: map[string]string{"status": "pending", "type": "email"},
+			},
+		},
+		{
+			name:   "buckets",
+			bucket: "biz.notification.delivery.sms.fail.retries",
+			kind:   KindHistogram,
+			expected: Metric{
+				Name:    "biz_notification_delivery_retries",
+				Labels:  map[string]string{"type": "sms", "status": "fail"},
+				Buckets: []float64{0, 1, 2, 3, 5, 8, 13, 21},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				require.Equal(t, testCase.expected, mapper.Map(testCase.bucket, testCase.kind))
+			},
+		)
+	}
+}
@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"gopkg.in/alexcesaro/statsd.v2"
@@ -9,17 +10,38 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 )
 
+const (
+	ExporterStatsd     = `statsd`
+	ExporterPrometheus = `prometheus`
+	ExporterAll        = `all`
+)
+
 type Metrics interface {
 	Count(bucket string, n interface{})
 	Increment(bucket string)
 	Gauge(bucket string, value interface{})
 	Timing(bucket string, value interface{})
 	Histogram(bucket string, value interface{})
-	NewTiming() statsd.Timing
+	NewTiming() Timing
 	Close()
 }
 
-func New(address, name string, mute bool) (*statsd.Client, error) {
+// Timing measures duration from its creation, Send reports duration in milliseconds to bucket
+type Timing interface {
+	Send(bucket string)
+	Duration() time.Duration
+}
+
+// Statsd is Metrics sent to StatsD
+type Statsd struct {
+	*statsd.Client
+}
+
+func (s *Statsd) NewTiming() Timing {
+	return s.Client.NewTiming()
+}
+
+func New(address, name string, mute bool) (*Statsd, error) {
 	metrics, err := statsd.New(
 		statsd.Address(address),
 		statsd.ErrorHandler(
@@ -40,5 +62,23 @@ func New(address, name string, mute bool) (*statsd.Client, error) {
 	if err != nil && !mute {
 		return nil, err
 	}
-	return metrics, nil
+	return &Statsd{Client: metrics}, nil
+}
+
+// NewExporter returns metrics of exporter: StatsD, Prometheus or both of them
+func NewExporter(exporter, address, name string, mute bool) (Metrics, error) {
+	switch exporter {
+	case ``, ExporterStatsd:
+		return New(address, name, mute)
+	case ExporterPrometheus:
+		return NewPrometheus(name), nil
+	case ExporterAll:
+		statsdMetrics, err := New(address, name, mute)
+		if err != nil {
+			return nil, err
+		}
+		return Fanout(statsdMetrics, NewPrometheus(name)), nil
+	default:
+		return nil, fmt.Errorf(`unknown metrics exporter: %s`, exporter)
+	}
 }
@@ -0,0 +1,213 @@
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Prometheus is Metrics collected in registry and exposed by Handler
+type Prometheus struct {
+	registry   *prometheus.Registry
+	mapper     *Mapper
+	app        string
+	mu         sync.Mutex
+	collectors map[string]*collector
+}
+
+// collector is vector of one kind with fixed set of label names
+type collector struct {
+	kind       Kind
+	labels     []string
+	counter    *prometheus.CounterVec
+	gauge      *prometheus.GaugeVec
+	histogram  *prometheus.HistogramVec
+	registered bool
+}
+
+// prometheusTiming measures duration for Prometheus
+type prometheusTiming struct {
+	start   time.Time
+	metrics *Prometheus
+}
+
+func (t *prometheusTiming) Send(bucket string) {
+	t.metrics.Timing(bucket, float64(t.Duration())/float64(time.Millisecond))
+}
+
+func (t *prometheusTiming) Duration() time.Duration {
+	return time.Since(t.start)
+}
+
+func NewPrometheus(name string, templates ...Template) *Prometheus {
+	if len(templates) == 0 {
+		templates = DefaultTemplates
+	}
+	return &Prometheus{
+		registry:   prometheus.NewRegistry(),
+		mapper:     NewMapper(templates...),
+		app:        name,
+		collectors: map[string]*collector{},
+	}
+}
+
+// Handler exposes metrics in prometheus text format
+func (p *Prometheus) Handler() http.Handler {
+	return promhttp.HandlerFor(p.registry, promhttp.HandlerOpts{})
+}
+
+// Registry returns registry of metrics, e.g. for registration of other collectors
+func (p *Prometheus) Registry() *prometheus.Registry {
+	return p.registry
+}
+
+func (p *Prometheus) Count(bucket string, n interface{}) {
+	metric := p.mapper.Map(bucket, KindCounter)
+	if c := p.collector(metric, KindCounter); c != nil {
+		c.counter.With(metric.Labels).Add(toFloat(n))
+	}
+}
+
+func (p *Prometheus) Increment(bucket string) {
+	p.Count(bucket, 1)
+}
+
+func (p *Prometheus) Gauge(bucket string, value interface{}) {
+	metric := p.mapper.Map(bucket, KindGauge)
+	if c := p.collector(metric, KindGauge); c != nil {
+		c.gauge.With(metric.Labels).Set(toFloat(value))
+	}
+}
+
+// Timing observes value in milliseconds as seconds
+func (p *Prometheus) Timing(bucket string, value interface{}) {
+	metric := p.mapper.Map(bucket, KindTiming)
+	if c := p.collector(metric, KindTiming); c != nil {
+		c.histogram.With(metric.Labels).Observe(toFloat(value) / 1000)
+	}
+}
+
+func (p *Prometheus) Histogram(bucket string, value interface{}) {
+	metric := p.mapper.Map(bucket, KindHistogram)
+	if c := p.collector(metric, KindHistogram); c != nil {
+		c.histogram.With(metric.Labels).Observe(toFloat(value))
+	}
+}
+
+func (p *Prometheus) NewTiming() Timing {
+	return &prometheusTiming{
+		start:   time.Now(),
+		metrics: p,
+	}
+}
+
+func (p *Prometheus) Close() {}
+
+// collector returns registered vector for metric or nil if metric conflicts with already registered one
+func (p *Prometheus) collector(metric Metric, kind Kind) *collector {
+	labels := make([]string, 0, len(metric.Labels)+1)
+	for label := range metric.Labels {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if c, ok := p.collectors[metric.Name]; ok {
+		if !c.registered || c.kind != kind || strings.Join(c.labels, ",") != strings.Join(labels, ",") {
+			return nil
+		}
+		return c
+	}
+
+	c := &collector{kind: kind, labels: labels}
+	constLabels := prometheus.Labels{`app`: p.app}
+	var vec prometheus.Collector
+	switch kind {
+	case KindCounter:
+		c.counter = prometheus.NewCounterVec(
+			prometheus.CounterOpts{Name: metric.Name, ConstLabels: constLabels},
+			labels,
+		)
+		vec = c.counter
+	case KindGauge:
+		c.gauge = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{Name: metric.Name, ConstLabels: constLabels},
+			labels,
+		)
+		vec = c.gauge
+	default:
+		buckets := prometheus.DefBuckets
+		if len(metric.Buckets) > 0 {
+			buckets = metric.Buckets
+		}
+		c.histogram = prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{Name: metric.Name, ConstLabels: constLabels, Buckets: buckets},
+			labels,
+		)
+		vec = c.histogram
+	}
+
+	if err := p.registry.Register(vec); err != nil {
+		log.Warnf(`failed to register prometheus metric %s: %v`, metric.Name, err)
+	} else {
+		c.registered = true
+	}
+	// Conflicting metric is remembered to warn only once
+	p.collectors[metric.Name] = c
+	if !c.registered {
+		return nil
+	}
+	return c
+}
+
+func toFloat(value interface{}) float64 {
+	switch v := value.(type) {
+	case float64:
+		return v
+	case float32:
+		return float64(v)
+	case int:
+		return float64(v)
+	case int8:
+		return float64(v)
+	case int16:
+		return float64(v)
+	case int32:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case uint:
+		return float64(v)
+	case uint8:
+		return float64(v)
+	case uint16:
+		return float64(v)
+	case uint32:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	case time.Duration:
+		return float64(v) / float64(time.Millisecond)
+	case bool:
+		if v {
+			return 1
+		}
+		return 0
+	default:
+		parsed, err := strconv.ParseFloat(fmt.Sprint(v), 64)
+		if err != nil {
+			return 0
+		}
+		return parsed
+	}
+}
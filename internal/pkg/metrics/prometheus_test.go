@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrometheus_Handler(t *testing.T) {
+	prometheus := NewPrometheus("test")
+	metric := Fanout(prometheus)
+
+	metric.Increment("biz.notification.sendNotification.success")
+	metric.Increment("biz.notification.sendNotification.success")
+	metric.Increment("biz.notification.sendNotification.failure")
+	metric.Gauge("worker.pool.email.busy", 3)
+	metric.Timing("data.notification.save.timings", 250)
+	metric.NewTiming().Send("http.api_notification_v1_notification.send")
+
+	// conflicting with counter above, must be skipped
+	metric.Gauge("biz.notification.sendNotification_total", 1)
+
+	recorder := httptest.NewRecorder()
+	Handler(metric).ServeHTTP(recorder, httptest.NewRequest("GET", HandlerPath, nil))
+	body, err := io.ReadAll(recorder.Body)
+	require.NoError(t, err)
+
+	exposed := string(body)
+	require.Contains(t, exposed, `biz_notification_send_notification_total{app="test",result="success"} 2`)
+	require.Contains(t, exposed, `biz_notification_send_notification_total{app="test",result="failure"} 1`)
+	require.Contains(t, exposed, `worker_pool_busy{app="test",pool="email"} 3`)
+	require.Contains(t, exposed, `data_notification_save_duration_seconds_sum{app="test"} 0.25`)
+	require.Contains(t, exposed, `http_server_requests_duration_seconds_count{app="test",method="send",service="api_notification_v1_notification"} 1`)
+}
+
+func TestFanout(t *testing.T) {
+	first := NewPrometheus("first")
+	second := NewPrometheus("second")
+	metric := Fanout(first, second)
+
+	metric.Count("starts.count", 2)
+
+	for _, prometheus := range []*Prometheus{first, second} {
+		families, err := prometheus.Registry().Gather()
+		require.NoError(t, err)
+		require.Len(t, families, 1)
+		require.Equal(t, "starts_count_total", families[0].GetName())
+		require.Equal(t, float64(2), families[0].GetMetric()[0].GetCounter().GetValue())
+	}
+
+	require.NotNil(t, Handler(metric))
+	statsd, err := New("", "test", true)
+	require.NoError(t, err)
+	require.Nil(t, Handler(statsd))
+}
@@ -1,4 +1,4 @@
-package server
+package pprof
 
 import (
 	"net/http"
@@ -8,7 +8,8 @@ import (
 	kratosHTTP "github.com/go-kratos/kratos/v2/transport/http"
 )
 
-func registerPProfHandlers(s *kratosHTTP.Server) {
+// Register adds handlers of net/http/pprof to server
+func Register(s *kratosHTTP.Server) {
 	r := s.Route("/")
 
 	wrap := func(handle func(w http.ResponseWriter, r *http.Request)) kratosHTTP.HandlerFunc {
@@ -0,0 +1,102 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limit of token bucket: Rate tokens per second are added to bucket with capacity of Burst tokens
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Limiter takes token from bucket by key. If bucket is empty, token is not taken,
+// and returned delay is time to wait until token will be available
+type Limiter interface {
+	Reserve(ctx context.Context, key string, limit Limit) (time.Duration, error)
+
+	// Release returns token taken by Reserve to bucket, e.g. if other bucket has no token for the same send
+	Release(ctx context.Context, key string, limit Limit) error
+}
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+// Memory is token bucket limiter shared between goroutines of one process
+type Memory struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		buckets: map[string]*bucket{},
+		now:     time.Now,
+	}
+}
+
+func (m *Memory) Reserve(_ context.Context, key string, limit Limit) (time.Duration, error) {
+	if limit.Rate <= 0 {
+		return 0, nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &bucket{tokens: Capacity(limit), updatedAt: now}
+		m.buckets[key] = b
+	}
+	available := Refill(b.tokens, now.Sub(b.updatedAt), limit)
+	b.updatedAt = now
+	if available >= 1 {
+		b.tokens = available - 1
+		return 0, nil
+	}
+	b.tokens = available
+	return Delay(available, limit), nil
+}
+
+func (m *Memory) Release(_ context.Context, key string, limit Limit) error {
+	if limit.Rate <= 0 {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if b, ok := m.buckets[key]; ok {
+		b.tokens = math.Min(Capacity(limit), b.tokens+1)
+	}
+	return nil
+}
+
+// Capacity returns maximum count of tokens in bucket
+func Capacity(limit Limit) float64 {
+	if limit.Burst < 1 {
+		return 1
+	}
+	return float64(limit.Burst)
+}
+
+// Refill returns count of tokens in bucket after elapsed time
+func Refill(tokens float64, elapsed time.Duration, limit Limit) float64 {
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	return math.Min(Capacity(limit), tokens+elapsed.Seconds()*limit.Rate)
+}
+
+// Delay returns time to wait until bucket with available tokens will have one token
+func Delay(available float64, limit Limit) time.Duration {
+	if available >= 1 || limit.Rate <= 0 {
+		return 0
+	}
+	return time.Duration(math.Ceil((1 - available) / limit.Rate * float64(time.Second)))
+}
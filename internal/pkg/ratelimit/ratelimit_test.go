@@ -0,0 +1,54 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemory_Reserve(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	limiter := NewMemory()
+	limiter.now = func() time.Time {
+		return now
+	}
+
+	limit := Limit{Rate: 2, Burst: 3}
+
+	for i := 0; i < 3; i++ {
+		delay, err := limiter.Reserve(ctx, `telegram`, limit)
+		require.NoError(t, err)
+		require.Zero(t, delay)
+	}
+
+	delay, err := limiter.Reserve(ctx, `telegram`, limit)
+	require.NoError(t, err)
+	require.Equal(t, 500*time.Millisecond, delay)
+
+	delay, err = limiter.Reserve(ctx, `telegram:100500`, limit)
+	require.NoError(t, err)
+	require.Zero(t, delay, `other key has own bucket`)
+
+	now = now.Add(250 * time.Millisecond)
+	delay, err = limiter.Reserve(ctx, `telegram`, limit)
+	require.NoError(t, err)
+	require.Equal(t, 250*time.Millisecond, delay)
+
+	now = now.Add(250 * time.Millisecond)
+	delay, err = limiter.Reserve(ctx, `telegram`, limit)
+	require.NoError(t, err)
+	require.Zero(t, delay)
+
+	require.NoError(t, limiter.Release(ctx, `telegram`, limit))
+	delay, err = limiter.Reserve(ctx, `telegram`, limit)
+	require.NoError(t, err)
+	require.Zero(t, delay, `released token is taken again`)
+
+	delay, err = limiter.Reserve(ctx, `unlimited`, Limit{})
+	require.NoError(t, err)
+	require.Zero(t, delay)
+}
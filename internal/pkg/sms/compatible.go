@@ -1,5 +1,10 @@
 package sms
 
+import (
+	"strings"
+	"unicode"
+)
+
 // see https://www.twilio.com/docs/glossary/what-is-gsm-7-character-encoding
 
 const (
@@ -46,3 +51,22 @@ func IsExceedsLimit(message string) bool {
 	}
 	return len(runes) > limitUCS2
 }
+
+// Fit returns message truncated with ellipsis to limit of one message or to overall limit of split message
+func Fit(message string, split bool) string {
+	fits := func(message string) bool {
+		return len(message) <= LimitOverall && (split || !IsExceedsLimit(message))
+	}
+	if fits(message) {
+		return message
+	}
+	ellipsis := `...`
+	runes := []rune(message)
+	if len(runes) > LimitOverall {
+		runes = runes[:LimitOverall]
+	}
+	for len(runes) > 0 && !fits(string(runes)+ellipsis) {
+		runes = runes[:len(runes)-1]
+	}
+	return strings.TrimRightFunc(string(runes), unicode.IsSpace) + ellipsis
+}
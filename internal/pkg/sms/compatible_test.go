@@ -1,6 +1,7 @@
 package sms
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -43,3 +44,45 @@ func TestIsCompatibleWithGSM7(t *testing.T) {
 		)
 	}
 }
+
+func TestFit(t *testing.T) {
+	long := strings.Repeat(`word `, 40)
+	testCases := []struct {
+		name     string
+		message  string
+		split    bool
+		expected string
+	}{
+		{
+			name:     `short`,
+			message:  `Hello, world!`,
+			expected: `Hello, world!`,
+		},
+		{
+			name:     `latin`,
+			message:  long,
+			expected: strings.TrimSpace(long[:157]) + `...`,
+		},
+		{
+			name:     `cyrillic`,
+			message:  strings.Repeat(`слово `, 20),
+			expected: strings.TrimSpace(string([]rune(strings.Repeat(`слово `, 20))[:67])) + `...`,
+		},
+		{
+			name:     `split`,
+			message:  long,
+			split:    true,
+			expected: long,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				actual := Fit(testCase.message, testCase.split)
+				require.Equal(t, testCase.expected, actual)
+				require.False(t, !testCase.split && IsExceedsLimit(actual))
+			},
+		)
+	}
+}
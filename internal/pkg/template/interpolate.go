@@ -7,9 +7,27 @@ import (
 
 // Interpolate returns "Hello, John!" from source=`Hello, {{ .user }}!` and replacements=map[string]any{"user": "John"}
 func Interpolate(source string, replacements map[string]any) (string, error) {
+	return interpolate(source, replacements, nil)
+}
+
+// InterpolateLocalized interpolates source with helpers of locale: plural, number, date and datetime
+func InterpolateLocalized(source string, replacements map[string]any, locale string) (string, error) {
+	return interpolate(source, replacements, Funcs(locale))
+}
+
+func MustInterpolate(source string, replacements map[string]any) string {
+	res, err := Interpolate(source, replacements)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+func interpolate(source string, replacements map[string]any, funcs template.FuncMap) (string, error) {
 	// see https://pkg.go.dev/text/template
 	parsed, err := template.New(`pkg/template/interpolate`).
 		Option(`missingkey=error`).
+		Funcs(funcs).
 		Parse(source)
 	if err != nil {
 		return "", err
@@ -21,11 +39,3 @@ func Interpolate(source string, replacements map[string]any) (string, error) {
 	}
 	return buf.String(), nil
 }
-
-func MustInterpolate(source string, replacements map[string]any) string {
-	res, err := Interpolate(source, replacements)
-	if err != nil {
-		panic(err)
-	}
-	return res
-}
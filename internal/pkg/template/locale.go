@@ -0,0 +1,216 @@
+package template
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+const (
+	LocaleEnglish = `en`
+	LocaleRussian = `ru`
+	LocaleKazakh  = `kk`
+)
+
+var (
+	monthsRussian = [12]string{
+		`января`, `февраля`, `марта`, `апреля`, `мая`, `июня`,
+		`июля`, `августа`, `сентября`, `октября`, `ноября`, `декабря`,
+	}
+	monthsKazakh = [12]string{
+		`қаңтар`, `ақпан`, `наурыз`, `сәуір`, `мамыр`, `маусым`,
+		`шілде`, `тамыз`, `қыркүйек`, `қазан`, `қараша`, `желтоқсан`,
+	}
+)
+
+// Language returns language of locale: "kk" from "kk-KZ" or "kk_KZ"
+func Language(locale string) string {
+	language, _, _ := strings.Cut(strings.ReplaceAll(strings.ToLower(locale), `_`, `-`), `-`)
+	return language
+}
+
+// Fallbacks returns chain of locales from locale by its language and next locales: "kk-KZ", "kk", "ru", "en"
+// for next={"kk": "ru", "ru": "en"}
+func Fallbacks(locale string, next map[string]string) []string {
+	chain := []string{}
+	seen := map[string]bool{}
+	add := func(locale string) {
+		if locale != "" && !seen[locale] {
+			seen[locale] = true
+			chain = append(chain, locale)
+		}
+	}
+	for locale != "" && !seen[locale] {
+		add(locale)
+		add(Language(locale))
+		following, ok := next[locale]
+		if !ok {
+			following = next[Language(locale)]
+		}
+		locale = following
+	}
+	return chain
+}
+
+// Funcs returns helpers of templates for locale:
+//
+//	{{ plural .count "уведомление" "уведомления" "уведомлений" }} — form by plural rules of language
+//	{{ number .amount 2 }} — number with separators of language and optional precision
+//	{{ date .at }}, {{ datetime .at }} — time.Time or RFC 3339 string as date of language
+func Funcs(locale string) template.FuncMap {
+	return template.FuncMap{
+		`plural`: func(n any, forms ...string) (string, error) {
+			value, err := toFloat(n)
+			if err != nil {
+				return "", err
+			}
+			return Plural(locale, int64(value), forms...), nil
+		},
+		`number`: func(n any, precision ...int) (string, error) {
+			value, err := toFloat(n)
+			if err != nil {
+				return "", err
+			}
+			digits := 0
+			if len(precision) > 0 {
+				digits = precision[0]
+			}
+			return FormatNumber(locale, value, digits), nil
+		},
+		`date`: func(t any) (string, error) {
+			value, err := toTime(t)
+			if err != nil {
+				return "", err
+			}
+			return FormatDate(locale, value), nil
+		},
+		`datetime`: func(t any) (string, error) {
+			value, err := toTime(t)
+			if err != nil {
+				return "", err
+			}
+			return FormatDate(locale, value) + ` ` + value.Format(`15:04`), nil
+		},
+	}
+}
+
+// Plural returns form of noun for count n. Forms are one, few and many for Russian ("1 уведомление",
+// "2 уведомления", "5 уведомлений"), one and other for English. Kazakh nouns keep one form after numerals
+func Plural(locale string, n int64, forms ...string) string {
+	if len(forms) == 0 {
+		return ""
+	}
+	form := func(i int) string {
+		if i >= len(forms) {
+			return forms[len(forms)-1]
+		}
+		return forms[i]
+	}
+	if n < 0 {
+		n = -n
+	}
+	switch Language(locale) {
+	case LocaleRussian:
+		switch {
+		case n%10 == 1 && n%100 != 11:
+			return form(0)
+		case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+			return form(1)
+		default:
+			return form(2)
+		}
+	case LocaleKazakh:
+		return form(0)
+	default:
+		if n == 1 {
+			return form(0)
+		}
+		return form(len(forms) - 1)
+	}
+}
+
+// FormatNumber returns "1 234 567,89" for Russian and Kazakh with no-break space and "1,234,567.89" for English
+func FormatNumber(locale string, value float64, precision int) string {
+	group, decimal := `,`, `.`
+	switch Language(locale) {
+	case LocaleRussian, LocaleKazakh:
+		group, decimal = "\u00a0", `,`
+	}
+
+	sign := ``
+	if value < 0 {
+		sign = `-`
+		value = math.Abs(value)
+	}
+	formatted := strconv.FormatFloat(value, 'f', precision, 64)
+	integer, fraction, _ := strings.Cut(formatted, `.`)
+
+	grouped := strings.Builder{}
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			grouped.WriteString(group)
+		}
+		grouped.WriteRune(digit)
+	}
+	if fraction != "" {
+		return sign + grouped.String() + decimal + fraction
+	}
+	return sign + grouped.String()
+}
+
+// FormatDate returns "2 сентября 2022" for Russian, "2022 жылғы 2 қыркүйек" for Kazakh and "September 2, 2022"
+// for other languages
+func FormatDate(locale string, t time.Time) string {
+	switch Language(locale) {
+	case LocaleRussian:
+		return fmt.Sprintf(`%d %s %d`, t.Day(), monthsRussian[t.Month()-1], t.Year())
+	case LocaleKazakh:
+		return fmt.Sprintf(`%d жылғы %d %s`, t.Year(), t.Day(), monthsKazakh[t.Month()-1])
+	default:
+		return t.Format(`January 2, 2006`)
+	}
+}
+
+func toFloat(n any) (float64, error) {
+	switch value := n.(type) {
+	case int:
+		return float64(value), nil
+	case int32:
+		return float64(value), nil
+	case int64:
+		return float64(value), nil
+	case uint:
+		return float64(value), nil
+	case uint32:
+		return float64(value), nil
+	case uint64:
+		return float64(value), nil
+	case float32:
+		return float64(value), nil
+	case float64:
+		return value, nil
+	case string:
+		return strconv.ParseFloat(strings.TrimSpace(value), 64)
+	default:
+		return 0, fmt.Errorf(`%v is not a number`, n)
+	}
+}
+
+func toTime(t any) (time.Time, error) {
+	switch value := t.(type) {
+	case time.Time:
+		return value, nil
+	case *time.Time:
+		if value == nil {
+			return time.Time{}, fmt.Errorf(`time is empty`)
+		}
+		return *value, nil
+	case string:
+		return time.Parse(time.RFC3339, strings.TrimSpace(value))
+	default:
+		return time.Time{}, fmt.Errorf(`%v is not a time`, t)
+	}
+}
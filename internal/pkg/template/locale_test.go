@@ -0,0 +1,125 @@
+package template
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFallbacks(t *testing.T) {
+	next := map[string]string{`kk`: `ru`, `ru`: `en`, `en`: `ru`}
+	require.Equal(t, []string{`kk-KZ`, `kk`, `ru`, `en`}, Fallbacks(`kk-KZ`, next))
+	require.Equal(t, []string{`ru`, `en`}, Fallbacks(`ru`, next))
+	require.Equal(t, []string{`de`}, Fallbacks(`de`, next))
+	require.Empty(t, Fallbacks(``, next))
+}
+
+func TestPlural(t *testing.T) {
+	forms := []string{`уведомление`, `уведомления`, `уведомлений`}
+	testCases := []struct {
+		locale   string
+		n        int64
+		forms    []string
+		expected string
+	}{
+		{locale: `ru`, n: 1, forms: forms, expected: `уведомление`},
+		{locale: `ru`, n: 21, forms: forms, expected: `уведомление`},
+		{locale: `ru`, n: 11, forms: forms, expected: `уведомлений`},
+		{locale: `ru`, n: 3, forms: forms, expected: `уведомления`},
+		{locale: `ru-RU`, n: 104, forms: forms, expected: `уведомления`},
+		{locale: `ru`, n: 12, forms: forms, expected: `уведомлений`},
+		{locale: `ru`, n: 0, forms: forms, expected: `уведомлений`},
+		{locale: `ru`, n: -2, forms: forms, expected: `уведомления`},
+		{locale: `kk`, n: 5, forms: []string{`хабарлама`}, expected: `хабарлама`},
+		{locale: `en`, n: 1, forms: []string{`notification`, `notifications`}, expected: `notification`},
+		{locale: `en`, n: 2, forms: []string{`notification`, `notifications`}, expected: `notifications`},
+		{locale: `ru`, n: 5, forms: []string{`шт.`}, expected: `шт.`},
+		{locale: `ru`, n: 5, expected: ``},
+	}
+	for _, testCase := range testCases {
+		require.Equal(t, testCase.expected, Plural(testCase.locale, testCase.n, testCase.forms...))
+	}
+}
+
+func TestFormatNumber(t *testing.T) {
+	require.Equal(t, "1\u00a0234\u00a0567,89", FormatNumber(`ru`, 1234567.891, 2))
+	require.Equal(t, "-1\u00a0000", FormatNumber(`kk-KZ`, -1000, 0))
+	require.Equal(t, `1,234,567.89`, FormatNumber(`en`, 1234567.891, 2))
+	require.Equal(t, `999`, FormatNumber(`en`, 999, 0))
+}
+
+func TestInterpolateLocalized(t *testing.T) {
+	at := time.Date(2022, 9, 2, 15, 4, 0, 0, time.UTC)
+	testCases := []struct {
+		name         string
+		locale       string
+		source       string
+		replacements map[string]any
+		expected     string
+		wantError    bool
+	}{
+		{
+			name:         `plural`,
+			locale:       `ru`,
+			source:       `{{ .count }} {{ plural .count "новое уведомление" "новых уведомления" "новых уведомлений" }}`,
+			replacements: map[string]any{`count`: 22},
+			expected:     `22 новых уведомления`,
+		},
+		{
+			name:         `plural-of-string`,
+			locale:       `en`,
+			source:       `{{ .count }} {{ plural .count "item" "items" }}`,
+			replacements: map[string]any{`count`: `1`},
+			expected:     `1 item`,
+		},
+		{
+			name:         `number`,
+			locale:       `en`,
+			source:       `{{ number .amount 2 }}`,
+			replacements: map[string]any{`amount`: `1500.5`},
+			expected:     `1,500.50`,
+		},
+		{
+			name:         `date-ru`,
+			locale:       `ru`,
+			source:       `{{ date .at }}`,
+			replacements: map[string]any{`at`: at},
+			expected:     `2 сентября 2022`,
+		},
+		{
+			name:         `datetime-kk`,
+			locale:       `kk`,
+			source:       `{{ datetime .at }}`,
+			replacements: map[string]any{`at`: `2022-09-02T15:04:00Z`},
+			expected:     `2022 жылғы 2 қыркүйек 15:04`,
+		},
+		{
+			name:         `date-en`,
+			locale:       `en-US`,
+			source:       `{{ date .at }}`,
+			replacements: map[string]any{`at`: &at},
+			expected:     `September 2, 2022`,
+		},
+		{
+			name:         `not-a-number`,
+			locale:       `ru`,
+			source:       `{{ number .amount }}`,
+			replacements: map[string]any{`amount`: `many`},
+			wantError:    true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				actual, err := InterpolateLocalized(testCase.source, testCase.replacements, testCase.locale)
+				if testCase.wantError {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, testCase.expected, actual)
+				}
+			},
+		)
+	}
+}
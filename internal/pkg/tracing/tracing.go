@@ -0,0 +1,141 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = `notifications`
+
+	defaultTimeout = 10 * time.Second
+)
+
+// propagator of trace context between services and through stored notifications
+var propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+
+// propagatedHosts are hosts of outgoing requests receiving trace context, see Transport
+var propagatedHosts atomic.Value
+
+type Options struct {
+	Endpoint       string        // address of OTLP gRPC collector
+	Insecure       bool          // disables TLS of connection with collector
+	Ratio          float64       // sampling ratio of root spans, all spans are sampled if zero
+	Timeout        time.Duration // timeout of export
+	PropagateHosts []string      // hosts of outgoing requests receiving trace context, e.g. own services
+}
+
+// NewProvider makes provider exporting spans of service by OTLP and sets it as global, returns shutdown of provider.
+// Spans are not exported if endpoint is empty, but trace context is still propagated
+func NewProvider(ctx context.Context, o Options, id, name, version string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagator)
+	SetPropagatedHosts(o.PropagateHosts...)
+	if o.Endpoint == `` {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	timeout := defaultTimeout
+	if o.Timeout > 0 {
+		timeout = o.Timeout
+	}
+	clientOptions := []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpoint(o.Endpoint),
+		otlptracegrpc.WithTimeout(timeout),
+	}
+	if o.Insecure {
+		clientOptions = append(clientOptions, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, clientOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	sampler := sdktrace.AlwaysSample()
+	if o.Ratio > 0 && o.Ratio < 1 {
+		sampler = sdktrace.TraceIDRatioBased(o.Ratio)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sampler)),
+		sdktrace.WithResource(
+			resource.NewWithAttributes(
+				semconv.SchemaURL,
+				semconv.ServiceInstanceIDKey.String(id),
+				semconv.ServiceNameKey.String(name),
+				semconv.ServiceVersionKey.String(version),
+			),
+		),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// SetPropagatedHosts sets hosts of outgoing requests receiving trace context, other hosts get no trace headers
+func SetPropagatedHosts(hosts ...string) {
+	propagated := make(map[string]bool, len(hosts))
+	for _, host := range hosts {
+		propagated[strings.ToLower(host)] = true
+	}
+	propagatedHosts.Store(propagated)
+}
+
+func isPropagatedHost(host string) bool {
+	propagated, _ := propagatedHosts.Load().(map[string]bool)
+	return propagated[strings.ToLower(host)]
+}
+
+// Start starts span as child of span in context
+func Start(
+	ctx context.Context,
+	name string,
+	kind trace.SpanKind,
+	attributes ...attribute.KeyValue,
+) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(
+		ctx,
+		name,
+		trace.WithSpanKind(kind),
+		trace.WithAttributes(attributes...),
+	)
+}
+
+// End records error of span if any and ends it
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else {
+		span.SetStatus(codes.Ok, ``)
+	}
+	span.End()
+}
+
+// Inject returns trace context of ctx as map to store it with notification, nil if there is no trace
+func Inject(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return nil
+	}
+	return carrier
+}
+
+// Extract returns ctx with trace context from map stored by Inject, so trace is resumed by child spans
+func Extract(ctx context.Context, carrier map[string]string) context.Context {
+	if len(carrier) == 0 {
+		return ctx
+	}
+	return propagator.Extract(ctx, propagation.MapCarrier(carrier))
+}
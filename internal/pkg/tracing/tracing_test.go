@@ -0,0 +1,89 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func withRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+	})
+	return recorder
+}
+
+func TestInjectExtract(t *testing.T) {
+	recorder := withRecorder(t)
+
+	require.Nil(t, Inject(context.Background()))
+
+	ctx, parent := Start(context.Background(), "request", trace.SpanKindServer)
+	carrier := Inject(ctx)
+	require.Contains(t, carrier, "traceparent")
+	parent.End()
+
+	resumed := Extract(context.Background(), carrier)
+	_, child := Start(resumed, "process", trace.SpanKindConsumer)
+	End(child, errors.New("failed"))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	require.Equal(t, spans[0].SpanContext().TraceID(), spans[1].SpanContext().TraceID())
+	require.Equal(t, spans[0].SpanContext().SpanID(), spans[1].Parent().SpanID())
+	require.Equal(t, codes.Error, spans[1].Status().Code)
+}
+
+func TestTransport(t *testing.T) {
+	recorder := withRecorder(t)
+
+	traceparent := ""
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				traceparent = r.Header.Get("traceparent")
+				w.WriteHeader(http.StatusBadGateway)
+			},
+		),
+	)
+	defer server.Close()
+
+	t.Cleanup(func() { SetPropagatedHosts() })
+	client := &http.Client{Transport: NewTransport(nil)}
+	send := func() {
+		request, err := http.NewRequest(http.MethodGet, server.URL+"/bot123:secret/sendMessage?secret=value", nil)
+		require.NoError(t, err)
+		response, err := client.Do(request)
+		require.NoError(t, err)
+		_ = response.Body.Close()
+	}
+
+	send()
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	require.Equal(t, "HTTP GET", spans[0].Name())
+	require.Equal(t, trace.SpanKindClient, spans[0].SpanKind())
+	require.Equal(t, codes.Error, spans[0].Status().Code)
+	require.Empty(t, traceparent, `trace context is not sent to hosts which are not propagated`)
+	for _, attribute := range spans[0].Attributes() {
+		require.NotContains(t, attribute.Value.Emit(), `secret`, `path with credentials is not recorded`)
+	}
+
+	SetPropagatedHosts(`127.0.0.1`)
+	send()
+	spans = recorder.Ended()
+	require.Len(t, spans, 2)
+	require.Contains(t, traceparent, spans[1].SpanContext().TraceID().String())
+}
@@ -0,0 +1,54 @@
+package tracing
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Transport makes client span for every request and propagates trace context in headers of request to hosts
+// set by SetPropagatedHosts. Path is not recorded: paths of providers contain credentials, e.g. bot token of Telegram
+type Transport struct {
+	Base http.RoundTripper
+}
+
+func NewTransport(base http.RoundTripper) *Transport {
+	return &Transport{
+		Base: base,
+	}
+}
+
+func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx, span := Start(
+		r.Context(),
+		fmt.Sprintf(`HTTP %s`, r.Method),
+		trace.SpanKindClient,
+		semconv.HTTPMethodKey.String(r.Method),
+		semconv.HTTPSchemeKey.String(r.URL.Scheme),
+		semconv.NetPeerNameKey.String(r.URL.Hostname()),
+	)
+	r = r.Clone(ctx)
+	if isPropagatedHost(r.URL.Hostname()) {
+		propagator.Inject(ctx, propagation.HeaderCarrier(r.Header))
+	}
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	resp, err := base.RoundTrip(r)
+	if err != nil {
+		End(span, err)
+		return resp, err
+	}
+	span.SetAttributes(semconv.HTTPStatusCodeKey.Int(resp.StatusCode))
+	if resp.StatusCode >= http.StatusInternalServerError {
+		End(span, fmt.Errorf(`response status %d`, resp.StatusCode))
+	} else {
+		End(span, nil)
+	}
+	return resp, nil
+}
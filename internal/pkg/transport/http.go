@@ -4,6 +4,8 @@ import (
 	"net"
 	"net/http"
 	"time"
+
+	"notifications/internal/pkg/tracing"
 )
 
 const (
@@ -18,7 +20,7 @@ type HTTPClient interface {
 func NewHTTPClient() *http.Client {
 	return &http.Client{
 		Timeout: defaultTimout,
-		Transport: &http.Transport{
+		Transport: tracing.NewTransport(&http.Transport{
 			DialContext: (&net.Dialer{
 				Timeout:   30 * time.Second,
 				KeepAlive: 30 * time.Second,
@@ -27,6 +29,6 @@ func NewHTTPClient() *http.Client {
 			IdleConnTimeout:       90 * time.Second,
 			TLSHandshakeTimeout:   10 * time.Second,
 			ExpectContinueTimeout: 1 * time.Second,
-		},
+		}),
 	}
 }
@@ -3,26 +3,34 @@ package senders
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/smtp"
-	"net/url"
 	"strings"
 
 	"notifications/internal/pkg/logger"
 	"notifications/internal/pkg/metrics"
+	"notifications/internal/pkg/tracing"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/jordan-wright/email"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
 	metricEmailSendSuccess = `senders.email.send.success`
 	metricEmailSendFailure = `senders.email.send.failure`
 	metricEmailSendTimings = `senders.email.send.timings`
+
+	spanEmailSend = `senders.email.send`
+	spanSMTPSend  = `smtp.send`
 )
 
 type EmailSender interface {
 	SendText(ctx context.Context, to []string, subject, body string) error
 	SendHTML(ctx context.Context, to []string, subject, body string) error
+	SendHTMLWithText(ctx context.Context, to []string, subject, body, text string) error
 }
 
 type Email struct {
@@ -59,10 +67,25 @@ func (e *Email) SendHTML(ctx context.Context, to []string, subject, body string)
 	)
 }
 
+// SendHTMLWithText sends HTML body with plain text alternative for clients not displaying HTML
+func (e *Email) SendHTMLWithText(ctx context.Context, to []string, subject, body, text string) error {
+	return e.send(
+		ctx,
+		&email.Email{
+			To:      to,
+			From:    e.From,
+			Subject: subject,
+			HTML:    []byte(body),
+			Text:    []byte(text),
+		},
+	)
+}
+
 func (e *Email) send(ctx context.Context, mail *email.Email) error {
 	defer e.metric.NewTiming().Send(metricEmailSendTimings)
-	auth := smtp.PlainAuth("", e.RelayAuthUsername, e.RelayAuthPassword, e.RelayAuthHost)
-	err := mail.Send(e.RelayAddress, auth)
+	ctx, span := tracing.Start(ctx, spanEmailSend, trace.SpanKindInternal)
+	err := e.sendSMTP(ctx, mail)
+	tracing.End(span, err)
 	if err != nil {
 		e.logs.WithContext(ctx).Errorf("failed to send email to %s", strings.Join(mail.To, ", "))
 		e.metric.Increment(metricEmailSendFailure)
@@ -73,12 +96,27 @@ func (e *Email) send(ctx context.Context, mail *email.Email) error {
 	return err
 }
 
+// sendSMTP sends mail to relay, so SMTP client has its own span as HTTP clients have
+func (e *Email) sendSMTP(ctx context.Context, mail *email.Email) error {
+	_, span := tracing.Start(
+		ctx,
+		spanSMTPSend,
+		trace.SpanKindClient,
+		semconv.NetPeerNameKey.String(e.RelayAuthHost),
+		attribute.Int(`smtp.recipients`, len(mail.To)),
+	)
+	auth := smtp.PlainAuth("", e.RelayAuthUsername, e.RelayAuthPassword, e.RelayAuthHost)
+	err := mail.Send(e.RelayAddress, auth)
+	tracing.End(span, err)
+	return err
+}
+
 func NewEmail(from, addr, username, password string, metric metrics.Metrics, logs log.Logger) (*Email, error) {
-	u, err := url.Parse(addr)
+	host, _, err := net.SplitHostPort(addr)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse smtp relay address '%s': %v", addr, err)
 	}
-	if u.Scheme == "" {
+	if host == "" {
 		return nil, fmt.Errorf("smtp relay address '%s' is incorrect", addr)
 	}
 	return &Email{
@@ -86,7 +124,7 @@ func NewEmail(from, addr, username, password string, metric metrics.Metrics, log
 		RelayAddress:      addr,
 		RelayAuthUsername: username,
 		RelayAuthPassword: password,
-		RelayAuthHost:     u.Scheme,
+		RelayAuthHost:     host,
 		metric:            metric,
 		logs:              logger.NewHelper(logs, "ts", log.DefaultTimestamp, "scope", "senders-email"),
 	}, nil
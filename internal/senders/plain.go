@@ -9,8 +9,10 @@ import (
 
 	"notifications/internal/pkg/logger"
 	"notifications/internal/pkg/metrics"
+	"notifications/internal/pkg/tracing"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -22,6 +24,8 @@ const (
 	metricPlainSendSuccess = `senders.plain.send.success`
 	metricPlainSendFailure = `senders.plain.send.failure`
 	metricPlainSendTimings = `senders.plain.send.timings`
+
+	spanPlainSend = `senders.plain.send`
 )
 
 type PlainSender interface {
@@ -56,7 +60,11 @@ func NewPlain(file *os.File, metric metrics.Metrics, logs log.Logger) *Plain {
 
 func (p *Plain) Send(ctx context.Context, message string) error {
 	defer p.metric.NewTiming().Send(metricPlainSendTimings)
+	ctx, span := tracing.Start(ctx, spanPlainSend, trace.SpanKindInternal)
 	var err error
+	defer func() {
+		tracing.End(span, err)
+	}()
 	if emulateLatency {
 		time.Sleep(randomLatency(latencyLimit.Milliseconds()))
 	}
@@ -1,10 +1,19 @@
 package senders
 
+import (
+	"context"
+
+	"notifications/ent/schema"
+)
+
+// Senders of channels built by credentials of config, senders of tenants with their own credentials
+// are resolved by tenants
 type Senders struct {
 	PlainSender    PlainSender
 	EmailSender    EmailSender
 	TelegramSender TelegramSender
 	SMSAeroSender  SMSAeroSender
+	tenants        *Tenants
 }
 
 func NewSenders(
@@ -12,11 +21,56 @@ func NewSenders(
 	emailSender EmailSender,
 	telegramSender TelegramSender,
 	smsAeroSender SMSAeroSender,
+	tenants *Tenants,
 ) *Senders {
 	return &Senders{
 		EmailSender:    emailSender,
 		PlainSender:    plainSender,
 		TelegramSender: telegramSender,
 		SMSAeroSender:  smsAeroSender,
+		tenants:        tenants,
+	}
+}
+
+// Email returns email sender of tenant, tenants without credentials of email use sender of config
+func (s *Senders) Email(ctx context.Context, tenant string) (EmailSender, error) {
+	sender, err := s.ofTenant(ctx, tenant, schema.TypeEmail)
+	if err != nil {
+		return nil, err
+	}
+	if sender == nil {
+		return s.EmailSender, nil
+	}
+	return sender.(EmailSender), nil
+}
+
+// Telegram returns telegram sender of tenant, tenants without credentials of telegram use sender of config
+func (s *Senders) Telegram(ctx context.Context, tenant string) (TelegramSender, error) {
+	sender, err := s.ofTenant(ctx, tenant, schema.TypeTelegram)
+	if err != nil {
+		return nil, err
+	}
+	if sender == nil {
+		return s.TelegramSender, nil
+	}
+	return sender.(TelegramSender), nil
+}
+
+// SMSAero returns sms sender of tenant, tenants without credentials of sms use sender of config
+func (s *Senders) SMSAero(ctx context.Context, tenant string) (SMSAeroSender, error) {
+	sender, err := s.ofTenant(ctx, tenant, schema.TypeSMS)
+	if err != nil {
+		return nil, err
+	}
+	if sender == nil {
+		return s.SMSAeroSender, nil
+	}
+	return sender.(SMSAeroSender), nil
+}
+
+func (s *Senders) ofTenant(ctx context.Context, tenant string, channel schema.NotificationType) (interface{}, error) {
+	if tenant == "" || s.tenants == nil {
+		return nil, nil
 	}
+	return s.tenants.sender(ctx, tenant, channel)
 }
@@ -4,16 +4,20 @@ import (
 	"context"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/trace"
 
 	"notifications/internal/clients/smsaero"
 	"notifications/internal/pkg/logger"
 	"notifications/internal/pkg/metrics"
+	"notifications/internal/pkg/tracing"
 )
 
 const (
 	metricSMSAeroSendTimings = `senders.sms-aero.send.timings`
 	metricSMSAeroSendSuccess = `senders.sms-aero.send.success`
 	metricSMSAeroSendFailure = `senders.sms-aero.send.failure`
+
+	spanSMSAeroSend = `senders.sms-aero.send`
 )
 
 type SMSAeroSender interface {
@@ -36,8 +40,10 @@ func NewSMSAero(client smsaero.Client, metric metrics.Metrics, logs log.Logger)
 
 func (a *SMSAero) Send(ctx context.Context, phone, text string) error {
 	defer a.metric.NewTiming().Send(metricSMSAeroSendTimings)
+	ctx, span := tracing.Start(ctx, spanSMSAeroSend, trace.SpanKindInternal)
 
 	err := a.client.Send(ctx, phone, text)
+	tracing.End(span, err)
 	if err != nil {
 		a.metric.Increment(metricSMSAeroSendFailure)
 		a.logs.WithContext(ctx).Errorf("failed sms-aero notification: %v", err)
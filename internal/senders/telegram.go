@@ -6,19 +6,23 @@ import (
 	"notifications/internal/clients/telegram"
 	"notifications/internal/pkg/logger"
 	"notifications/internal/pkg/metrics"
+	"notifications/internal/pkg/tracing"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
 	metricTelegramSendTimings = `senders.telegram.send.timings`
 	metricTelegramSendSuccess = `senders.telegram.send.success`
 	metricTelegramSendFailure = `senders.telegram.send.failure`
+
+	spanTelegramSend = `senders.telegram.send`
 )
 
 type TelegramSenderOption func(request *telegram.SendMessageRequest)
 
-// WithParseMode get `markdown` or `html`
+// WithParseMode get `markdown`, `markdownv2` or `html`
 func WithParseMode(parseMode string) TelegramSenderOption {
 	return func(request *telegram.SendMessageRequest) {
 		request.ParseMode = &parseMode
@@ -63,6 +67,7 @@ func NewTelegram(client telegram.Client, metric metrics.Metrics, logs log.Logger
 
 func (t *Telegram) Send(ctx context.Context, chatID, text string, options ...TelegramSenderOption) error {
 	defer t.metric.NewTiming().Send(metricTelegramSendTimings)
+	ctx, span := tracing.Start(ctx, spanTelegramSend, trace.SpanKindInternal)
 
 	request := &telegram.SendMessageRequest{
 		ChatID: chatID,
@@ -74,6 +79,7 @@ func (t *Telegram) Send(ctx context.Context, chatID, text string, options ...Tel
 	}
 
 	_, err := t.client.SendMessage(ctx, *request)
+	tracing.End(span, err)
 	if err != nil {
 		t.metric.Increment(metricTelegramSendFailure)
 		t.logs.WithContext(ctx).Errorf("failed telegram notification: %v", err)
@@ -0,0 +1,129 @@
+package senders
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/clients/smsaero"
+	"notifications/internal/clients/telegram"
+	"notifications/internal/pkg/logger"
+	"notifications/internal/pkg/metrics"
+	"notifications/internal/pkg/transport"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// tenantsCheckInterval limits lookups of credentials, updated credentials are used after it
+	tenantsCheckInterval = time.Minute
+)
+
+type CredentialsRepo interface {
+	FindByTenantAndType(
+		ctx context.Context,
+		tenant string,
+		notificationType schema.NotificationType,
+	) (*ent.ChannelCredential, error)
+}
+
+// Tenants builds senders of channels by credentials of tenants and caches them until credentials are updated
+type Tenants struct {
+	repo       CredentialsRepo
+	httpClient transport.HTTPClient
+	metric     metrics.Metrics
+	logger     log.Logger
+	logs       logger.Logger
+	mu         sync.Mutex // guards map of senders only, every sender is looked up under its own lock
+	senders    map[tenantChannel]*tenantSender
+	now        func() time.Time
+}
+
+type tenantChannel struct {
+	tenant  string
+	channel schema.NotificationType
+}
+
+type tenantSender struct {
+	mu        sync.Mutex
+	sender    interface{} // nil if tenant has no credentials of channel
+	updatedAt time.Time   // update time of credentials sender is built by
+	checkedAt time.Time
+}
+
+func NewTenants(
+	repo CredentialsRepo,
+	httpClient transport.HTTPClient,
+	metric metrics.Metrics,
+	logs log.Logger,
+) *Tenants {
+	return &Tenants{
+		repo:       repo,
+		httpClient: httpClient,
+		metric:     metric,
+		logger:     logs,
+		logs:       logger.NewHelper(logs, "ts", log.DefaultTimestamp, "scope", "senders-tenants"),
+		senders:    map[tenantChannel]*tenantSender{},
+		now:        time.Now,
+	}
+}
+
+// sender returns sender of channel built by credentials of tenant, nil if tenant has no credentials of channel.
+// Cached sender is used if credentials are not found because of failure. Credentials of the same tenant and channel
+// are looked up once by concurrent sends, lookups of other tenants are not blocked by it
+func (t *Tenants) sender(ctx context.Context, tenant string, channel schema.NotificationType) (interface{}, error) {
+	key := tenantChannel{tenant: tenant, channel: channel}
+	t.mu.Lock()
+	cached, ok := t.senders[key]
+	if !ok {
+		cached = &tenantSender{}
+		t.senders[key] = cached
+	}
+	t.mu.Unlock()
+
+	cached.mu.Lock()
+	defer cached.mu.Unlock()
+	checked := !cached.checkedAt.IsZero()
+	now := t.now()
+	if checked && now.Sub(cached.checkedAt) < tenantsCheckInterval {
+		return cached.sender, nil
+	}
+	credential, err := t.repo.FindByTenantAndType(ctx, tenant, channel)
+	if ent.IsNotFound(err) {
+		cached.sender, cached.updatedAt, cached.checkedAt = nil, time.Time{}, now
+		return nil, nil
+	}
+	if err != nil {
+		if checked {
+			t.logs.WithContext(ctx).Warnf("failed to find credentials of %s of tenant %s: %v", channel, tenant, err)
+			return cached.sender, nil
+		}
+		return nil, fmt.Errorf(`failed to find credentials of %s of tenant %s: %w`, channel, tenant, err)
+	}
+	if cached.sender != nil && cached.updatedAt.Equal(credential.UpdatedAt) {
+		cached.checkedAt = now
+		return cached.sender, nil
+	}
+	sender, err := t.build(channel, credential.Credentials)
+	if err != nil {
+		return nil, fmt.Errorf(`credentials of %s of tenant %s are invalid: %w`, channel, tenant, err)
+	}
+	cached.sender, cached.updatedAt, cached.checkedAt = sender, credential.UpdatedAt, now
+	return sender, nil
+}
+
+func (t *Tenants) build(channel schema.NotificationType, c schema.Credentials) (interface{}, error) {
+	switch channel {
+	case schema.TypeEmail:
+		return NewEmail(c.From, c.Address, c.Username, c.Password, t.metric, t.logger)
+	case schema.TypeTelegram:
+		return NewTelegram(telegram.New(c.BotToken, t.httpClient, t.metric, t.logger), t.metric, t.logger), nil
+	case schema.TypeSMS:
+		return NewSMSAero(smsaero.New(c.Email, c.APIKey, t.httpClient, t.metric, t.logger), t.metric, t.logger), nil
+	default:
+		return nil, fmt.Errorf(`channel %s has no credentials`, channel)
+	}
+}
@@ -0,0 +1,172 @@
+package senders
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/pkg/metrics"
+	"notifications/internal/pkg/transport"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/require"
+)
+
+type credentialsRepoStub struct {
+	credentials map[tenantChannel]*ent.ChannelCredential
+	err         error
+	finds       int
+}
+
+func (r *credentialsRepoStub) FindByTenantAndType(
+	_ context.Context,
+	tenant string,
+	notificationType schema.NotificationType,
+) (*ent.ChannelCredential, error) {
+	r.finds++
+	if r.err != nil {
+		return nil, r.err
+	}
+	credential, ok := r.credentials[tenantChannel{tenant: tenant, channel: notificationType}]
+	if !ok {
+		return nil, &ent.NotFoundError{}
+	}
+	return credential, nil
+}
+
+func TestSendersOfTenants(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2022, 9, 1, 12, 30, 0, 0, time.UTC)
+	metric, err := metrics.New(``, `test`, true)
+	require.NoError(t, err)
+	logs := log.NewFilter(log.DefaultLogger, log.FilterLevel(log.LevelFatal))
+
+	repo := &credentialsRepoStub{
+		credentials: map[tenantChannel]*ent.ChannelCredential{
+			{tenant: `acme`, channel: schema.TypeTelegram}: {
+				Tenant:      `acme`,
+				Type:        schema.TypeTelegram,
+				Credentials: schema.Credentials{BotToken: `acme-bot`},
+				UpdatedAt:   now,
+			},
+			{tenant: `acme`, channel: schema.TypeEmail}: {
+				Tenant:      `acme`,
+				Type:        schema.TypeEmail,
+				Credentials: schema.Credentials{From: `acme@mail.example`, Address: `:587`},
+				UpdatedAt:   now,
+			},
+		},
+	}
+	tenants := NewTenants(repo, transport.NewHTTPClient(), metric, logs)
+	tenants.now = func() time.Time { return now }
+	defaultTelegram := &Telegram{}
+	s := NewSenders(nil, nil, defaultTelegram, nil, tenants)
+
+	sender, err := s.Telegram(ctx, ``)
+	require.NoError(t, err)
+	require.Same(t, defaultTelegram, sender, `sender without tenant uses config`)
+	require.Equal(t, 0, repo.finds)
+
+	sender, err = s.Telegram(ctx, `globex`)
+	require.NoError(t, err)
+	require.Same(t, defaultTelegram, sender, `tenant without credentials uses config`)
+
+	acme, err := s.Telegram(ctx, `acme`)
+	require.NoError(t, err)
+	require.NotSame(t, defaultTelegram, acme)
+
+	finds := repo.finds
+	sender, err = s.Telegram(ctx, `acme`)
+	require.NoError(t, err)
+	require.Same(t, acme, sender, `sender of tenant is cached`)
+	require.Equal(t, finds, repo.finds)
+
+	now = now.Add(tenantsCheckInterval)
+	sender, err = s.Telegram(ctx, `acme`)
+	require.NoError(t, err)
+	require.Same(t, acme, sender, `sender is not rebuilt while credentials are not updated`)
+
+	repo.credentials[tenantChannel{tenant: `acme`, channel: schema.TypeTelegram}].UpdatedAt = now
+	now = now.Add(tenantsCheckInterval)
+	updated, err := s.Telegram(ctx, `acme`)
+	require.NoError(t, err)
+	require.NotSame(t, acme, updated, `sender is rebuilt by updated credentials`)
+
+	repo.err = errors.New(`connection refused`)
+	now = now.Add(tenantsCheckInterval)
+	sender, err = s.Telegram(ctx, `acme`)
+	require.NoError(t, err)
+	require.Same(t, updated, sender, `cached sender is used on failure`)
+
+	_, err = s.SMSAero(ctx, `acme`)
+	require.Error(t, err, `tenant is not sent by config on failure`)
+
+	repo.err = nil
+	_, err = s.Email(ctx, `acme`)
+	require.Error(t, err, `invalid credentials fail`)
+}
+
+// blockingCredentialsRepo blocks lookups of tenant until it is released
+type blockingCredentialsRepo struct {
+	blocked string
+	release chan struct{}
+	finds   int32
+}
+
+func (r *blockingCredentialsRepo) FindByTenantAndType(
+	_ context.Context,
+	tenant string,
+	_ schema.NotificationType,
+) (*ent.ChannelCredential, error) {
+	atomic.AddInt32(&r.finds, 1)
+	if tenant == r.blocked {
+		<-r.release
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+func TestSendersOfTenants_Lookups(t *testing.T) {
+	ctx := context.Background()
+	metric, err := metrics.New(``, `test`, true)
+	require.NoError(t, err)
+	logs := log.NewFilter(log.DefaultLogger, log.FilterLevel(log.LevelFatal))
+
+	repo := &blockingCredentialsRepo{blocked: `acme`, release: make(chan struct{})}
+	s := NewSenders(nil, nil, &Telegram{}, nil, NewTenants(repo, transport.NewHTTPClient(), metric, logs))
+
+	errs := make(chan error, 3)
+	wg := sync.WaitGroup{}
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.Telegram(ctx, `acme`)
+			errs <- err
+		}()
+	}
+
+	done := make(chan error)
+	go func() {
+		_, err := s.Telegram(ctx, `globex`)
+		done <- err
+	}()
+	select {
+	case err = <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal(`lookup of tenant is blocked by lookup of other tenant`)
+	}
+
+	close(repo.release)
+	wg.Wait()
+	close(errs)
+	for err = range errs {
+		require.NoError(t, err)
+	}
+	require.Equal(t, int32(2), atomic.LoadInt32(&repo.finds), `credentials of tenant are looked up once`)
+}
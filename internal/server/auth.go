@@ -0,0 +1,28 @@
+package server
+
+import (
+	"context"
+
+	"notifications/internal/auth"
+	"notifications/internal/biz"
+	"notifications/internal/health"
+	"notifications/internal/middlewares"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+)
+
+// Authentication returns middleware checking API key or bearer token of HTTP header or gRPC metadata and
+// authorizing operation by claims of key or token, the same for both transports.
+// Standard gRPC health check is public as HTTP probes
+func Authentication(verifier *auth.Verifier, keys *biz.APIKeyUsecase, logger log.Logger) middleware.Middleware {
+	return selector.Server(
+		middlewares.APIKey(keys, middlewares.JWT(verifier, logger), logger),
+		middlewares.Authorize(logger),
+	).Match(
+		func(_ context.Context, operation string) bool {
+			return operation != health.GRPCCheckOperation
+		},
+	).Build()
+}
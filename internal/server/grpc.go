@@ -2,7 +2,10 @@ package server
 
 import (
 	v1notification "notifications/api/notification/v1"
+	"notifications/internal/auth"
+	"notifications/internal/biz"
 	"notifications/internal/conf"
+	"notifications/internal/health"
 	"notifications/internal/middlewares"
 	"notifications/internal/pkg/metrics"
 	"notifications/internal/service"
@@ -16,7 +19,10 @@ import (
 // NewGRPCServer new a gRPC server.
 func NewGRPCServer(
 	c *conf.Server,
+	verifier *auth.Verifier,
 	notifier *service.NotificationService,
+	keys *biz.APIKeyUsecase,
+	h *health.Health,
 	metric metrics.Metrics,
 	logger log.Logger,
 ) *grpc.Server {
@@ -26,6 +32,8 @@ func NewGRPCServer(
 			middlewares.Duration(metric, logger),
 			tracing.Server(),
 			recovery.Recovery(),
+			Authentication(verifier, keys, logger),
+			health.GRPCServer(h),
 		),
 	}
 	if c.Grpc.Network != "" {
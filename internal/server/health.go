@@ -0,0 +1,43 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"notifications/internal/conf"
+	"notifications/internal/data"
+	"notifications/internal/health"
+)
+
+var (
+	errSenderNotConfigured = errors.New(`sender is not configured`)
+)
+
+// NewHealth returns readiness checks of database and configuration state of senders by channel.
+// Channel without configuration is reported, but server is ready to serve other channels
+func NewHealth(database data.Database, c *conf.Senders) *health.Health {
+	h := health.New().Register(`database`, database.Check)
+
+	senders := []struct {
+		name       string
+		configured bool
+	}{
+		{name: `plain`, configured: c.GetPlain().GetFile() != ""},
+		{name: `email`, configured: c.GetEmail().GetAddress() != "" && c.GetEmail().GetFrom() != ""},
+		{name: `telegram`, configured: c.GetTelegram().GetBotToken() != ""},
+		{name: `sms`, configured: c.GetSms().GetAero().GetEmail() != "" && c.GetSms().GetAero().GetApiKey() != ""},
+	}
+	for _, sender := range senders {
+		configured := sender.configured
+		h.RegisterOptional(
+			`sender.`+sender.name, func(context.Context) error {
+				if !configured {
+					return errSenderNotConfigured
+				}
+				return nil
+			},
+		)
+	}
+
+	return h
+}
@@ -3,13 +3,15 @@ package server
 import (
 	v1notification "notifications/api/notification/v1"
 	"notifications/internal/auth"
+	"notifications/internal/biz"
 	"notifications/internal/conf"
+	"notifications/internal/health"
 	"notifications/internal/middlewares"
 	"notifications/internal/pkg/metrics"
+	"notifications/internal/pkg/pprof"
 	"notifications/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/http"
@@ -18,8 +20,10 @@ import (
 // NewHTTPServer new a HTTP server.
 func NewHTTPServer(
 	c *conf.Server,
-	a *conf.Auth,
+	verifier *auth.Verifier,
 	notifier *service.NotificationService,
+	keys *biz.APIKeyUsecase,
+	h *health.Health,
 	metric metrics.Metrics,
 	logger log.Logger,
 ) *http.Server {
@@ -29,7 +33,7 @@ func NewHTTPServer(
 			middlewares.Duration(metric, logger),
 			tracing.Server(),
 			recovery.Recovery(),
-			jwt.Server(auth.CheckJWT(a.Jwt.Secret)),
+			Authentication(verifier, keys, logger),
 		),
 	}
 	if c.Http.Network != "" {
@@ -43,6 +47,8 @@ func NewHTTPServer(
 	}
 	srv := http.NewServer(opts...)
 	v1notification.RegisterNotificationHTTPServer(srv, notifier)
-	registerPProfHandlers(srv)
+	health.RegisterHandlers(srv, h)
+	pprof.Register(srv)
+	metrics.RegisterHandler(srv, metric)
 	return srv
 }
@@ -1,8 +1,10 @@
 package server
 
 import (
+	"notifications/internal/auth"
+
 	"github.com/google/wire"
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer)
+var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewHealth, auth.NewVerifier)
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"notifications/ent"
+	"notifications/internal/auth"
+	"notifications/internal/biz"
+	"notifications/internal/pkg/slices"
+
+	v1 "notifications/api/notification/v1"
+
+	"github.com/AlekSi/pointer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *NotificationService) IssueAPIKey(
+	ctx context.Context,
+	req *v1.IssueAPIKeyRequest,
+) (*v1.IssueAPIKeyResponse, error) {
+	apiKey, err := apiKeyFromProto(req.ApiKey)
+	if err != nil {
+		return nil, v1.ErrorInvalidRequest(`validation failed: %v`, err)
+	}
+	issued, key, err := s.apiKeys.Issue(ctx, apiKey)
+	if err != nil {
+		return nil, v1.ErrorInternalError(`issue api key failed: %v`, err)
+	}
+	s.logger.Infof("api key %d of sender %d was issued successfully", issued.ID, issued.SenderID)
+	return &v1.IssueAPIKeyResponse{ApiKey: apiKeyToProto(issued), Key: key}, nil
+}
+
+func (s *NotificationService) ListAPIKeys(
+	ctx context.Context,
+	req *v1.ListAPIKeysRequest,
+) (*v1.ListAPIKeysResponse, error) {
+	apiKeys, err := s.apiKeys.List(ctx, int(req.SenderId), req.Tenant)
+	if err != nil {
+		return nil, v1.ErrorInternalError(`list api keys failed: %v`, err)
+	}
+	response := &v1.ListAPIKeysResponse{
+		ApiKeys: make([]*v1.APIKey, 0, len(apiKeys)),
+	}
+	for _, apiKey := range apiKeys {
+		response.ApiKeys = append(response.ApiKeys, apiKeyToProto(apiKey))
+	}
+	return response, nil
+}
+
+func (s *NotificationService) RevokeAPIKey(
+	ctx context.Context,
+	req *v1.RevokeAPIKeyRequest,
+) (*v1.RevokeAPIKeyResponse, error) {
+	if req.Id <= 0 {
+		return nil, v1.ErrorInvalidRequest(`validation failed: id=%d is incorrect`, req.Id)
+	}
+	revoked, err := s.apiKeys.Revoke(ctx, int(req.Id), int(req.SenderId), req.Tenant)
+	if errors.Is(err, biz.ErrAPIKeyNotFound) {
+		return nil, v1.ErrorApiKeyNotFound(`api key with id %d was not found`, req.Id)
+	}
+	if err != nil {
+		return nil, v1.ErrorInternalError(`revoke api key failed: %v`, err)
+	}
+	s.logger.Infof("api key %d was revoked successfully", revoked.ID)
+	return &v1.RevokeAPIKeyResponse{ApiKey: apiKeyToProto(revoked)}, nil
+}
+
+func apiKeyFromProto(proto *v1.APIKey) (*ent.APIKey, error) {
+	if proto == nil {
+		return nil, errors.New(`api key is empty`)
+	}
+	if len(proto.Scopes) == 0 {
+		return nil, errors.New(`scopes are empty`)
+	}
+	for _, scope := range proto.Scopes {
+		if !slices.Includes(scope, auth.Scopes) {
+			return nil, errors.New(`scope ` + scope + ` is unknown`)
+		}
+	}
+	apiKey := &ent.APIKey{
+		SenderID: int(proto.SenderId),
+		Tenant:   proto.Tenant,
+		Name:     proto.Name,
+		Scopes:   proto.Scopes,
+		Channels: make([]string, 0, len(proto.Channels)),
+	}
+	for _, channel := range proto.Channels {
+		if _, ok := TypesProtoToSchemaMap[channel]; !ok {
+			return nil, errors.New(`type ` + channel.String() + ` is unknown`)
+		}
+		apiKey.Channels = append(apiKey.Channels, channel.String())
+	}
+	if proto.ExpiresAt != nil {
+		apiKey.ExpiresAt = pointer.ToTime(proto.ExpiresAt.AsTime())
+	}
+	return apiKey, nil
+}
+
+func apiKeyToProto(apiKey *ent.APIKey) *v1.APIKey {
+	proto := &v1.APIKey{
+		Id:        int64(apiKey.ID),
+		SenderId:  int64(apiKey.SenderID),
+		Tenant:    apiKey.Tenant,
+		Name:      apiKey.Name,
+		Prefix:    apiKey.Prefix,
+		Scopes:    apiKey.Scopes,
+		Channels:  make([]v1.Type, 0, len(apiKey.Channels)),
+		CreatedAt: timestamppb.New(apiKey.CreatedAt),
+	}
+	for _, channel := range apiKey.Channels {
+		proto.Channels = append(proto.Channels, v1.Type(v1.Type_value[channel]))
+	}
+	if apiKey.ExpiresAt != nil {
+		proto.ExpiresAt = timestamppb.New(*apiKey.ExpiresAt)
+	}
+	if apiKey.LastUsedAt != nil {
+		proto.LastUsedAt = timestamppb.New(*apiKey.LastUsedAt)
+	}
+	if apiKey.RevokedAt != nil {
+		proto.RevokedAt = timestamppb.New(*apiKey.RevokedAt)
+	}
+	return proto
+}
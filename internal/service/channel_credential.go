@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/biz"
+
+	v1 "notifications/api/notification/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *NotificationService) SetCredentials(
+	ctx context.Context,
+	req *v1.SetCredentialsRequest,
+) (*v1.SetCredentialsResponse, error) {
+	credential, err := channelCredentialFromProto(req.Credentials)
+	if err != nil {
+		return nil, v1.ErrorInvalidRequest(`validation failed: %v`, err)
+	}
+	saved, err := s.credentials.Set(ctx, credential)
+	if err != nil {
+		return nil, v1.ErrorInternalError(`set credentials failed: %v`, err)
+	}
+	return &v1.SetCredentialsResponse{Credentials: channelCredentialToProto(saved)}, nil
+}
+
+func (s *NotificationService) ListCredentials(
+	ctx context.Context,
+	req *v1.ListCredentialsRequest,
+) (*v1.ListCredentialsResponse, error) {
+	if req.Tenant == "" {
+		return nil, v1.ErrorInvalidRequest(`validation failed: tenant is empty`)
+	}
+	credentials, err := s.credentials.List(ctx, req.Tenant)
+	if err != nil {
+		return nil, v1.ErrorInternalError(`list credentials failed: %v`, err)
+	}
+	response := &v1.ListCredentialsResponse{
+		Credentials: make([]*v1.Credentials, 0, len(credentials)),
+	}
+	for _, credential := range credentials {
+		response.Credentials = append(response.Credentials, channelCredentialToProto(credential))
+	}
+	return response, nil
+}
+
+func (s *NotificationService) DeleteCredentials(
+	ctx context.Context,
+	req *v1.DeleteCredentialsRequest,
+) (*v1.DeleteCredentialsResponse, error) {
+	if req.Tenant == "" {
+		return nil, v1.ErrorInvalidRequest(`validation failed: tenant is empty`)
+	}
+	notificationType := TypesProtoToSchemaMap[req.Type]
+	if err := schema.ValidateCredentialType(notificationType.String()); err != nil {
+		return nil, v1.ErrorInvalidRequest(`validation failed: %v`, err)
+	}
+	err := s.credentials.Delete(ctx, req.Tenant, notificationType)
+	if errors.Is(err, biz.ErrChannelCredentialNotFound) {
+		return nil, v1.ErrorCredentialsNotFound(`credentials of %s of tenant %s were not found`, req.Type, req.Tenant)
+	}
+	if err != nil {
+		return nil, v1.ErrorInternalError(`delete credentials failed: %v`, err)
+	}
+	return &v1.DeleteCredentialsResponse{}, nil
+}
+
+// channelCredentialFromProto returns credentials of channel selected by field of proto,
+// tenant is required as credentials of service without tenant are configured
+func channelCredentialFromProto(proto *v1.Credentials) (*ent.ChannelCredential, error) {
+	if proto == nil {
+		return nil, errors.New(`credentials are empty`)
+	}
+	if proto.Tenant == "" {
+		return nil, errors.New(`tenant is empty`)
+	}
+	credential := &ent.ChannelCredential{Tenant: proto.Tenant}
+	switch channel := proto.Channel.(type) {
+	case *v1.Credentials_Email:
+		credential.Type = schema.TypeEmail
+		credential.Credentials = schema.Credentials{
+			From:     channel.Email.GetFrom(),
+			Address:  channel.Email.GetAddress(),
+			Username: channel.Email.GetUsername(),
+			Password: channel.Email.GetPassword(),
+		}
+	case *v1.Credentials_Telegram:
+		credential.Type = schema.TypeTelegram
+		credential.Credentials = schema.Credentials{
+			BotToken: channel.Telegram.GetBotToken(),
+		}
+	case *v1.Credentials_Sms:
+		credential.Type = schema.TypeSMS
+		credential.Credentials = schema.Credentials{
+			Email:  channel.Sms.GetEmail(),
+			APIKey: channel.Sms.GetApiKey(),
+		}
+	default:
+		return nil, errors.New(`credentials of channel are empty`)
+	}
+	if err := credential.Credentials.Validate(credential.Type); err != nil {
+		return nil, err
+	}
+	return credential, nil
+}
+
+// channelCredentialToProto returns credentials without secrets
+func channelCredentialToProto(credential *ent.ChannelCredential) *v1.Credentials {
+	proto := &v1.Credentials{
+		Tenant:    credential.Tenant,
+		UpdatedAt: timestamppb.New(credential.UpdatedAt),
+	}
+	switch credential.Type {
+	case schema.TypeEmail:
+		proto.Channel = &v1.Credentials_Email{
+			Email: &v1.EmailCredentials{
+				From:     credential.Credentials.From,
+				Address:  credential.Credentials.Address,
+				Username: credential.Credentials.Username,
+			},
+		}
+	case schema.TypeTelegram:
+		proto.Channel = &v1.Credentials_Telegram{Telegram: &v1.TelegramCredentials{}}
+	case schema.TypeSMS:
+		proto.Channel = &v1.Credentials_Sms{Sms: &v1.SmsCredentials{Email: credential.Credentials.Email}}
+	}
+	return proto
+}
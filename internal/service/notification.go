@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"notifications/ent/schema"
 	"notifications/internal/biz"
@@ -16,11 +17,15 @@ import (
 
 var (
 	StatusesSchemaToProtoMap = map[schema.NotificationStatus]v1.Status{
-		schema.StatusDraft:   v1.Status_draft,
-		schema.StatusPending: v1.Status_pending,
-		schema.StatusSent:    v1.Status_sent,
-		schema.StatusRetry:   v1.Status_retry,
-		schema.StatusFail:    v1.Status_fail,
+		schema.StatusDraft:      v1.Status_draft,
+		schema.StatusPending:    v1.Status_pending,
+		schema.StatusSent:       v1.Status_sent,
+		schema.StatusRetry:      v1.Status_retry,
+		schema.StatusFail:       v1.Status_fail,
+		schema.StatusProcessing: v1.Status_processing,
+		schema.StatusSuppressed: v1.Status_suppressed,
+		schema.StatusHeld:       v1.Status_held,
+		schema.StatusDigested:   v1.Status_digested,
 	}
 
 	TypesProtoToSchemaMap = map[v1.Type]schema.NotificationType{
@@ -38,6 +43,14 @@ type NotificationService struct {
 
 	usecase *biz.NotificationUsecase
 
+	schedules *biz.ScheduleUsecase
+
+	suppressions *biz.SuppressionUsecase
+
+	apiKeys *biz.APIKeyUsecase
+
+	credentials *biz.ChannelCredentialUsecase
+
 	senders *senders.Senders
 
 	logger *log.Helper
@@ -45,13 +58,21 @@ type NotificationService struct {
 
 func NewNotificationService(
 	usecase *biz.NotificationUsecase,
+	schedules *biz.ScheduleUsecase,
+	suppressions *biz.SuppressionUsecase,
+	apiKeys *biz.APIKeyUsecase,
+	credentials *biz.ChannelCredentialUsecase,
 	senders *senders.Senders,
 	logs log.Logger,
 ) *NotificationService {
 	return &NotificationService{
-		usecase: usecase,
-		senders: senders,
-		logger:  logger.NewHelper(logs, "ts", log.DefaultTimestamp, "scope", "service-notification"),
+		usecase:      usecase,
+		schedules:    schedules,
+		suppressions: suppressions,
+		apiKeys:      apiKeys,
+		credentials:  credentials,
+		senders:      senders,
+		logger:       logger.NewHelper(logs, "ts", log.DefaultTimestamp, "scope", "service-notification"),
 	}
 }
 
@@ -62,7 +83,7 @@ func (s *NotificationService) Check(ctx context.Context, req *v1.CheckRequest) (
 	if req.Id == 0 {
 		return nil, v1.ErrorInvalidRequest(`validation failed: id was not set`)
 	}
-	status, err := s.usecase.CheckStatus(ctx, req.Id)
+	status, err := s.usecase.CheckStatus(ctx, req.Id, int(req.SenderId), req.Tenant)
 	if err == biz.ErrNotificationNotFound {
 		return nil, v1.ErrorNotificationNotFound(`notification with id %d was not found`, req.Id)
 	}
@@ -76,25 +97,9 @@ func (s *NotificationService) Check(ctx context.Context, req *v1.CheckRequest) (
 }
 
 func (s *NotificationService) Enqueue(ctx context.Context, req *v1.SendRequest) (*v1.EnqueueResponse, error) {
-	payload, err := schema.PayloadFromProto(req.Payload)
-	if err != nil {
-		return nil, v1.ErrorInternalError(`payload conversion failed: %v`, err)
-	}
-
-	notificationType, ok := TypesProtoToSchemaMap[req.Type]
-	if !ok {
-		return nil, v1.ErrorInvalidRequest(`validation failed: type %s is unknown`, req.Type.String())
-	}
-	err = payload.Validate(notificationType)
+	in, err := notificationInFromRequest(req)
 	if err != nil {
-		return nil, v1.ErrorInvalidRequest(`validation failed: %v`, err)
-	}
-
-	in := &biz.NotificationInDTO{
-		SendType: req.Type,
-		SenderID: req.SenderId,
-		Payload:  payload,
-		TTL:      int(req.Ttl),
+		return nil, err
 	}
 
 	if req.PlannedAt != nil {
@@ -105,6 +110,15 @@ func (s *NotificationService) Enqueue(ctx context.Context, req *v1.SendRequest)
 	result, err := s.usecase.EnqueueNotification(ctx, in)
 	if result != nil {
 		response.Id = result.ID
+		response.OriginalId = result.OriginalID
+		response.Suppressed = result.Suppressed
+		response.Held = result.Held
+	}
+	if errors.Is(err, biz.ErrQuotaExceeded) {
+		return nil, v1.ErrorQuotaExceeded(`enqueue notification failed: %v`, err)
+	}
+	if errors.Is(err, biz.ErrRecipientSuppressed) {
+		return nil, v1.ErrorRecipientSuppressed(`enqueue notification failed: %v`, err)
 	}
 	if err != nil {
 		s.logger.Errorf(`notification %d was failed to send: %v`, response.Id, err)
@@ -115,35 +129,132 @@ func (s *NotificationService) Enqueue(ctx context.Context, req *v1.SendRequest)
 }
 
 func (s *NotificationService) Send(ctx context.Context, req *v1.SendRequest) (*v1.SendResponse, error) {
-	payload, err := schema.PayloadFromProto(req.Payload)
+	in, err := notificationInFromRequest(req)
 	if err != nil {
-		return nil, v1.ErrorInternalError(`payload conversion failed: %v`, err)
+		return nil, err
 	}
 
+	result, err := s.usecase.SendNotification(ctx, in)
+	if errors.Is(err, biz.ErrQuotaExceeded) {
+		return nil, v1.ErrorQuotaExceeded(`send notification failed: %v`, err)
+	}
+	if errors.Is(err, biz.ErrRecipientSuppressed) {
+		return nil, v1.ErrorRecipientSuppressed(`send notification failed: %v`, err)
+	}
+	if err != nil {
+		s.logger.Errorf(`notification was failed to send: %v`, err)
+		return nil, v1.ErrorInternalError(`send notification failed: %v`, err)
+	}
+	s.logger.Infof("notification %d was sent successfully", result.ID)
+	return &v1.SendResponse{
+		Id:         result.ID,
+		Sent:       result.Sent,
+		OriginalId: result.OriginalID,
+		Suppressed: result.Suppressed,
+		Held:       result.Held,
+	}, nil
+}
+
+func (s *NotificationService) Usage(ctx context.Context, req *v1.UsageRequest) (*v1.UsageResponse, error) {
+	if req.SenderId < 0 {
+		return nil, v1.ErrorInvalidRequest(`validation failed: senderId=%d is incorrect`, req.SenderId)
+	}
+	usages, err := s.usecase.Usage(ctx, req.SenderId, req.Tenant)
+	if err != nil {
+		return nil, v1.ErrorInternalError(`get usage failed: %v`, err)
+	}
+	response := &v1.UsageResponse{
+		Quotas: make([]*v1.QuotaUsage, 0, len(usages)),
+	}
+	for _, usage := range usages {
+		response.Quotas = append(
+			response.Quotas, &v1.QuotaUsage{
+				Type:        v1.Type(v1.Type_value[usage.Type.String()]),
+				MinuteUsed:  int64(usage.MinuteUsed),
+				MinuteLimit: int64(usage.MinuteLimit),
+				DayUsed:     int64(usage.DayUsed),
+				DayLimit:    int64(usage.DayLimit),
+			},
+		)
+	}
+	return response, nil
+}
+
+// notificationInFromRequest validates request and returns notification to enqueue or send
+func notificationInFromRequest(req *v1.SendRequest) (*biz.NotificationInDTO, error) {
 	notificationType, ok := TypesProtoToSchemaMap[req.Type]
 	if !ok {
 		return nil, v1.ErrorInvalidRequest(`validation failed: type %s is unknown`, req.Type.String())
 	}
+	payload, err := payloadFromRequest(req, notificationType)
+	if err != nil {
+		return nil, v1.ErrorInvalidRequest(`validation failed: %v`, err)
+	}
+	*payload = payload.RenderMarkdown(notificationType)
 	err = payload.Validate(notificationType)
 	if err != nil {
 		return nil, v1.ErrorInvalidRequest(`validation failed: %v`, err)
 	}
 
-	in := &biz.NotificationInDTO{
-		SendType: req.Type,
-		SenderID: req.SenderId,
-		Payload:  payload,
-		TTL:      int(req.Ttl),
+	retryPolicy, err := retryPolicyFromProto(req.Retry)
+	if err != nil {
+		return nil, v1.ErrorInvalidRequest(`validation failed: %v`, err)
 	}
 
-	result, err := s.usecase.SendNotification(ctx, in)
+	window, err := deliveryWindowFromProto(req.Window)
 	if err != nil {
-		s.logger.Errorf(`notification was failed to send: %v`, err)
-		return nil, v1.ErrorInternalError(`send notification failed: %v`, err)
+		return nil, v1.ErrorInvalidRequest(`validation failed: %v`, err)
 	}
-	s.logger.Infof("notification %d was sent successfully", result.ID)
-	return &v1.SendResponse{
-		Id:   result.ID,
-		Sent: result.Sent,
+
+	if req.Digest != nil && req.Digest.Key == "" {
+		return nil, v1.ErrorInvalidRequest(`validation failed: digest key is empty`)
+	}
+
+	return &biz.NotificationInDTO{
+		SendType:     req.Type,
+		SenderID:     req.SenderId,
+		Tenant:       req.Tenant,
+		Payload:      payload,
+		TTL:          int(req.Ttl),
+		RetryPolicy:  retryPolicy,
+		Priority:     int(req.Priority),
+		Category:     req.Category,
+		Window:       window,
+		DedupWindow:  req.DedupWindow.AsDuration(),
+		DigestKey:    req.Digest.GetKey(),
+		DigestWindow: req.Digest.GetWindow().AsDuration(),
+		Locale:       req.Locale,
 	}, nil
 }
+
+func retryPolicyFromProto(proto *v1.RetryPolicy) (*schema.RetryPolicy, error) {
+	if proto == nil {
+		return nil, nil
+	}
+	policy := &schema.RetryPolicy{
+		InitialInterval: proto.InitialInterval.AsDuration(),
+		Multiplier:      proto.Multiplier,
+		MaxInterval:     proto.MaxInterval.AsDuration(),
+		Jitter:          proto.Jitter,
+		MaxAttempts:     int(proto.MaxAttempts),
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func deliveryWindowFromProto(proto *v1.DeliveryWindow) (*schema.DeliveryWindow, error) {
+	if proto == nil {
+		return nil, nil
+	}
+	window := &schema.DeliveryWindow{
+		From:     proto.From,
+		To:       proto.To,
+		Timezone: proto.Timezone,
+	}
+	if err := window.Validate(); err != nil {
+		return nil, err
+	}
+	return window, nil
+}
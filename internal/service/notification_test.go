@@ -0,0 +1,65 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	v1 "notifications/api/notification/v1"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestNotificationInFromRequest(t *testing.T) {
+	testCases := []struct {
+		name  string
+		req   *v1.SendRequest
+		fails bool
+	}{
+		{
+			name: "email",
+			req: &v1.SendRequest{
+				Type:     v1.Type_email,
+				SenderId: 42,
+				Tenant:   `acme`,
+				Payload:  map[string]string{`to`: `john@example.com`, `subject`: `Hi`, `body`: `Hi`},
+				Digest:   &v1.Digest{Key: `daily`, Window: durationpb.New(time.Hour)},
+			},
+		},
+		{
+			name:  "unknown-type",
+			req:   &v1.SendRequest{Type: 42, Payload: map[string]string{`message`: `hi`}},
+			fails: true,
+		},
+		{
+			name:  "invalid-payload",
+			req:   &v1.SendRequest{Type: v1.Type_email, Payload: map[string]string{`message`: `hi`}},
+			fails: true,
+		},
+		{
+			name: "digest-without-key",
+			req: &v1.SendRequest{
+				Type:    v1.Type_email,
+				Payload: map[string]string{`to`: `john@example.com`, `subject`: `Hi`, `body`: `Hi`},
+				Digest:  &v1.Digest{Window: durationpb.New(time.Hour)},
+			},
+			fails: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				in, err := notificationInFromRequest(testCase.req)
+				if testCase.fails {
+					require.True(t, v1.IsInvalidRequest(err))
+					return
+				}
+				require.NoError(t, err)
+				require.Equal(t, testCase.req.SenderId, in.SenderID)
+				require.Equal(t, testCase.req.Tenant, in.Tenant)
+				require.Equal(t, `daily`, in.DigestKey)
+				require.Equal(t, time.Hour, in.DigestWindow)
+			},
+		)
+	}
+}
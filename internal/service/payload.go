@@ -0,0 +1,89 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"notifications/ent/schema"
+
+	v1 "notifications/api/notification/v1"
+)
+
+var (
+	TelegramParseModesProtoToSchemaMap = map[v1.TelegramParseMode]string{
+		v1.TelegramParseMode_text:       "",
+		v1.TelegramParseMode_markdown:   `markdown`,
+		v1.TelegramParseMode_markdownv2: `markdownv2`,
+		v1.TelegramParseMode_html:       `html`,
+	}
+)
+
+// payloadFromRequest converts typed payload or payload map of request into payload of notification type
+func payloadFromRequest(req *v1.SendRequest, as schema.NotificationType) (*schema.Payload, error) {
+	if req.TypedPayload == nil {
+		return schema.PayloadFromProto(req.Payload)
+	}
+	if len(req.Payload) > 0 {
+		return nil, errors.New(`payload and typed payload are mutually exclusive`)
+	}
+
+	var typedPayload schema.PayloadTyped
+	var typed schema.NotificationType
+	markdown := ""
+	switch proto := req.TypedPayload.(type) {
+	case *v1.SendRequest_Plain:
+		typed = schema.TypePlain
+		markdown = proto.Plain.GetMarkdown()
+		typedPayload = schema.PayloadPlain{Message: proto.Plain.GetMessage()}
+	case *v1.SendRequest_Email:
+		typed = schema.TypeEmail
+		markdown = proto.Email.GetMarkdown()
+		typedPayload = schema.PayloadEmail{
+			To:      proto.Email.GetTo(),
+			Subject: proto.Email.GetSubject(),
+			Body:    proto.Email.GetBody(),
+			IsHTML:  strconv.FormatBool(proto.Email.GetIsHtml()),
+			Text:    proto.Email.GetText(),
+		}
+	case *v1.SendRequest_Sms:
+		typed = schema.TypeSMS
+		markdown = proto.Sms.GetMarkdown()
+		typedPayload = schema.PayloadSMS{
+			Phone: proto.Sms.GetPhone(),
+			Text:  proto.Sms.GetText(),
+			Split: strconv.FormatBool(proto.Sms.GetSplit()),
+		}
+	case *v1.SendRequest_Telegram:
+		typed = schema.TypeTelegram
+		markdown = proto.Telegram.GetMarkdown()
+		parseMode, ok := TelegramParseModesProtoToSchemaMap[proto.Telegram.GetParseMode()]
+		if !ok {
+			return nil, fmt.Errorf(`parse mode %s is unknown`, proto.Telegram.GetParseMode().String())
+		}
+		typedPayload = schema.PayloadTelegram{
+			ChatID:                proto.Telegram.GetChatId(),
+			Text:                  proto.Telegram.GetText(),
+			ParseMode:             parseMode,
+			DisableWebPagePreview: strconv.FormatBool(proto.Telegram.GetDisableWebPagePreview()),
+			DisableNotification:   strconv.FormatBool(proto.Telegram.GetDisableNotification()),
+			ProtectContent:        strconv.FormatBool(proto.Telegram.GetProtectContent()),
+		}
+	default:
+		return nil, errors.New(`typed payload is unknown`)
+	}
+	if typed != as {
+		return nil, fmt.Errorf(`typed payload of %s does not match type %s`, typed, as)
+	}
+	payload, err := typedPayload.ToPayload()
+	if err != nil {
+		return nil, err
+	}
+	payload[schema.PayloadMarkdown] = markdown
+	for key, value := range payload {
+		if value == "" {
+			delete(payload, key)
+		}
+	}
+	return &payload, nil
+}
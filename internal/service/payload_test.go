@@ -0,0 +1,130 @@
+package service
+
+import (
+	"testing"
+
+	"notifications/ent/schema"
+
+	v1 "notifications/api/notification/v1"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayloadFromRequest(t *testing.T) {
+	testCases := []struct {
+		name     string
+		req      *v1.SendRequest
+		as       schema.NotificationType
+		expected schema.Payload
+		fails    bool
+	}{
+		{
+			name:     "payload-map",
+			req:      &v1.SendRequest{Payload: map[string]string{`message`: `hi`}},
+			as:       schema.TypePlain,
+			expected: schema.Payload{`message`: `hi`},
+		},
+		{
+			name: "payload-map-and-typed-payload",
+			req: &v1.SendRequest{
+				Payload:      map[string]string{`message`: `hi`},
+				TypedPayload: &v1.SendRequest_Plain{Plain: &v1.PlainPayload{Message: `hi`}},
+			},
+			as:    schema.TypePlain,
+			fails: true,
+		},
+		{
+			name:  "typed-payload-of-other-type",
+			req:   &v1.SendRequest{TypedPayload: &v1.SendRequest_Sms{Sms: &v1.SmsPayload{Phone: `79009009090`}}},
+			as:    schema.TypeEmail,
+			fails: true,
+		},
+		{
+			name: "email-with-bool",
+			req: &v1.SendRequest{
+				TypedPayload: &v1.SendRequest_Email{
+					Email: &v1.EmailPayload{To: `john@example.com`, Subject: `Hi`, Body: `<b>Hi</b>`, IsHtml: true},
+				},
+			},
+			as: schema.TypeEmail,
+			expected: schema.Payload{
+				`to`:      `john@example.com`,
+				`subject`: `Hi`,
+				`body`:    `<b>Hi</b>`,
+				`is_html`: `true`,
+			},
+		},
+		{
+			name: "sms-with-markdown",
+			req: &v1.SendRequest{
+				TypedPayload: &v1.SendRequest_Sms{Sms: &v1.SmsPayload{Phone: `79009009090`, Markdown: `**Hi**`}},
+			},
+			as: schema.TypeSMS,
+			expected: schema.Payload{
+				`phone`:                `79009009090`,
+				`split`:                `false`,
+				schema.PayloadMarkdown: `**Hi**`,
+			},
+		},
+		{
+			name: "telegram-text",
+			req: &v1.SendRequest{
+				TypedPayload: &v1.SendRequest_Telegram{
+					Telegram: &v1.TelegramPayload{ChatId: `1`, Text: `Hi`, DisableNotification: true},
+				},
+			},
+			as: schema.TypeTelegram,
+			expected: schema.Payload{
+				`chat_id`:                  `1`,
+				`text`:                     `Hi`,
+				`disable_web_page_preview`: `false`,
+				`disable_notification`:     `true`,
+				`protect_content`:          `false`,
+			},
+		},
+		{
+			name: "telegram-markdownv2",
+			req: &v1.SendRequest{
+				TypedPayload: &v1.SendRequest_Telegram{
+					Telegram: &v1.TelegramPayload{
+						ChatId:    `1`,
+						Text:      `*Hi*`,
+						ParseMode: v1.TelegramParseMode_markdownv2,
+					},
+				},
+			},
+			as: schema.TypeTelegram,
+			expected: schema.Payload{
+				`chat_id`:                  `1`,
+				`text`:                     `*Hi*`,
+				`parse_mode`:               `markdownv2`,
+				`disable_web_page_preview`: `false`,
+				`disable_notification`:     `false`,
+				`protect_content`:          `false`,
+			},
+		},
+		{
+			name: "telegram-unknown-parse-mode",
+			req: &v1.SendRequest{
+				TypedPayload: &v1.SendRequest_Telegram{
+					Telegram: &v1.TelegramPayload{ChatId: `1`, Text: `Hi`, ParseMode: 42},
+				},
+			},
+			as:    schema.TypeTelegram,
+			fails: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				payload, err := payloadFromRequest(testCase.req, testCase.as)
+				if testCase.fails {
+					require.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+				require.Equal(t, testCase.expected, *payload)
+			},
+		)
+	}
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/biz"
+
+	v1 "notifications/api/notification/v1"
+
+	"github.com/AlekSi/pointer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *NotificationService) CreateSchedule(ctx context.Context, req *v1.ScheduleRequest) (*v1.ScheduleResponse, error) {
+	schedule, err := scheduleFromProto(req.Schedule)
+	if err != nil {
+		return nil, v1.ErrorInvalidRequest(`validation failed: %v`, err)
+	}
+	schedule.ID = 0
+	created, err := s.schedules.Create(ctx, schedule)
+	if err != nil {
+		return nil, v1.ErrorInternalError(`create schedule failed: %v`, err)
+	}
+	s.logger.Infof("schedule %d was created successfully", created.ID)
+	return &v1.ScheduleResponse{Schedule: scheduleToProto(created)}, nil
+}
+
+func (s *NotificationService) UpdateSchedule(ctx context.Context, req *v1.ScheduleRequest) (*v1.ScheduleResponse, error) {
+	schedule, err := scheduleFromProto(req.Schedule)
+	if err != nil {
+		return nil, v1.ErrorInvalidRequest(`validation failed: %v`, err)
+	}
+	if schedule.ID <= 0 {
+		return nil, v1.ErrorInvalidRequest(`validation failed: id=%d is incorrect`, schedule.ID)
+	}
+	updated, err := s.schedules.Update(ctx, schedule)
+	if errors.Is(err, biz.ErrScheduleNotFound) {
+		return nil, v1.ErrorScheduleNotFound(`schedule with id %d was not found`, schedule.ID)
+	}
+	if err != nil {
+		return nil, v1.ErrorInternalError(`update schedule failed: %v`, err)
+	}
+	return &v1.ScheduleResponse{Schedule: scheduleToProto(updated)}, nil
+}
+
+func (s *NotificationService) GetSchedule(ctx context.Context, req *v1.GetScheduleRequest) (*v1.ScheduleResponse, error) {
+	if req.Id <= 0 {
+		return nil, v1.ErrorInvalidRequest(`validation failed: id=%d is incorrect`, req.Id)
+	}
+	schedule, err := s.schedules.Get(ctx, int(req.Id), int(req.SenderId), req.Tenant)
+	if errors.Is(err, biz.ErrScheduleNotFound) {
+		return nil, v1.ErrorScheduleNotFound(`schedule with id %d was not found`, req.Id)
+	}
+	if err != nil {
+		return nil, v1.ErrorInternalError(`get schedule failed: %v`, err)
+	}
+	return &v1.ScheduleResponse{Schedule: scheduleToProto(schedule)}, nil
+}
+
+func (s *NotificationService) DeleteSchedule(
+	ctx context.Context,
+	req *v1.DeleteScheduleRequest,
+) (*v1.DeleteScheduleResponse, error) {
+	if req.Id <= 0 {
+		return nil, v1.ErrorInvalidRequest(`validation failed: id=%d is incorrect`, req.Id)
+	}
+	err := s.schedules.Delete(ctx, int(req.Id), int(req.SenderId), req.Tenant)
+	if errors.Is(err, biz.ErrScheduleNotFound) {
+		return nil, v1.ErrorScheduleNotFound(`schedule with id %d was not found`, req.Id)
+	}
+	if err != nil {
+		return nil, v1.ErrorInternalError(`delete schedule failed: %v`, err)
+	}
+	return &v1.DeleteScheduleResponse{}, nil
+}
+
+func (s *NotificationService) ListSchedules(
+	ctx context.Context,
+	req *v1.ListSchedulesRequest,
+) (*v1.ListSchedulesResponse, error) {
+	if req.SenderId < 0 {
+		return nil, v1.ErrorInvalidRequest(`validation failed: senderId=%d is incorrect`, req.SenderId)
+	}
+	schedules, err := s.schedules.List(ctx, int(req.SenderId), req.Tenant)
+	if err != nil {
+		return nil, v1.ErrorInternalError(`list schedules failed: %v`, err)
+	}
+	response := &v1.ListSchedulesResponse{
+		Schedules: make([]*v1.Schedule, 0, len(schedules)),
+	}
+	for _, schedule := range schedules {
+		response.Schedules = append(response.Schedules, scheduleToProto(schedule))
+	}
+	return response, nil
+}
+
+func scheduleFromProto(proto *v1.Schedule) (*ent.Schedule, error) {
+	if proto == nil {
+		return nil, errors.New(`schedule is empty`)
+	}
+	payload, err := schema.PayloadFromProto(proto.Payload)
+	if err != nil {
+		return nil, err
+	}
+	notificationType, ok := TypesProtoToSchemaMap[proto.Type]
+	if !ok {
+		return nil, errors.New(`type ` + proto.Type.String() + ` is unknown`)
+	}
+	*payload = payload.RenderMarkdown(notificationType)
+	if err = payload.Validate(notificationType); err != nil {
+		return nil, err
+	}
+	if err = schema.ValidateCron(proto.Cron); err != nil {
+		return nil, err
+	}
+	if err = schema.ValidateTimezone(proto.Timezone); err != nil {
+		return nil, err
+	}
+	schedule := &ent.Schedule{
+		ID:       int(proto.Id),
+		SenderID: int(proto.SenderId),
+		Tenant:   proto.Tenant,
+		Type:     notificationType,
+		Payload:  *payload,
+		TTL:      int(proto.Ttl),
+		Priority: int(proto.Priority),
+		Category: proto.Category,
+		Cron:     proto.Cron,
+		Timezone: proto.Timezone,
+	}
+	if proto.EndsAt != nil {
+		schedule.EndsAt = pointer.ToTime(proto.EndsAt.AsTime())
+	}
+	return schedule, nil
+}
+
+func scheduleToProto(schedule *ent.Schedule) *v1.Schedule {
+	proto := &v1.Schedule{
+		Id:       int64(schedule.ID),
+		Type:     v1.Type(v1.Type_value[schedule.Type.String()]),
+		Payload:  schedule.Payload,
+		Ttl:      uint64(schedule.TTL),
+		SenderId: int64(schedule.SenderID),
+		Tenant:   schedule.Tenant,
+		Priority: int32(schedule.Priority),
+		Category: schedule.Category,
+		Cron:     schedule.Cron,
+		Timezone: schedule.Timezone,
+	}
+	if schedule.EndsAt != nil {
+		proto.EndsAt = timestamppb.New(*schedule.EndsAt)
+	}
+	if schedule.NextRunAt != nil {
+		proto.NextRunAt = timestamppb.New(*schedule.NextRunAt)
+	}
+	if schedule.LastRunAt != nil {
+		proto.LastRunAt = timestamppb.New(*schedule.LastRunAt)
+	}
+	return proto
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/biz"
+
+	v1 "notifications/api/notification/v1"
+
+	"github.com/AlekSi/pointer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *NotificationService) CreateSuppression(
+	ctx context.Context,
+	req *v1.SuppressionRequest,
+) (*v1.SuppressionResponse, error) {
+	suppression, err := suppressionFromProto(req.Suppression)
+	if err != nil {
+		return nil, v1.ErrorInvalidRequest(`validation failed: %v`, err)
+	}
+	suppression.ID = 0
+	created, err := s.suppressions.Create(ctx, suppression)
+	if err != nil {
+		return nil, v1.ErrorInternalError(`create suppression failed: %v`, err)
+	}
+	s.logger.Infof("suppression %d was created successfully", created.ID)
+	return &v1.SuppressionResponse{Suppression: suppressionToProto(created)}, nil
+}
+
+func (s *NotificationService) UpdateSuppression(
+	ctx context.Context,
+	req *v1.SuppressionRequest,
+) (*v1.SuppressionResponse, error) {
+	suppression, err := suppressionFromProto(req.Suppression)
+	if err != nil {
+		return nil, v1.ErrorInvalidRequest(`validation failed: %v`, err)
+	}
+	if suppression.ID <= 0 {
+		return nil, v1.ErrorInvalidRequest(`validation failed: id=%d is incorrect`, suppression.ID)
+	}
+	updated, err := s.suppressions.Update(ctx, suppression)
+	if errors.Is(err, biz.ErrSuppressionNotFound) {
+		return nil, v1.ErrorSuppressionNotFound(`suppression with id %d was not found`, suppression.ID)
+	}
+	if err != nil {
+		return nil, v1.ErrorInternalError(`update suppression failed: %v`, err)
+	}
+	return &v1.SuppressionResponse{Suppression: suppressionToProto(updated)}, nil
+}
+
+func (s *NotificationService) GetSuppression(
+	ctx context.Context,
+	req *v1.GetSuppressionRequest,
+) (*v1.SuppressionResponse, error) {
+	if req.Id <= 0 {
+		return nil, v1.ErrorInvalidRequest(`validation failed: id=%d is incorrect`, req.Id)
+	}
+	suppression, err := s.suppressions.Get(ctx, int(req.Id), int(req.SenderId), req.Tenant)
+	if errors.Is(err, biz.ErrSuppressionNotFound) {
+		return nil, v1.ErrorSuppressionNotFound(`suppression with id %d was not found`, req.Id)
+	}
+	if err != nil {
+		return nil, v1.ErrorInternalError(`get suppression failed: %v`, err)
+	}
+	return &v1.SuppressionResponse{Suppression: suppressionToProto(suppression)}, nil
+}
+
+func (s *NotificationService) DeleteSuppression(
+	ctx context.Context,
+	req *v1.DeleteSuppressionRequest,
+) (*v1.DeleteSuppressionResponse, error) {
+	if req.Id <= 0 {
+		return nil, v1.ErrorInvalidRequest(`validation failed: id=%d is incorrect`, req.Id)
+	}
+	err := s.suppressions.Delete(ctx, int(req.Id), int(req.SenderId), req.Tenant)
+	if errors.Is(err, biz.ErrSuppressionNotFound) {
+		return nil, v1.ErrorSuppressionNotFound(`suppression with id %d was not found`, req.Id)
+	}
+	if err != nil {
+		return nil, v1.ErrorInternalError(`delete suppression failed: %v`, err)
+	}
+	return &v1.DeleteSuppressionResponse{}, nil
+}
+
+func (s *NotificationService) ListSuppressions(
+	ctx context.Context,
+	req *v1.ListSuppressionsRequest,
+) (*v1.ListSuppressionsResponse, error) {
+	var notificationType schema.NotificationType
+	if req.Type != nil {
+		var ok bool
+		notificationType, ok = TypesProtoToSchemaMap[*req.Type]
+		if !ok {
+			return nil, v1.ErrorInvalidRequest(`validation failed: type %s is unknown`, req.Type.String())
+		}
+	}
+	suppressions, err := s.suppressions.List(ctx, int(req.SenderId), req.Tenant, notificationType, req.Address)
+	if err != nil {
+		return nil, v1.ErrorInternalError(`list suppressions failed: %v`, err)
+	}
+	response := &v1.ListSuppressionsResponse{
+		Suppressions: make([]*v1.Suppression, 0, len(suppressions)),
+	}
+	for _, suppression := range suppressions {
+		response.Suppressions = append(response.Suppressions, suppressionToProto(suppression))
+	}
+	return response, nil
+}
+
+func suppressionFromProto(proto *v1.Suppression) (*ent.Suppression, error) {
+	if proto == nil {
+		return nil, errors.New(`suppression is empty`)
+	}
+	notificationType, ok := TypesProtoToSchemaMap[proto.Type]
+	if !ok {
+		return nil, errors.New(`type ` + proto.Type.String() + ` is unknown`)
+	}
+	if schema.NormalizeAddress(notificationType, proto.Address) == "" {
+		return nil, errors.New(`address is empty`)
+	}
+	suppression := &ent.Suppression{
+		ID:       int(proto.Id),
+		SenderID: int(proto.SenderId),
+		Tenant:   proto.Tenant,
+		Type:     notificationType,
+		Address:  proto.Address,
+		Reason:   proto.Reason,
+	}
+	if proto.ExpiresAt != nil {
+		suppression.ExpiresAt = pointer.ToTime(proto.ExpiresAt.AsTime())
+	}
+	return suppression, nil
+}
+
+func suppressionToProto(suppression *ent.Suppression) *v1.Suppression {
+	proto := &v1.Suppression{
+		Id:        int64(suppression.ID),
+		Type:      v1.Type(v1.Type_value[suppression.Type.String()]),
+		Address:   suppression.Address,
+		Reason:    suppression.Reason,
+		CreatedAt: timestamppb.New(suppression.CreatedAt),
+		SenderId:  int64(suppression.SenderID),
+		Tenant:    suppression.Tenant,
+	}
+	if suppression.ExpiresAt != nil {
+		proto.ExpiresAt = timestamppb.New(*suppression.ExpiresAt)
+	}
+	return proto
+}
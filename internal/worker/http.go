@@ -0,0 +1,62 @@
+package worker
+
+import (
+	"net/http"
+	"time"
+
+	"notifications/internal/conf"
+	"notifications/internal/health"
+	"notifications/internal/pkg/metrics"
+	"notifications/internal/pkg/pprof"
+
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	kratosHTTP "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+const (
+	StatsPath = `/stats`
+
+	defaultLoopTimeout = 2 * time.Minute
+)
+
+// NewHTTPServer returns server with liveness, readiness, pprof, metrics and stats of worker
+// or nil if address of server is not configured
+func NewHTTPServer(c *conf.Worker_HTTP, w *Worker, h *health.Health) *kratosHTTP.Server {
+	if c.GetAddr() == "" {
+		return nil
+	}
+
+	opts := []kratosHTTP.ServerOption{
+		kratosHTTP.Address(c.GetAddr()),
+		kratosHTTP.Middleware(
+			recovery.Recovery(),
+		),
+	}
+	if c.GetNetwork() != "" {
+		opts = append(opts, kratosHTTP.Network(c.GetNetwork()))
+	}
+	if c.GetTimeout() != nil {
+		opts = append(opts, kratosHTTP.Timeout(c.GetTimeout().AsDuration()))
+	}
+	srv := kratosHTTP.NewServer(opts...)
+
+	loopTimeout := defaultLoopTimeout
+	if c.GetLoopTimeout() != nil && c.GetLoopTimeout().AsDuration() > 0 {
+		loopTimeout = c.GetLoopTimeout().AsDuration()
+	}
+	h.Register(`loop`, w.LoopChecker(loopTimeout))
+
+	health.RegisterHandlers(srv, h)
+	pprof.Register(srv)
+	metrics.RegisterHandler(srv, w.metric)
+
+	srv.Route("/").GET(StatsPath, func(ctx kratosHTTP.Context) error {
+		stats, err := w.Stats(ctx)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(http.StatusOK, stats)
+	})
+
+	return srv
+}
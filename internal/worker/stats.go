@@ -0,0 +1,83 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"notifications/internal/health"
+)
+
+// Stats is current state of worker pools and queue
+type Stats struct {
+	LastLoopAt *time.Time   `json:"lastLoopAt,omitempty"`
+	Pools      []*PoolStats `json:"pools"`
+}
+
+type PoolStats struct {
+	Name        string   `json:"name"`
+	Types       []string `json:"types,omitempty"`
+	BatchSize   int      `json:"batchSize"`
+	Concurrency int      `json:"concurrency"`
+	Busy        int64    `json:"busy"`
+	Waiting     int      `json:"waiting"`
+}
+
+// Stats returns state of pools with count of waiting notifications of their types
+func (w *Worker) Stats(ctx context.Context) (*Stats, error) {
+	stats := &Stats{
+		LastLoopAt: w.LastLoopAt(),
+		Pools:      make([]*PoolStats, 0, len(w.pools)),
+	}
+	for _, p := range w.pools {
+		waiting, err := w.usecase.CountOfPendingNotifications(ctx, p.types...)
+		if err != nil {
+			return nil, err
+		}
+		types := make([]string, 0, len(p.types))
+		for _, notificationType := range p.types {
+			types = append(types, notificationType.String())
+		}
+		stats.Pools = append(
+			stats.Pools, &PoolStats{
+				Name:        p.name,
+				Types:       types,
+				BatchSize:   p.batchSize,
+				Concurrency: p.concurrency,
+				Busy:        atomic.LoadInt64(&p.busy),
+				Waiting:     waiting,
+			},
+		)
+	}
+	return stats, nil
+}
+
+// LastLoopAt returns time of last successful loop of any pool or nil if there was no one yet
+func (w *Worker) LastLoopAt() *time.Time {
+	nanoseconds := atomic.LoadInt64(&w.lastLoopAt)
+	if nanoseconds == 0 {
+		return nil
+	}
+	lastLoopAt := time.Unix(0, nanoseconds)
+	return &lastLoopAt
+}
+
+// LoopChecker fails if worker had no successful loop for timeout
+func (w *Worker) LoopChecker(timeout time.Duration) health.Checker {
+	return func(_ context.Context) error {
+		lastLoopAt := w.LastLoopAt()
+		if lastLoopAt == nil {
+			return errors.New(`worker has no successful loop yet`)
+		}
+		if since := time.Since(*lastLoopAt); since > timeout {
+			return fmt.Errorf(`last successful loop was %s ago`, since.Round(time.Second))
+		}
+		return nil
+	}
+}
+
+func (w *Worker) touchLoop() {
+	atomic.StoreInt64(&w.lastLoopAt, time.Now().UnixNano())
+}
@@ -2,104 +2,525 @@ package worker
 
 import (
 	"context"
+	"fmt"
 	"math"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"notifications/ent/schema"
 	"notifications/internal/biz"
+	"notifications/internal/conf"
+	"notifications/internal/pkg/metrics"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
 
 const (
-	notificationsLimit = 10 // limit of notifications processing at one time
+	defaultBatchSize = 10 // limit of notifications processing at one time
 
-	maxConcurrentWorkers = 10
+	defaultConcurrency = 10
 
-	sleepDuration = time.Second
+	defaultPollInterval = time.Second
+
+	defaultListenPollInterval = 30 * time.Second
+
+	minPoolBackoff = 100 * time.Millisecond // first sleep of pool claiming nothing or failing to count
+
+	defaultQueueMetricsInterval = 15 * time.Second
+
+	defaultScheduleInterval = 10 * time.Second
+
+	defaultScheduleBatchSize = 10
+
+	defaultDigestInterval = 10 * time.Second
+
+	defaultDigestBatchSize = 100
+
+	commonPoolName = `common`
+
+	metricPoolBusy       = `worker.pool.%s.busy`
+	metricPoolSaturation = `worker.pool.%s.saturation`
+	metricPoolBlocked    = `worker.pool.%s.blocked.timings`
+
+	metricQueueDepth     = `worker.queue.%s.%s.depth`
+	metricQueueOldestAge = `worker.queue.%s.%s.oldestAge`
 )
 
 type Worker struct {
 	usecase *biz.NotificationUsecase
 
+	pools []*pool
+
+	pollInterval time.Duration
+
+	queueMetricsInterval time.Duration
+
+	listener biz.NotificationListener
+
+	scheduler Scheduler
+
+	scheduleInterval time.Duration
+
+	scheduleBatchSize int
+
+	digester Digester
+
+	digestInterval time.Duration
+
+	digestBatchSize int
+
+	metric metrics.Metrics
+
 	logger *log.Helper
 
 	runOnce bool
+
+	lastLoopAt int64 // unix nanoseconds of last successful loop of any pool
+}
+
+// pool is set of long-lived processes claiming notifications of its types
+type pool struct {
+	name        string
+	types       []schema.NotificationType // all types if empty
+	batchSize   int
+	concurrency int
+	busy        int64
+	starved     int32 // last claim of pool found nothing, though notifications are waiting
+	wake        chan struct{}
 }
 
 type Option func(w *Worker)
 
+// Scheduler materializes due occurrences of recurring notifications
+type Scheduler interface {
+	Materialize(ctx context.Context, limit int) (int, error)
+}
+
+// Digester merges held notifications with passed flush time into digests
+type Digester interface {
+	Flush(ctx context.Context, limit int) (int, error)
+}
+
 func RunOnceOption() Option {
 	return func(w *Worker) {
 		w.runOnce = true
 	}
 }
 
-func New(u *biz.NotificationUsecase, l log.Logger, options ...Option) *Worker {
+// ListenOption wakes pools by listener and polls with interval only as fallback for planned notifications
+func ListenOption(listener biz.NotificationListener, pollInterval time.Duration) Option {
+	return func(w *Worker) {
+		w.listener = listener
+		w.pollInterval = defaultListenPollInterval
+		if pollInterval > 0 {
+			w.pollInterval = pollInterval
+		}
+	}
+}
+
+// ScheduleOption periodically materializes due schedules into notifications
+func ScheduleOption(scheduler Scheduler, interval time.Duration, batchSize uint32) Option {
+	return func(w *Worker) {
+		w.scheduler = scheduler
+		w.scheduleInterval = defaultScheduleInterval
+		if interval > 0 {
+			w.scheduleInterval = interval
+		}
+		w.scheduleBatchSize = orDefault(batchSize, defaultScheduleBatchSize)
+	}
+}
+
+// DigestOption periodically merges held notifications into digests
+func DigestOption(digester Digester, interval time.Duration, batchSize uint32) Option {
+	return func(w *Worker) {
+		w.digester = digester
+		w.digestInterval = defaultDigestInterval
+		if interval > 0 {
+			w.digestInterval = interval
+		}
+		w.digestBatchSize = orDefault(batchSize, defaultDigestBatchSize)
+	}
+}
+
+func New(u *biz.NotificationUsecase, c *conf.Worker, metric metrics.Metrics, l log.Logger, options ...Option) *Worker {
 	w := &Worker{
-		usecase: u,
-		logger:  log.NewHelper(l),
+		usecase:              u,
+		pollInterval:         defaultPollInterval,
+		queueMetricsInterval: defaultQueueMetricsInterval,
+		metric:               metric,
+		logger:               log.NewHelper(l),
+	}
+	if c.GetPollInterval() != nil && c.GetPollInterval().AsDuration() > 0 {
+		w.pollInterval = c.GetPollInterval().AsDuration()
 	}
+	if c.GetQueueMetricsInterval() != nil {
+		w.queueMetricsInterval = c.GetQueueMetricsInterval().AsDuration()
+	}
+	w.pools = w.makePools(c)
 	for _, option := range options {
 		option(w)
 	}
 	return w
 }
 
+// Run starts all pools and blocks until context is done or any pool fails
 func (w *Worker) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if w.listener != nil {
+		go w.listen(ctx)
+	}
+	if w.queueMetricsInterval > 0 && !w.runOnce {
+		go w.reportQueue(ctx)
+	}
+	if w.scheduler != nil {
+		if w.runOnce {
+			w.materialize(ctx)
+		} else {
+			go w.runScheduler(ctx)
+		}
+	}
+	if w.digester != nil {
+		if w.runOnce {
+			w.flush(ctx)
+		} else {
+			go w.runDigester(ctx)
+		}
+	}
+
+	errs := make(chan error, len(w.pools))
+	wg := sync.WaitGroup{}
+	for _, p := range w.pools {
+		wg.Add(1)
+		go func(p *pool) {
+			defer wg.Done()
+			if err := w.runPool(ctx, p); err != nil {
+				errs <- err
+				cancel()
+			}
+		}(p)
+	}
+	wg.Wait()
+	close(errs)
+
+	return <-errs
+}
+
+// runPool dispatches batches to processes of pool. Dispatch blocks while all processes are busy,
+// so pool never claims more notifications than it is able to send
+func (w *Worker) runPool(ctx context.Context, p *pool) error {
+	jobs := make(chan struct{})
+	wg := sync.WaitGroup{}
+	for i := 0; i < p.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range jobs {
+				w.process(ctx, p)
+			}
+		}()
+	}
+	defer wg.Wait()
+	defer close(jobs)
+
+	backoff := time.Duration(0)
 	for {
 		select {
 		case <-ctx.Done():
-			w.logger.Info(`worker get done signal`)
+			w.logger.Infof(`worker pool %s get done signal`, p.name)
 			return nil
 		default:
 		}
-		count, err := w.usecase.CountOfPendingNotifications(ctx)
+		count, err := w.usecase.CountOfPendingNotifications(ctx, p.types...)
 		if err != nil {
-			w.logger.Errorf(`failed to count waiting notifications: %v`, err)
-			return err
+			w.logger.Errorf(`failed to count waiting notifications of pool %s: %v`, p.name, err)
+			if w.runOnce {
+				return err
+			}
+			backoff = w.nextBackoff(backoff)
+			w.sleep(ctx, p, backoff)
+			continue
 		}
+		w.touchLoop()
 		if count == 0 {
 			if w.runOnce {
 				return nil
 			}
-			w.logger.Infof("primary count = 0, sleeping for %d seconds", int(sleepDuration.Seconds()))
-			time.Sleep(sleepDuration)
+			w.logger.Debugf(
+				"pool %s primary count = 0, sleeping for %d seconds",
+				p.name,
+				int(w.pollInterval.Seconds()),
+			)
+			w.sleep(ctx, p, w.pollInterval)
 			continue
 		}
-		goroutines := int(
+		// Waiting notifications may be claimed by other workers or be retried later, so pool sleeps
+		// before the next round instead of claiming nothing again and again
+		if atomic.LoadInt32(&p.starved) == 1 && !w.runOnce {
+			backoff = w.nextBackoff(backoff)
+			w.logger.Debugf("pool %s claimed nothing, sleeping for %s", p.name, backoff)
+			w.sleep(ctx, p, backoff)
+		} else {
+			backoff = 0
+		}
+		batches := int(
 			math.Min(
-				float64(maxConcurrentWorkers),
-				math.Ceil(float64(count)/float64(notificationsLimit)),
+				float64(p.concurrency),
+				math.Ceil(float64(count)/float64(p.batchSize)),
 			),
 		)
-		found := int64(0)
-		processed := int64(0)
-		wg := sync.WaitGroup{}
-		for ; goroutines != 0; goroutines-- {
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-
-				currentFound, currentProcessed, err := w.usecase.ProcessNotifications(ctx, notificationsLimit)
-				if err != nil {
-					w.logger.Warnf(`error run once notification process: %v`, err)
+		for ; batches != 0; batches-- {
+			blocked := w.metric.NewTiming()
+			select {
+			case jobs <- struct{}{}:
+				blocked.Send(fmt.Sprintf(metricPoolBlocked, p.name))
+			case <-ctx.Done():
+				return nil
+			}
+		}
+		if w.runOnce {
+			return nil
+		}
+	}
+}
+
+// sleep waits for duration, done of context or wake of pool
+func (w *Worker) sleep(ctx context.Context, p *pool, d time.Duration) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(d):
+	case <-p.wake:
+	}
+}
+
+// nextBackoff doubles sleep of pool from minimal one up to poll interval
+func (w *Worker) nextBackoff(backoff time.Duration) time.Duration {
+	backoff *= 2
+	if backoff < minPoolBackoff {
+		backoff = minPoolBackoff
+	}
+	if backoff > w.pollInterval {
+		backoff = w.pollInterval
+	}
+	return backoff
+}
+
+// listen wakes sleeping pools of notification types from listener
+func (w *Worker) listen(ctx context.Context) {
+	types, err := w.listener.Listen(ctx)
+	if err != nil {
+		w.logger.Errorf(`failed to listen notifications, fallback to polling: %v`, err)
+		return
+	}
+	for notificationType := range types {
+		for _, p := range w.pools {
+			if !p.accepts(notificationType) {
+				continue
+			}
+			select {
+			case p.wake <- struct{}{}:
+			default: // pool is already woken
+			}
+		}
+	}
+}
+
+// accepts returns true if pool processes notifications of type, empty type is accepted by any pool
+func (p *pool) accepts(notificationType schema.NotificationType) bool {
+	if notificationType == `` || len(p.types) == 0 {
+		return true
+	}
+	for _, t := range p.types {
+		if t == notificationType {
+			return true
+		}
+	}
+	return false
+}
+
+// reportQueue periodically sends depth of queue and age of the oldest notification by status and type.
+// Gauges of absent combinations are reset to zero
+func (w *Worker) reportQueue(ctx context.Context) {
+	ticker := time.NewTicker(w.queueMetricsInterval)
+	defer ticker.Stop()
+	for {
+		depths, err := w.usecase.QueueDepth(ctx)
+		if err != nil {
+			w.logger.Warnf(`failed to get queue depth: %v`, err)
+		} else {
+			now := time.Now()
+			reported := map[string]*biz.QueueDepth{}
+			for _, depth := range depths {
+				reported[depth.Status.String()+`.`+depth.Type.String()] = depth
+			}
+			for _, status := range biz.QueuedStatuses {
+				for _, notificationType := range schema.Types {
+					count, age := 0, 0.
+					if depth, ok := reported[status.String()+`.`+notificationType.String()]; ok {
+						count = depth.Count
+						age = math.Max(0, now.Sub(depth.OldestPlannedAt).Seconds())
+					}
+					w.metric.Gauge(fmt.Sprintf(metricQueueDepth, status, notificationType), count)
+					w.metric.Gauge(fmt.Sprintf(metricQueueOldestAge, status, notificationType), age)
 				}
-				atomic.AddInt64(&found, currentFound)
-				atomic.AddInt64(&processed, currentProcessed)
-			}()
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
 		}
-		wg.Wait()
+	}
+}
 
-		w.logger.Infof(
-			"process iteration complete: primary count = %d, found = %d, processed = %d",
-			count,
-			found,
-			processed,
+// runScheduler materializes due schedules with interval, schedules are locked so replicas do not duplicate them
+func (w *Worker) runScheduler(ctx context.Context) {
+	ticker := time.NewTicker(w.scheduleInterval)
+	defer ticker.Stop()
+	for {
+		w.materialize(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// materialize creates notifications of due schedules by batches until there are no due schedules left
+func (w *Worker) materialize(ctx context.Context) {
+	for ctx.Err() == nil {
+		count, err := w.scheduler.Materialize(ctx, w.scheduleBatchSize)
+		if err != nil {
+			w.logger.Warnf(`failed to materialize schedules: %v`, err)
+			return
+		}
+		if count > 0 {
+			w.logger.Infof(`materialized %d notifications of schedules`, count)
+		}
+		if count < w.scheduleBatchSize {
+			return
+		}
+	}
+}
+
+// runDigester merges held notifications with interval, notifications are locked so replicas do not duplicate digests
+func (w *Worker) runDigester(ctx context.Context) {
+	ticker := time.NewTicker(w.digestInterval)
+	defer ticker.Stop()
+	for {
+		w.flush(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// flush merges held notifications by batches until there are no due held notifications left
+func (w *Worker) flush(ctx context.Context) {
+	for ctx.Err() == nil {
+		count, err := w.digester.Flush(ctx, w.digestBatchSize)
+		if err != nil {
+			w.logger.Warnf(`failed to flush digests: %v`, err)
+			return
+		}
+		if count > 0 {
+			w.logger.Infof(`merged %d held notifications into digests`, count)
+		}
+		if count < w.digestBatchSize {
+			return
+		}
+	}
+}
+
+func (w *Worker) process(ctx context.Context, p *pool) {
+	w.reportSaturation(p, atomic.AddInt64(&p.busy, 1))
+	defer func() {
+		w.reportSaturation(p, atomic.AddInt64(&p.busy, -1))
+	}()
+
+	found, processed, err := w.usecase.ProcessNotifications(ctx, p.batchSize, p.types...)
+	if err != nil {
+		w.logger.Warnf(`error run once notification process in pool %s: %v`, p.name, err)
+	} else {
+		w.touchLoop()
+	}
+	starved := int32(0)
+	if err != nil || found == 0 {
+		starved = 1
+	}
+	atomic.StoreInt32(&p.starved, starved)
+	w.logger.Infof(
+		"process iteration of pool %s complete: found = %d, processed = %d",
+		p.name,
+		found,
+		processed,
+	)
+}
+
+func (w *Worker) reportSaturation(p *pool, busy int64) {
+	w.metric.Gauge(fmt.Sprintf(metricPoolBusy, p.name), busy)
+	w.metric.Gauge(fmt.Sprintf(metricPoolSaturation, p.name), float64(busy)/float64(p.concurrency))
+}
+
+// makePools returns dedicated pools of configured types and common pool for all other types
+func (w *Worker) makePools(c *conf.Worker) []*pool {
+	common := &pool{
+		name:        commonPoolName,
+		batchSize:   orDefault(c.GetBatchSize(), defaultBatchSize),
+		concurrency: orDefault(c.GetConcurrency(), defaultConcurrency),
+		wake:        make(chan struct{}, 1),
+	}
+
+	names := make([]string, 0, len(c.GetPools()))
+	for name := range c.GetPools() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pools := []*pool{}
+	dedicated := map[schema.NotificationType]bool{}
+	for _, name := range names {
+		if err := schema.ValidateType(name); err != nil {
+			w.logger.Warnf(`worker pool %s is skipped: %v`, name, err)
+			continue
+		}
+		c := c.GetPools()[name]
+		notificationType := schema.NotificationType(name)
+		dedicated[notificationType] = true
+		pools = append(
+			pools, &pool{
+				name:        name,
+				types:       []schema.NotificationType{notificationType},
+				batchSize:   orDefault(c.GetBatchSize(), common.batchSize),
+				concurrency: orDefault(c.GetConcurrency(), 1),
+				wake:        make(chan struct{}, 1),
+			},
 		)
-		if w.runOnce {
-			return nil
+	}
+
+	if len(dedicated) == 0 {
+		return []*pool{common}
+	}
+	for _, notificationType := range schema.Types {
+		if !dedicated[notificationType] {
+			common.types = append(common.types, notificationType)
 		}
 	}
+	if len(common.types) > 0 {
+		pools = append([]*pool{common}, pools...)
+	}
+	return pools
+}
+
+func orDefault(value uint32, fallback int) int {
+	if value == 0 {
+		return fallback
+	}
+	return int(value)
 }
@@ -8,7 +8,10 @@ import (
 	context "context"
 	sql "database/sql"
 	ent "notifications/ent"
+	schema "notifications/ent/schema"
+	biz "notifications/internal/biz"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -37,18 +40,18 @@ func (m *MockNotificationRepo) EXPECT() *MockNotificationRepoMockRecorder {
 }
 
 // CountWaitingNotifications mocks base method.
-func (m *MockNotificationRepo) CountWaitingNotifications(ctx context.Context) (int, error) {
+func (m *MockNotificationRepo) CountWaitingNotifications(ctx context.Context, types []schema.NotificationType) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountWaitingNotifications", ctx)
+	ret := m.ctrl.Call(m, "CountWaitingNotifications", ctx, types)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CountWaitingNotifications indicates an expected call of CountWaitingNotifications.
-func (mr *MockNotificationRepoMockRecorder) CountWaitingNotifications(ctx interface{}) *gomock.Call {
+func (mr *MockNotificationRepoMockRecorder) CountWaitingNotifications(ctx, types interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountWaitingNotifications", reflect.TypeOf((*MockNotificationRepo)(nil).CountWaitingNotifications), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountWaitingNotifications", reflect.TypeOf((*MockNotificationRepo)(nil).CountWaitingNotifications), ctx, types)
 }
 
 // Create mocks base method.
@@ -95,19 +98,94 @@ func (mr *MockNotificationRepoMockRecorder) FindByID(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockNotificationRepo)(nil).FindByID), arg0, arg1)
 }
 
+// FindDuplicate mocks base method.
+func (m *MockNotificationRepo) FindDuplicate(ctx context.Context, senderID int, tenant, dedupKey string, since time.Time) (*ent.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDuplicate", ctx, senderID, tenant, dedupKey, since)
+	ret0, _ := ret[0].(*ent.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDuplicate indicates an expected call of FindDuplicate.
+func (mr *MockNotificationRepoMockRecorder) FindDuplicate(ctx, senderID, tenant, dedupKey, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDuplicate", reflect.TypeOf((*MockNotificationRepo)(nil).FindDuplicate), ctx, senderID, tenant, dedupKey, since)
+}
+
+// ListDueHeldOfDigestWithLock mocks base method.
+func (m *MockNotificationRepo) ListDueHeldOfDigestWithLock(ctx context.Context, senderID int, digestKey string, now time.Time) ([]*ent.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueHeldOfDigestWithLock", ctx, senderID, digestKey, now)
+	ret0, _ := ret[0].([]*ent.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueHeldOfDigestWithLock indicates an expected call of ListDueHeldOfDigestWithLock.
+func (mr *MockNotificationRepoMockRecorder) ListDueHeldOfDigestWithLock(ctx, senderID, digestKey, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueHeldOfDigestWithLock", reflect.TypeOf((*MockNotificationRepo)(nil).ListDueHeldOfDigestWithLock), ctx, senderID, digestKey, now)
+}
+
+// ListDueHeldWithLock mocks base method.
+func (m *MockNotificationRepo) ListDueHeldWithLock(ctx context.Context, now time.Time, limit int) ([]*ent.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueHeldWithLock", ctx, now, limit)
+	ret0, _ := ret[0].([]*ent.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueHeldWithLock indicates an expected call of ListDueHeldWithLock.
+func (mr *MockNotificationRepoMockRecorder) ListDueHeldWithLock(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueHeldWithLock", reflect.TypeOf((*MockNotificationRepo)(nil).ListDueHeldWithLock), ctx, now, limit)
+}
+
+// ListHeld mocks base method.
+func (m *MockNotificationRepo) ListHeld(ctx context.Context, senderID int, digestKey string) ([]*ent.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHeld", ctx, senderID, digestKey)
+	ret0, _ := ret[0].([]*ent.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHeld indicates an expected call of ListHeld.
+func (mr *MockNotificationRepoMockRecorder) ListHeld(ctx, senderID, digestKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHeld", reflect.TypeOf((*MockNotificationRepo)(nil).ListHeld), ctx, senderID, digestKey)
+}
+
 // ListWaitingNotificationsWithLock mocks base method.
-func (m *MockNotificationRepo) ListWaitingNotificationsWithLock(ctx context.Context, limit int) ([]*ent.Notification, error) {
+func (m *MockNotificationRepo) ListWaitingNotificationsWithLock(ctx context.Context, limit int, types []schema.NotificationType, priorities biz.PriorityRange) ([]*ent.Notification, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListWaitingNotificationsWithLock", ctx, limit)
+	ret := m.ctrl.Call(m, "ListWaitingNotificationsWithLock", ctx, limit, types, priorities)
 	ret0, _ := ret[0].([]*ent.Notification)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListWaitingNotificationsWithLock indicates an expected call of ListWaitingNotificationsWithLock.
-func (mr *MockNotificationRepoMockRecorder) ListWaitingNotificationsWithLock(ctx, limit interface{}) *gomock.Call {
+func (mr *MockNotificationRepoMockRecorder) ListWaitingNotificationsWithLock(ctx, limit, types, priorities interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWaitingNotificationsWithLock", reflect.TypeOf((*MockNotificationRepo)(nil).ListWaitingNotificationsWithLock), ctx, limit, types, priorities)
+}
+
+// QueueDepth mocks base method.
+func (m *MockNotificationRepo) QueueDepth(ctx context.Context, statuses []schema.NotificationStatus) ([]*biz.QueueDepth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueDepth", ctx, statuses)
+	ret0, _ := ret[0].([]*biz.QueueDepth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueueDepth indicates an expected call of QueueDepth.
+func (mr *MockNotificationRepoMockRecorder) QueueDepth(ctx, statuses interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWaitingNotificationsWithLock", reflect.TypeOf((*MockNotificationRepo)(nil).ListWaitingNotificationsWithLock), ctx, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueDepth", reflect.TypeOf((*MockNotificationRepo)(nil).QueueDepth), ctx, statuses)
 }
 
 // Transaction mocks base method.
@@ -181,6 +259,20 @@ func (mr *MockEmailSenderMockRecorder) SendHTML(ctx, to, subject, body interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHTML", reflect.TypeOf((*MockEmailSender)(nil).SendHTML), ctx, to, subject, body)
 }
 
+// SendHTMLWithText mocks base method.
+func (m *MockEmailSender) SendHTMLWithText(ctx context.Context, to []string, subject, body, text string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHTMLWithText", ctx, to, subject, body, text)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHTMLWithText indicates an expected call of SendHTMLWithText.
+func (mr *MockEmailSenderMockRecorder) SendHTMLWithText(ctx, to, subject, body, text interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHTMLWithText", reflect.TypeOf((*MockEmailSender)(nil).SendHTMLWithText), ctx, to, subject, body, text)
+}
+
 // SendText mocks base method.
 func (m *MockEmailSender) SendText(ctx context.Context, to []string, subject, body string) error {
 	m.ctrl.T.Helper()
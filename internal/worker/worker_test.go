@@ -5,18 +5,22 @@ import (
 	"database/sql"
 	"errors"
 	"math/rand"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"notifications/ent"
 	"notifications/ent/schema"
 	"notifications/internal/biz"
+	"notifications/internal/conf"
+	"notifications/internal/pkg/metrics"
 	"notifications/internal/senders"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
-	"gopkg.in/alexcesaro/statsd.v2"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 //go:generate mockgen -source ./${GOFILE} -destination ./worker_repo_mock_test.go -package ${GOPACKAGE}
@@ -43,7 +47,7 @@ func TestWorker_Run(t *testing.T) {
 	loggerInstance := log.With(log.DefaultLogger, "ts", log.DefaultTimestamp)
 	logger := log.NewFilter(loggerInstance, log.FilterLevel(log.LevelFatal))
 
-	metricMuted, err := statsd.New(statsd.Mute(true))
+	metricMuted, err := metrics.New(``, `test`, true)
 	require.NoError(t, err)
 
 	transaction := func(ctx context.Context, _ *sql.TxOptions, actions ...func(context.Context) error) error {
@@ -63,6 +67,7 @@ func TestWorker_Run(t *testing.T) {
 
 	testCases := []struct {
 		name             string
+		worker           *conf.Worker
 		notificationRepo func() NotificationRepo
 		plainSender      func() PlainSender
 		emailSender      func() EmailSender
@@ -72,10 +77,10 @@ func TestWorker_Run(t *testing.T) {
 			name: "basic",
 			notificationRepo: func() NotificationRepo {
 				notificationRepoMock := NewMockNotificationRepo(ctrl)
-				notificationRepoMock.EXPECT().CountWaitingNotifications(gomock.Any()).Return(0, nil)
+				notificationRepoMock.EXPECT().CountWaitingNotifications(gomock.Any(), gomock.Any()).Return(0, nil)
 				notificationRepoMock.EXPECT().Transaction(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 				notificationRepoMock.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
-				notificationRepoMock.EXPECT().ListWaitingNotificationsWithLock(gomock.Any(), gomock.Any()).Times(0)
+				notificationRepoMock.EXPECT().ListWaitingNotificationsWithLock(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 				return notificationRepoMock
 			},
 			plainSender: func() PlainSender {
@@ -95,7 +100,7 @@ func TestWorker_Run(t *testing.T) {
 			notificationRepo: func() NotificationRepo {
 				notificationRepoMock := NewMockNotificationRepo(ctrl)
 				notificationRepoMock.EXPECT().
-					CountWaitingNotifications(gomock.Any()).
+					CountWaitingNotifications(gomock.Any(), gomock.Any()).
 					Return(1000, nil).
 					Times(1)
 
@@ -107,12 +112,12 @@ func TestWorker_Run(t *testing.T) {
 				notificationRepoMock.EXPECT().
 					Update(gomock.Any(), gomock.Any()).
 					DoAndReturn(update).
-					Times(100)
+					Times(200) // claim and result of every notification
 
 				notificationRepoMock.EXPECT().
-					ListWaitingNotificationsWithLock(gomock.Any(), gomock.Any()).
+					ListWaitingNotificationsWithLock(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					DoAndReturn(
-						func(_ context.Context, _ int) ([]*ent.Notification, error) {
+						func(_ context.Context, _ int, _ []schema.NotificationType, _ biz.PriorityRange) ([]*ent.Notification, error) {
 							return makePlainNotifications(10, "test message")
 						},
 					).
@@ -136,7 +141,7 @@ func TestWorker_Run(t *testing.T) {
 			notificationRepo: func() NotificationRepo {
 				notificationRepoMock := NewMockNotificationRepo(ctrl)
 				notificationRepoMock.EXPECT().
-					CountWaitingNotifications(gomock.Any()).
+					CountWaitingNotifications(gomock.Any(), gomock.Any()).
 					Return(10, nil).
 					Times(1)
 
@@ -149,6 +154,14 @@ func TestWorker_Run(t *testing.T) {
 					Update(gomock.Any(), gomock.Any()).
 					DoAndReturn(
 						func(ctx context.Context, n *ent.Notification) (*ent.Notification, error) {
+							if n.Status == schema.StatusProcessing {
+								require.NotNil(t, n.LeaseUntil)
+								require.NotNil(t, n.WorkerID)
+								return n, nil
+							}
+
+							require.Nil(t, n.LeaseUntil)
+							require.Nil(t, n.WorkerID)
 							require.Equal(t, 1, n.Retries)
 							require.Equal(t, schema.StatusRetry, n.Status)
 							require.NotNil(t, n.RetryAt)
@@ -164,12 +177,12 @@ func TestWorker_Run(t *testing.T) {
 							return n, nil
 						},
 					).
-					Times(10)
+					Times(20)
 
 				notificationRepoMock.EXPECT().
-					ListWaitingNotificationsWithLock(gomock.Any(), gomock.Any()).
+					ListWaitingNotificationsWithLock(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					DoAndReturn(
-						func(_ context.Context, _ int) ([]*ent.Notification, error) {
+						func(_ context.Context, _ int, _ []schema.NotificationType, _ biz.PriorityRange) ([]*ent.Notification, error) {
 							return makePlainNotifications(10, "test message")
 						},
 					).
@@ -191,6 +204,109 @@ func TestWorker_Run(t *testing.T) {
 				return emailSender
 			},
 		},
+		{
+			name: "failed-record",
+			notificationRepo: func() NotificationRepo {
+				notificationRepoMock := NewMockNotificationRepo(ctrl)
+				notificationRepoMock.EXPECT().
+					CountWaitingNotifications(gomock.Any(), gomock.Any()).
+					Return(10, nil).
+					Times(1)
+
+				notificationRepoMock.EXPECT().
+					Transaction(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(transaction).
+					Times(1)
+
+				// Failed record of one result must not affect results of other notifications
+				notificationRepoMock.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(
+						func(ctx context.Context, n *ent.Notification) (*ent.Notification, error) {
+							if n.Status != schema.StatusProcessing && n.ID == 8080001 {
+								return nil, errors.New("test for failed update")
+							}
+							return n, nil
+						},
+					).
+					Times(20)
+
+				notificationRepoMock.EXPECT().
+					ListWaitingNotificationsWithLock(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(
+						func(_ context.Context, _ int, _ []schema.NotificationType, _ biz.PriorityRange) ([]*ent.Notification, error) {
+							return makePlainNotifications(10, "test message")
+						},
+					).
+					Times(1)
+				return notificationRepoMock
+			},
+			plainSender: func() PlainSender {
+				plainSender := NewMockPlainSender(ctrl)
+				plainSender.EXPECT().Send(gomock.Any(), gomock.Any()).Times(10)
+				return plainSender
+			},
+			emailSender: func() EmailSender {
+				emailSender := NewMockEmailSender(ctrl)
+				emailSender.EXPECT().SendText(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				emailSender.EXPECT().SendHTML(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				return emailSender
+			},
+		},
+		{
+			name: "dedicated-pool",
+			worker: &conf.Worker{
+				Pools: map[string]*conf.Worker_Pool{
+					schema.TypePlain.String(): {BatchSize: 5, Concurrency: 2},
+				},
+			},
+			notificationRepo: func() NotificationRepo {
+				notificationRepoMock := NewMockNotificationRepo(ctrl)
+				notificationRepoMock.EXPECT().
+					CountWaitingNotifications(gomock.Any(), gomock.Any()).
+					DoAndReturn(
+						func(_ context.Context, types []schema.NotificationType) (int, error) {
+							if len(types) == 1 && types[0] == schema.TypePlain {
+								return 10, nil
+							}
+							require.NotContains(t, types, schema.TypePlain)
+							return 0, nil
+						},
+					).
+					Times(2)
+
+				notificationRepoMock.EXPECT().
+					Transaction(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(transaction).
+					Times(2)
+
+				notificationRepoMock.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(update).
+					Times(20)
+
+				notificationRepoMock.EXPECT().
+					ListWaitingNotificationsWithLock(gomock.Any(), 5, []schema.NotificationType{schema.TypePlain}, gomock.Any()).
+					DoAndReturn(
+						func(_ context.Context, limit int, _ []schema.NotificationType, _ biz.PriorityRange) ([]*ent.Notification, error) {
+							return makePlainNotifications(limit, "test message")
+						},
+					).
+					Times(2)
+				return notificationRepoMock
+			},
+			plainSender: func() PlainSender {
+				plainSender := NewMockPlainSender(ctrl)
+				plainSender.EXPECT().Send(gomock.Any(), gomock.Any()).Times(10)
+				return plainSender
+			},
+			emailSender: func() EmailSender {
+				emailSender := NewMockEmailSender(ctrl)
+				emailSender.EXPECT().SendText(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				emailSender.EXPECT().SendHTML(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				return emailSender
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -203,9 +319,9 @@ func TestWorker_Run(t *testing.T) {
 					EmailSender: testCase.emailSender(),
 				}
 
-				usecase := biz.NewNotificationUsecase(notificationsRepoMock, sendersMock, metricMuted, logger)
+				usecase := biz.NewNotificationUsecase(notificationsRepoMock, nil, nil, nil, sendersMock, nil, metricMuted, logger)
 
-				worker := New(usecase, logger, RunOnceOption())
+				worker := New(usecase, testCase.worker, metricMuted, logger, RunOnceOption())
 
 				err := worker.Run(ctx)
 				require.Nil(t, err)
@@ -238,3 +354,286 @@ func makePlainNotifications(length int, message string) ([]*ent.Notification, er
 
 	return notifications, nil
 }
+
+type listenerStub struct {
+	types chan schema.NotificationType
+}
+
+func (l *listenerStub) Listen(_ context.Context) (<-chan schema.NotificationType, error) {
+	return l.types, nil
+}
+
+func TestWorker_RunWithListener(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	loggerInstance := log.With(log.DefaultLogger, "ts", log.DefaultTimestamp)
+	logger := log.NewFilter(loggerInstance, log.FilterLevel(log.LevelFatal))
+
+	metricMuted, err := metrics.New(``, `test`, true)
+	require.NoError(t, err)
+
+	woken := int32(0)
+	listener := &listenerStub{types: make(chan schema.NotificationType)}
+
+	notificationRepoMock := NewMockNotificationRepo(ctrl)
+	notificationRepoMock.EXPECT().
+		CountWaitingNotifications(gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			func(_ context.Context, _ []schema.NotificationType) (int, error) {
+				if atomic.CompareAndSwapInt32(&woken, 1, 0) {
+					return 1, nil
+				}
+				return 0, nil
+			},
+		).
+		AnyTimes()
+
+	notificationRepoMock.EXPECT().
+		Transaction(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, _ *sql.TxOptions, actions ...func(context.Context) error) error {
+				for _, action := range actions {
+					if err := action(ctx); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		).
+		Times(1)
+
+	notificationRepoMock.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			func(_ context.Context, n *ent.Notification) (*ent.Notification, error) {
+				return n, nil
+			},
+		).
+		Times(2)
+
+	notificationRepoMock.EXPECT().
+		ListWaitingNotificationsWithLock(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			func(_ context.Context, _ int, _ []schema.NotificationType, _ biz.PriorityRange) ([]*ent.Notification, error) {
+				return makePlainNotifications(1, "test message")
+			},
+		).
+		Times(1)
+
+	plainSender := NewMockPlainSender(ctrl)
+	plainSender.EXPECT().
+		Send(gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			func(_ context.Context, _ string) error {
+				cancel()
+				return nil
+			},
+		).
+		Times(1)
+
+	sendersMock := &senders.Senders{
+		PlainSender: plainSender,
+		EmailSender: NewMockEmailSender(ctrl),
+	}
+
+	usecase := biz.NewNotificationUsecase(notificationRepoMock, nil, nil, nil, sendersMock, nil, metricMuted, logger)
+
+	// Poll interval is too long for test, so notification may be found only after wake by listener
+	worker := New(
+		usecase,
+		&conf.Worker{QueueMetricsInterval: durationpb.New(0)},
+		metricMuted,
+		logger,
+		ListenOption(listener, time.Hour),
+	)
+
+	go func() {
+		atomic.StoreInt32(&woken, 1)
+		listener.types <- schema.TypePlain
+	}()
+
+	err = worker.Run(ctx)
+	require.Nil(t, err)
+	require.NotErrorIs(t, ctx.Err(), context.DeadlineExceeded)
+}
+
+func TestWorker_RunBacksOff(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	logger := log.NewFilter(log.DefaultLogger, log.FilterLevel(log.LevelFatal))
+
+	metricMuted, err := metrics.New(``, `test`, true)
+	require.NoError(t, err)
+
+	counts := int32(0)
+	claims := int32(0)
+
+	notificationRepoMock := NewMockNotificationRepo(ctrl)
+	notificationRepoMock.EXPECT().
+		CountWaitingNotifications(gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			func(_ context.Context, _ []schema.NotificationType) (int, error) {
+				if atomic.AddInt32(&counts, 1) == 1 {
+					return 0, errors.New("test for failed count")
+				}
+				return 1, nil // notification is locked by other worker, so it is never claimed
+			},
+		).
+		AnyTimes()
+
+	notificationRepoMock.EXPECT().
+		Transaction(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, _ *sql.TxOptions, actions ...func(context.Context) error) error {
+				for _, action := range actions {
+					if err := action(ctx); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		).
+		AnyTimes()
+
+	notificationRepoMock.EXPECT().
+		ListWaitingNotificationsWithLock(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			func(_ context.Context, _ int, _ []schema.NotificationType, _ biz.PriorityRange) ([]*ent.Notification, error) {
+				atomic.AddInt32(&claims, 1)
+				return nil, nil
+			},
+		).
+		AnyTimes()
+
+	usecase := biz.NewNotificationUsecase(notificationRepoMock, nil, nil, nil, &senders.Senders{}, nil, metricMuted, logger)
+	worker := New(usecase, &conf.Worker{QueueMetricsInterval: durationpb.New(0)}, metricMuted, logger)
+
+	err = worker.Run(ctx)
+	require.NoError(t, err, `failed count does not stop worker`)
+	require.Greater(t, atomic.LoadInt32(&claims), int32(0))
+	require.Less(t, atomic.LoadInt32(&counts), int32(20), `pool claiming nothing sleeps between rounds`)
+}
+
+// gaugeRecorder keeps last values of gauges
+type gaugeRecorder struct {
+	metrics.Metrics
+	mu     sync.Mutex
+	gauges map[string]interface{}
+}
+
+func (r *gaugeRecorder) Gauge(bucket string, value interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.gauges[bucket] = value
+}
+
+func TestWorker_ReportQueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := log.NewFilter(log.DefaultLogger, log.FilterLevel(log.LevelFatal))
+
+	metricMuted, err := metrics.New(``, `test`, true)
+	require.NoError(t, err)
+	recorder := &gaugeRecorder{Metrics: metricMuted, gauges: map[string]interface{}{}}
+
+	notificationRepoMock := NewMockNotificationRepo(ctrl)
+	notificationRepoMock.EXPECT().
+		QueueDepth(gomock.Any(), biz.QueuedStatuses).
+		Return(
+			[]*biz.QueueDepth{
+				{
+					Status:          schema.StatusPending,
+					Type:            schema.TypeEmail,
+					Count:           3,
+					OldestPlannedAt: time.Now().Add(-time.Minute),
+				},
+			},
+			nil,
+		).
+		Times(1)
+
+	usecase := biz.NewNotificationUsecase(notificationRepoMock, nil, nil, nil, &senders.Senders{}, nil, metricMuted, logger)
+	worker := New(usecase, nil, recorder, logger)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	worker.reportQueue(ctx)
+
+	require.Equal(t, 3, recorder.gauges[`worker.queue.pending.email.depth`])
+	require.InDelta(t, 60., recorder.gauges[`worker.queue.pending.email.oldestAge`], 5)
+	require.Equal(t, 0, recorder.gauges[`worker.queue.retry.sms.depth`])
+	require.Equal(t, 0., recorder.gauges[`worker.queue.processing.plain.oldestAge`])
+	require.Len(t, recorder.gauges, 2*len(biz.QueuedStatuses)*len(schema.Types))
+}
+
+type schedulerStub struct {
+	counts []int
+	limits []int
+}
+
+func (s *schedulerStub) Materialize(_ context.Context, limit int) (int, error) {
+	s.limits = append(s.limits, limit)
+	if len(s.limits) > len(s.counts) {
+		return 0, errors.New("test for failed materialize")
+	}
+	return s.counts[len(s.limits)-1], nil
+}
+
+func TestWorker_Materialize(t *testing.T) {
+	logger := log.NewFilter(log.DefaultLogger, log.FilterLevel(log.LevelFatal))
+
+	metricMuted, err := metrics.New(``, `test`, true)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		counts []int
+		calls  int
+	}{
+		{
+			name:   "empty",
+			counts: []int{0},
+			calls:  1,
+		},
+		{
+			name:   "until batch is not full",
+			counts: []int{5, 5, 2},
+			calls:  3,
+		},
+		{
+			name:   "failed",
+			counts: []int{5},
+			calls:  2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name, func(t *testing.T) {
+				scheduler := &schedulerStub{counts: testCase.counts}
+				worker := New(nil, nil, metricMuted, logger, ScheduleOption(scheduler, 0, 5))
+				require.Equal(t, defaultScheduleInterval, worker.scheduleInterval)
+
+				worker.materialize(context.Background())
+
+				require.Len(t, scheduler.limits, testCase.calls)
+				for _, limit := range scheduler.limits {
+					require.Equal(t, 5, limit)
+				}
+			},
+		)
+	}
+}
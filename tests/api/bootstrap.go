@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"net"
 	"os"
 	"path"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/go-kratos/kratos/v2/config"
 	"github.com/go-kratos/kratos/v2/config/file"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/joho/godotenv"
 )
@@ -39,6 +41,8 @@ var (
 	sendersSet       *senders.Senders
 	notificationRepo biz.NotificationRepo
 	httpServer       *http.Server
+	grpcServer       *grpc.Server
+	grpcEndpoint     string
 	jwtToken         string
 )
 
@@ -73,11 +77,11 @@ func bootstrap() (func(), error) {
 		return nil, err
 	}
 
-	jwtToken = auth.MakeJWT(bc.Auth.Jwt.Secret)
+	jwtToken = auth.MakeJWT(bc.Auth.Jwt.Secret, 0, auth.Scopes, nil)
 
 	logs = logger.New(id, name, version, bc.Log.Level)
 
-	metric, err = metrics.New(bc.Metrics.Address, name, bc.Metrics.Mute)
+	metric, err = metrics.NewExporter(bc.Metrics.Exporter, bc.Metrics.Address, name, bc.Metrics.Mute)
 	if err != nil {
 		return nil, err
 	}
@@ -127,13 +131,32 @@ func bootstrap() (func(), error) {
 	smsAeroClient := smsaero.New(aero.Email, aero.ApiKey, httpClient, metric, logs)
 	smsAeroSender := senders.NewSMSAero(smsAeroClient, metric, logs)
 
-	sendersSet = senders.NewSenders(plainSender, emailSender, telegramSender, smsAeroSender)
+	tenants := senders.NewTenants(wireChannelCredentialRepo(database, metric), httpClient, metric, logs)
+
+	sendersSet = senders.NewSenders(plainSender, emailSender, telegramSender, smsAeroSender, tenants)
 
 	notificationRepo = wireNotificationRepo(database, logs, metric)
 
-	httpServer = wireHTTPServer(database, bc.Server, bc.Auth, sendersSet, metric, logs)
+	httpServer, err = wireHTTPServer(database, bc.Server, bc.Auth, bc.Biz, bc.Senders, sendersSet, metric, logs)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcServer, err = wireGRPCServer(database, bc.Server, bc.Auth, bc.Biz, bc.Senders, sendersSet, metric, logs)
+	if err != nil {
+		return nil, err
+	}
+	grpcListener, err := net.Listen(`tcp`, `127.0.0.1:0`)
+	if err != nil {
+		return nil, err
+	}
+	grpcEndpoint = grpcListener.Addr().String()
+	go func() {
+		_ = grpcServer.Serve(grpcListener)
+	}()
 
 	cleanup := func() {
+		_ = grpcServer.Stop(ctx)
 		_ = c.Close()
 		metric.Close()
 		databaseCleanup()
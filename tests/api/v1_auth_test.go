@@ -0,0 +1,273 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	v1 "notifications/api/notification/v1"
+	"notifications/ent"
+	"notifications/ent/schema"
+	"notifications/internal/auth"
+
+	"github.com/gavv/httpexpect/v2"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestV1Auth(t *testing.T) {
+	var (
+		err          error
+		notification *ent.Notification
+		expect       *httpexpect.Expect
+		server       *httptest.Server
+		client       v1.NotificationClient
+	)
+
+	readToken := auth.MakeJWT(bc.Auth.Jwt.Secret, 0, []string{auth.ScopeRead}, nil)
+	sendToken := auth.MakeJWT(bc.Auth.Jwt.Secret, 0, []string{auth.ScopeSend}, nil)
+	foreignToken := auth.MakeJWT(`not-a-secret`, 0, auth.Scopes, nil)
+
+	t.Run(
+		`prerequisites`, func(t *testing.T) {
+			ctx := context.Background()
+
+			now := time.Now()
+
+			notification, err = notificationRepo.Create(
+				ctx,
+				&ent.Notification{
+					Type: schema.TypePlain,
+					Payload: (schema.PayloadPlain{
+						Message: `hello from api tests!`,
+					}).MustToPayload(),
+					TTL:       600,
+					Status:    schema.StatusSent,
+					PlannedAt: now,
+					SentAt:    &now,
+				},
+			)
+			require.NoError(t, err)
+
+			server = httptest.NewServer(httpServer)
+
+			expect = httpexpect.New(t, server.URL)
+
+			conn, err := grpc.DialInsecure(ctx, grpc.WithEndpoint(grpcEndpoint))
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = conn.Close() })
+
+			client = v1.NewNotificationClient(conn)
+		},
+	)
+
+	defer server.Close()
+
+	t.Run(
+		`http_without_token`, func(t *testing.T) {
+			expect.POST(`/v1/check`).
+				WithJSON(AbstractJSON{`id`: notification.ID}).
+				Expect().
+				Status(http.StatusUnauthorized)
+		},
+	)
+
+	t.Run(
+		`http_foreign_token`, func(t *testing.T) {
+			expect.POST(`/v1/check`).
+				WithHeader(`Authorization`, `Bearer `+foreignToken).
+				WithJSON(AbstractJSON{`id`: notification.ID}).
+				Expect().
+				Status(http.StatusUnauthorized)
+		},
+	)
+
+	t.Run(
+		`http_without_scope`, func(t *testing.T) {
+			expect.POST(`/v1/check`).
+				WithHeader(`Authorization`, `Bearer `+sendToken).
+				WithJSON(AbstractJSON{`id`: notification.ID}).
+				Expect().
+				Status(http.StatusForbidden).
+				JSON().
+				Object().
+				ContainsMap(AbstractJSON{`reason`: v1.ErrorReason_FORBIDDEN.String()})
+		},
+	)
+
+	t.Run(
+		`http_with_scope`, func(t *testing.T) {
+			expect.POST(`/v1/check`).
+				WithHeader(`Authorization`, `Bearer `+readToken).
+				WithJSON(AbstractJSON{`id`: notification.ID}).
+				Expect().
+				Status(http.StatusOK).
+				JSON().
+				Equal(AbstractJSON{`status`: schema.StatusSent})
+		},
+	)
+
+	t.Run(
+		`grpc_without_token`, func(t *testing.T) {
+			_, err := client.Check(context.Background(), &v1.CheckRequest{Id: int64(notification.ID)})
+			require.Equal(t, http.StatusUnauthorized, int(errors.FromError(err).Code))
+		},
+	)
+
+	t.Run(
+		`grpc_foreign_token`, func(t *testing.T) {
+			ctx := metadata.AppendToOutgoingContext(context.Background(), `authorization`, `Bearer `+foreignToken)
+			_, err := client.Check(ctx, &v1.CheckRequest{Id: int64(notification.ID)})
+			require.Equal(t, http.StatusUnauthorized, int(errors.FromError(err).Code))
+		},
+	)
+
+	t.Run(
+		`grpc_without_scope`, func(t *testing.T) {
+			ctx := metadata.AppendToOutgoingContext(context.Background(), `authorization`, `Bearer `+sendToken)
+			_, err := client.Check(ctx, &v1.CheckRequest{Id: int64(notification.ID)})
+			require.True(t, v1.IsForbidden(err))
+		},
+	)
+
+	t.Run(
+		`grpc_with_scope`, func(t *testing.T) {
+			ctx := metadata.AppendToOutgoingContext(context.Background(), `authorization`, `Bearer `+readToken)
+			response, err := client.Check(ctx, &v1.CheckRequest{Id: int64(notification.ID)})
+			require.NoError(t, err)
+			require.Equal(t, v1.Status_sent, response.Status)
+		},
+	)
+}
+
+func TestV1APIKey(t *testing.T) {
+	var (
+		err          error
+		notification *ent.Notification
+		expect       *httpexpect.Expect
+		server       *httptest.Server
+		client       v1.NotificationClient
+		key          string
+		keyID        int64
+	)
+
+	keysToken := auth.MakeJWT(bc.Auth.Jwt.Secret, 42, []string{auth.ScopeKeys, auth.ScopeRead}, nil)
+
+	t.Run(
+		`prerequisites`, func(t *testing.T) {
+			ctx := context.Background()
+
+			now := time.Now()
+
+			notification, err = notificationRepo.Create(
+				ctx,
+				&ent.Notification{
+					SenderID: 42,
+					Type:     schema.TypePlain,
+					Payload: (schema.PayloadPlain{
+						Message: `hello from api tests!`,
+					}).MustToPayload(),
+					TTL:       600,
+					Status:    schema.StatusSent,
+					PlannedAt: now,
+					SentAt:    &now,
+				},
+			)
+			require.NoError(t, err)
+
+			server = httptest.NewServer(httpServer)
+
+			expect = httpexpect.New(t, server.URL)
+
+			conn, err := grpc.DialInsecure(ctx, grpc.WithEndpoint(grpcEndpoint))
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = conn.Close() })
+
+			client = v1.NewNotificationClient(conn)
+		},
+	)
+
+	defer server.Close()
+
+	t.Run(
+		`issue_with_exceeding_scope`, func(t *testing.T) {
+			expect.POST(`/v1/key/issue`).
+				WithHeader(`Authorization`, `Bearer `+keysToken).
+				WithJSON(AbstractJSON{`apiKey`: AbstractJSON{`scopes`: []string{auth.ScopeSend}}}).
+				Expect().
+				Status(http.StatusForbidden)
+		},
+	)
+
+	t.Run(
+		`issue`, func(t *testing.T) {
+			response := expect.POST(`/v1/key/issue`).
+				WithHeader(`Authorization`, `Bearer `+keysToken).
+				WithJSON(AbstractJSON{`apiKey`: AbstractJSON{`name`: `legacy`, `scopes`: []string{auth.ScopeRead}}}).
+				Expect().
+				Status(http.StatusOK).
+				JSON().
+				Object()
+			response.Path(`$.apiKey.senderId`).Equal(`42`)
+			key = response.Value(`key`).String().NotEmpty().Raw()
+			keyID, err = strconv.ParseInt(response.Path(`$.apiKey.id`).String().Raw(), 10, 64)
+			require.NoError(t, err)
+		},
+	)
+
+	t.Run(
+		`http_with_api_key`, func(t *testing.T) {
+			expect.POST(`/v1/check`).
+				WithHeader(auth.APIKeyHeader, key).
+				WithJSON(AbstractJSON{`id`: notification.ID}).
+				Expect().
+				Status(http.StatusOK).
+				JSON().
+				Equal(AbstractJSON{`status`: schema.StatusSent})
+		},
+	)
+
+	t.Run(
+		`http_api_key_without_scope`, func(t *testing.T) {
+			expect.POST(`/v1/send`).
+				WithHeader(auth.APIKeyHeader, key).
+				WithJSON(AbstractJSON{`type`: `plain`, `payload`: AbstractJSON{`message`: `hello`}}).
+				Expect().
+				Status(http.StatusForbidden)
+		},
+	)
+
+	t.Run(
+		`grpc_with_api_key`, func(t *testing.T) {
+			ctx := metadata.AppendToOutgoingContext(context.Background(), auth.APIKeyHeader, key)
+			response, err := client.Check(ctx, &v1.CheckRequest{Id: int64(notification.ID)})
+			require.NoError(t, err)
+			require.Equal(t, v1.Status_sent, response.Status)
+		},
+	)
+
+	t.Run(
+		`revoke`, func(t *testing.T) {
+			expect.POST(`/v1/key/revoke`).
+				WithHeader(`Authorization`, `Bearer `+keysToken).
+				WithJSON(AbstractJSON{`id`: keyID}).
+				Expect().
+				Status(http.StatusOK)
+
+			expect.POST(`/v1/check`).
+				WithHeader(auth.APIKeyHeader, key).
+				WithJSON(AbstractJSON{`id`: notification.ID}).
+				Expect().
+				Status(http.StatusUnauthorized)
+
+			ctx := metadata.AppendToOutgoingContext(context.Background(), auth.APIKeyHeader, key)
+			_, err := client.Check(ctx, &v1.CheckRequest{Id: int64(notification.ID)})
+			require.True(t, v1.IsUnauthorized(err))
+		},
+	)
+}
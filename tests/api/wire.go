@@ -10,6 +10,7 @@ import (
 	"notifications/internal/senders"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/google/wire"
 
@@ -29,13 +30,32 @@ func wireNotificationRepo(data.Database, log.Logger, metrics.Metrics) biz.Notifi
 	panic(wire.Build(data.ProviderRepoSet))
 }
 
+func wireChannelCredentialRepo(data.Database, metrics.Metrics) biz.ChannelCredentialRepo {
+	panic(wire.Build(data.ProviderRepoSet))
+}
+
 func wireHTTPServer(
 	data.Database,
 	*conf.Server,
 	*conf.Auth,
+	*conf.Biz,
+	*conf.Senders,
+	*senders.Senders,
+	metrics.Metrics,
+	log.Logger,
+) (*http.Server, error) {
+	panic(wire.Build(server.ProviderSet, data.ProviderRepoSet, biz.ProviderSet, service.ProviderSet))
+}
+
+func wireGRPCServer(
+	data.Database,
+	*conf.Server,
+	*conf.Auth,
+	*conf.Biz,
+	*conf.Senders,
 	*senders.Senders,
 	metrics.Metrics,
 	log.Logger,
-) *http.Server {
+) (*grpc.Server, error) {
 	panic(wire.Build(server.ProviderSet, data.ProviderRepoSet, biz.ProviderSet, service.ProviderSet))
 }
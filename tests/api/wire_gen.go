@@ -8,7 +8,9 @@ package api
 
 import (
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/go-kratos/kratos/v2/transport/http"
+	"notifications/internal/auth"
 	"notifications/internal/biz"
 	"notifications/internal/conf"
 	"notifications/internal/data"
@@ -36,10 +38,55 @@ func wireNotificationRepo(dataDatabase data.Database, logger log.Logger, metrics
 	return bizNotificationRepo
 }
 
-func wireHTTPServer(dataDatabase data.Database, confServer *conf.Server, auth *conf.Auth, sendersSenders *senders.Senders, metricsMetrics metrics.Metrics, logger log.Logger) *http.Server {
+func wireChannelCredentialRepo(dataDatabase data.Database, metricsMetrics metrics.Metrics) biz.ChannelCredentialRepo {
+	channelCredentialRepo := data.NewChannelCredentialRepo(dataDatabase, metricsMetrics)
+	return channelCredentialRepo
+}
+
+func wireHTTPServer(dataDatabase data.Database, confServer *conf.Server, confAuth *conf.Auth, confBiz *conf.Biz, confSenders *conf.Senders, sendersSenders *senders.Senders, metricsMetrics metrics.Metrics, logger log.Logger) (*http.Server, error) {
+	verifier, err := auth.NewVerifier(confAuth)
+	if err != nil {
+		return nil, err
+	}
+	bizNotificationRepo := data.NewNotificationRepo(dataDatabase, logger, metricsMetrics)
+	quotaRepo := data.NewQuotaRepo(dataDatabase, metricsMetrics)
+	suppressionRepo := data.NewSuppressionRepo(dataDatabase, metricsMetrics)
+	limiter := data.NewRateLimiter(dataDatabase, confBiz, metricsMetrics)
+	notificationUsecase := biz.NewNotificationUsecase(bizNotificationRepo, quotaRepo, suppressionRepo, limiter, sendersSenders, confBiz, metricsMetrics, logger)
+	scheduleRepo := data.NewScheduleRepo(dataDatabase, metricsMetrics)
+	transactor := data.NewTransactor(bizNotificationRepo)
+	scheduleUsecase := biz.NewScheduleUsecase(scheduleRepo, transactor, notificationUsecase, metricsMetrics, logger)
+	suppressionUsecase := biz.NewSuppressionUsecase(suppressionRepo, metricsMetrics, logger)
+	apiKeyRepo := data.NewAPIKeyRepo(dataDatabase, metricsMetrics)
+	apiKeyUsecase := biz.NewAPIKeyUsecase(apiKeyRepo, metricsMetrics, logger)
+	channelCredentialRepo := data.NewChannelCredentialRepo(dataDatabase, metricsMetrics)
+	channelCredentialUsecase := biz.NewChannelCredentialUsecase(channelCredentialRepo, metricsMetrics, logger)
+	notificationService := service.NewNotificationService(notificationUsecase, scheduleUsecase, suppressionUsecase, apiKeyUsecase, channelCredentialUsecase, sendersSenders, logger)
+	health := server.NewHealth(dataDatabase, confSenders)
+	server2 := server.NewHTTPServer(confServer, verifier, notificationService, apiKeyUsecase, health, metricsMetrics, logger)
+	return server2, nil
+}
+
+func wireGRPCServer(dataDatabase data.Database, confServer *conf.Server, confAuth *conf.Auth, confBiz *conf.Biz, confSenders *conf.Senders, sendersSenders *senders.Senders, metricsMetrics metrics.Metrics, logger log.Logger) (*grpc.Server, error) {
+	verifier, err := auth.NewVerifier(confAuth)
+	if err != nil {
+		return nil, err
+	}
 	bizNotificationRepo := data.NewNotificationRepo(dataDatabase, logger, metricsMetrics)
-	notificationUsecase := biz.NewNotificationUsecase(bizNotificationRepo, sendersSenders, metricsMetrics, logger)
-	notificationService := service.NewNotificationService(notificationUsecase, sendersSenders, logger)
-	server2 := server.NewHTTPServer(confServer, auth, notificationService, metricsMetrics, logger)
-	return server2
+	quotaRepo := data.NewQuotaRepo(dataDatabase, metricsMetrics)
+	suppressionRepo := data.NewSuppressionRepo(dataDatabase, metricsMetrics)
+	limiter := data.NewRateLimiter(dataDatabase, confBiz, metricsMetrics)
+	notificationUsecase := biz.NewNotificationUsecase(bizNotificationRepo, quotaRepo, suppressionRepo, limiter, sendersSenders, confBiz, metricsMetrics, logger)
+	scheduleRepo := data.NewScheduleRepo(dataDatabase, metricsMetrics)
+	transactor := data.NewTransactor(bizNotificationRepo)
+	scheduleUsecase := biz.NewScheduleUsecase(scheduleRepo, transactor, notificationUsecase, metricsMetrics, logger)
+	suppressionUsecase := biz.NewSuppressionUsecase(suppressionRepo, metricsMetrics, logger)
+	apiKeyRepo := data.NewAPIKeyRepo(dataDatabase, metricsMetrics)
+	apiKeyUsecase := biz.NewAPIKeyUsecase(apiKeyRepo, metricsMetrics, logger)
+	channelCredentialRepo := data.NewChannelCredentialRepo(dataDatabase, metricsMetrics)
+	channelCredentialUsecase := biz.NewChannelCredentialUsecase(channelCredentialRepo, metricsMetrics, logger)
+	notificationService := service.NewNotificationService(notificationUsecase, scheduleUsecase, suppressionUsecase, apiKeyUsecase, channelCredentialUsecase, sendersSenders, logger)
+	health := server.NewHealth(dataDatabase, confSenders)
+	grpcServer := server.NewGRPCServer(confServer, verifier, notificationService, apiKeyUsecase, health, metricsMetrics, logger)
+	return grpcServer, nil
 }